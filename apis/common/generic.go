@@ -0,0 +1,105 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
+)
+
+const (
+	errParseAPIVersion      = "cannot parse the apiVersion of the generic reference"
+	errNotProviderResource  = "the generic reference must be to a managed resource of the " + v1beta1.Group + " API groups"
+	errNoFieldPath          = "the fieldPath of the generic reference must be set"
+	errGetGenericReferenced = "cannot get the managed resource of the generic reference"
+	errGetField             = "cannot get the field of the generic reference"
+	errEmptyGenericValue    = "the value of the generic reference is empty (the referenced resource may not yet be ready)"
+)
+
+// ResolveGeneric resolves the given generic reference of the given managed resource, and returns the referenced
+// value. Like the other references, it is only resolved when the current value is not set. For managed resources
+// annotated with AnnotationWaitForReady, the referenced managed resource must be Ready.
+func ResolveGeneric(ctx context.Context, c client.Reader, from resource.Managed, current string, ref *v1beta1.GenericReference) (string, error) {
+	if ref == nil || current != "" || meta.WasDeleted(from) {
+		return current, nil
+	}
+	if ref.FieldPath == "" {
+		return "", errors.New(errNoFieldPath)
+	}
+
+	gv, err := schema.ParseGroupVersion(ref.APIVersion)
+	if err != nil {
+		return "", errors.Wrap(err, errParseAPIVersion)
+	}
+	if gv.Group != v1beta1.Group && !strings.HasSuffix(gv.Group, "."+v1beta1.Group) {
+		return "", errors.New(errNotProviderResource)
+	}
+
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(gv.WithKind(ref.Kind))
+	if err := c.Get(ctx, types.NamespacedName{Name: ref.Name}, u); err != nil {
+		return "", errors.Wrap(err, errGetGenericReferenced)
+	}
+
+	if WaitsForReady(from) && !isReady(u) {
+		err := errors.Errorf(errWaitingForDependency, ref.Name)
+		from.SetConditions(WaitingForDependency(err))
+		return "", err
+	}
+
+	v, err := getField(u, ref.FieldPath)
+	if err != nil {
+		return "", err
+	}
+	if v == "" {
+		return "", errors.New(errEmptyGenericValue)
+	}
+	return v, nil
+}
+
+// isReady returns true if the Ready condition of the given managed resource is true
+func isReady(u *unstructured.Unstructured) bool {
+	conditions := []runtimev1alpha1.Condition{}
+	if err := fieldpath.Pave(u.Object).GetValueInto("status.conditions", &conditions); err != nil {
+		return false
+	}
+	s := runtimev1alpha1.ConditionedStatus{Conditions: conditions}
+	return s.GetCondition(runtimev1alpha1.TypeReady).Status == corev1.ConditionTrue
+}
+
+// getField returns the value of the field at the given path, an empty string if it is not set
+func getField(u *unstructured.Unstructured, path string) (string, error) {
+	v, err := fieldpath.Pave(u.Object).GetString(path)
+	if fieldpath.IsNotFound(err) {
+		return "", nil
+	}
+	return v, errors.Wrap(err, errGetField)
+}
@@ -0,0 +1,134 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
+)
+
+const (
+	instanceCRN = "crn:v1:bluemix:public:cloud-object-storage:global:a/0b5a00334eaf9eb9339d2ab48f20d7f5:78d88b2b-bbbb-4226-b2e0-0ec6a7ae5d54::"
+)
+
+func genericRef(apiVersion string, fieldPath string) *v1beta1.GenericReference {
+	return &v1beta1.GenericReference{
+		APIVersion: apiVersion,
+		Kind:       "ResourceInstance",
+		Name:       referenced,
+		FieldPath:  fieldPath,
+	}
+}
+
+func referencedInstance(ready bool) test.ObjectFn {
+	return func(obj runtime.Object) error {
+		if o, ok := obj.(*unstructured.Unstructured); ok {
+			status := map[string]interface{}{"atProvider": map[string]interface{}{"crn": instanceCRN}}
+			if ready {
+				status["conditions"] = []interface{}{map[string]interface{}{"type": "Ready", "status": "True"}}
+			}
+			o.Object["status"] = status
+		}
+		return nil
+	}
+}
+
+func TestResolveGeneric(t *testing.T) {
+	errBoom := errors.New("boom")
+	rcv2 := "resourcecontrollerv2.ibmcloud.crossplane.io/v1alpha1"
+	type args struct {
+		from    map[string]string
+		kube    client.Client
+		current string
+		ref     *v1beta1.GenericReference
+	}
+	type want struct {
+		value string
+		err   error
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NoReference": {
+			args: args{current: "id"},
+			want: want{value: "id"},
+		},
+		"AlreadyResolved": {
+			args: args{
+				kube:    &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				current: "id",
+				ref:     genericRef(rcv2, "status.atProvider.crn"),
+			},
+			want: want{value: "id"},
+		},
+		"FieldPath": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(nil, referencedInstance(false))},
+				ref:  genericRef(rcv2, "status.atProvider.crn"),
+			},
+			want: want{value: instanceCRN},
+		},
+		"EmptyField": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(nil, referencedInstance(false))},
+				ref:  genericRef(rcv2, "status.atProvider.guid"),
+			},
+			want: want{err: errors.New(errEmptyGenericValue)},
+		},
+		"NoFieldPath": {
+			args: args{
+				ref: genericRef(rcv2, ""),
+			},
+			want: want{err: errors.New(errNoFieldPath)},
+		},
+		"NotProviderResource": {
+			args: args{
+				ref: genericRef("v1", "metadata.name"),
+			},
+			want: want{err: errors.New(errNotProviderResource)},
+		},
+		"NotReady": {
+			args: args{
+				from: map[string]string{AnnotationWaitForReady: "true"},
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(nil, referencedInstance(false))},
+				ref:  genericRef(rcv2, "status.atProvider.crn"),
+			},
+			want: want{err: errors.Errorf(errWaitingForDependency, referenced)},
+		},
+		"Ready": {
+			args: args{
+				from: map[string]string{AnnotationWaitForReady: "true"},
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(nil, referencedInstance(true))},
+				ref:  genericRef(rcv2, "status.atProvider.crn"),
+			},
+			want: want{value: instanceCRN},
+		},
+		"GetFailed": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				ref:  genericRef(rcv2, "status.atProvider.crn"),
+			},
+			want: want{err: errors.Wrap(errBoom, errGetGenericReferenced)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			v, err := ResolveGeneric(context.Background(), tc.args.kube, dependent(tc.args.from), tc.args.current, tc.args.ref)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("ResolveGeneric(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.value, v); diff != "" {
+				t.Errorf("ResolveGeneric(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package common contains reference resolvers shared by the API types.
+package common
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const (
+	// AnnotationWaitForReady is the annotation that, when set to `true`, makes the references of a managed resource
+	// only resolve to managed resources whose Ready condition is true. Until then, the managed resource is not
+	// created, and its Ready condition is false with the ReasonWaitingForDependency reason.
+	AnnotationWaitForReady = "ibmcloud.crossplane.io/wait-for-ready-references"
+
+	// ReasonWaitingForDependency means that a managed resource waits for a managed resource it references to be Ready.
+	ReasonWaitingForDependency runtimev1alpha1.ConditionReason = "WaitingForDependency"
+
+	errGetReferenced        = "cannot get the referenced managed resource"
+	errWaitingForDependency = "waiting for the referenced managed resource %q to be Ready"
+)
+
+// WaitsForReady returns true if the references of the given object only resolve to managed resources that are Ready.
+func WaitsForReady(o metav1.Object) bool {
+	v, ok := o.GetAnnotations()[AnnotationWaitForReady]
+	if !ok {
+		return false
+	}
+	wait, err := strconv.ParseBool(v)
+	return err == nil && wait
+}
+
+// WaitingForDependency returns a condition that indicates the managed resource waits for the managed resource it
+// references to be Ready.
+func WaitingForDependency(err error) runtimev1alpha1.Condition {
+	return runtimev1alpha1.Condition{
+		Type:               runtimev1alpha1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonWaitingForDependency,
+		Message:            err.Error(),
+	}
+}
+
+// An APIResolver resolves references like reference.APIResolver does but, for the managed resources annotated with
+// AnnotationWaitForReady, fails to resolve them to managed resources that are not Ready.
+type APIResolver struct {
+	*reference.APIResolver
+
+	client client.Reader
+	from   resource.Managed
+}
+
+// NewAPIResolver returns an APIResolver that resolves the references of the given managed resource.
+func NewAPIResolver(c client.Reader, from resource.Managed) *APIResolver {
+	return &APIResolver{APIResolver: reference.NewAPIResolver(c, from), client: c, from: from}
+}
+
+// Resolve the given ResolutionRequest.
+func (r *APIResolver) Resolve(ctx context.Context, req reference.ResolutionRequest) (reference.ResolutionResponse, error) {
+	rsp, err := r.APIResolver.Resolve(ctx, req)
+	if err != nil || !r.waits(req.IsNoOp()) {
+		return rsp, err
+	}
+	if err := r.checkReady(ctx, rsp.ResolvedReference, req.To.Managed); err != nil {
+		return reference.ResolutionResponse{}, err
+	}
+	return rsp, nil
+}
+
+// ResolveMultiple resolves the given MultiResolutionRequest.
+func (r *APIResolver) ResolveMultiple(ctx context.Context, req reference.MultiResolutionRequest) (reference.MultiResolutionResponse, error) {
+	rsp, err := r.APIResolver.ResolveMultiple(ctx, req)
+	if err != nil || !r.waits(req.IsNoOp()) {
+		return rsp, err
+	}
+	for i := range rsp.ResolvedReferences {
+		if err := r.checkReady(ctx, &rsp.ResolvedReferences[i], req.To.Managed); err != nil {
+			return reference.MultiResolutionResponse{}, err
+		}
+	}
+	return rsp, nil
+}
+
+// waits returns true if the resolved references must be Ready, that is when a resolution actually took place for a
+// managed resource annotated with AnnotationWaitForReady
+func (r *APIResolver) waits(noOp bool) bool {
+	return !noOp && !meta.WasDeleted(r.from) && WaitsForReady(r.from)
+}
+
+// checkReady returns an error if the referenced managed resource is not Ready, and then reflects it in the Ready
+// condition of the managed resource that references it
+func (r *APIResolver) checkReady(ctx context.Context, ref *runtimev1alpha1.Reference, to resource.Managed) error {
+	if ref == nil {
+		return nil
+	}
+	if err := r.client.Get(ctx, types.NamespacedName{Name: ref.Name}, to); err != nil {
+		return errors.Wrap(err, errGetReferenced)
+	}
+	if to.GetCondition(runtimev1alpha1.TypeReady).Status == corev1.ConditionTrue {
+		return nil
+	}
+	err := errors.Errorf(errWaitingForDependency, ref.Name)
+	r.from.SetConditions(WaitingForDependency(err))
+	return err
+}
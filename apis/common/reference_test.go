@@ -0,0 +1,131 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+const (
+	referenced = "instance"
+	resolved   = "guid"
+)
+
+func dependent(annotations map[string]string) *fake.Managed {
+	mg := &fake.Managed{}
+	mg.SetName("key")
+	mg.SetAnnotations(annotations)
+	return mg
+}
+
+func withCondition(c runtimev1alpha1.Condition) test.ObjectFn {
+	return func(obj runtime.Object) error {
+		obj.(*fake.Managed).SetConditions(c)
+		return nil
+	}
+}
+
+func TestResolve(t *testing.T) {
+	errBoom := errors.New("boom")
+	waiting := map[string]string{AnnotationWaitForReady: "true"}
+	type args struct {
+		from    *fake.Managed
+		kube    *test.MockClient
+		current string
+	}
+	type want struct {
+		rsp       reference.ResolutionResponse
+		err       error
+		condition *runtimev1alpha1.Condition
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotWaiting": {
+			args: args{
+				from: dependent(nil),
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(nil, withCondition(runtimev1alpha1.Creating()))},
+			},
+			want: want{
+				rsp: reference.ResolutionResponse{ResolvedValue: resolved, ResolvedReference: &runtimev1alpha1.Reference{Name: referenced}},
+			},
+		},
+		"AlreadyResolved": {
+			args: args{
+				from:    dependent(waiting),
+				kube:    &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				current: resolved,
+			},
+			want: want{
+				rsp: reference.ResolutionResponse{ResolvedValue: resolved, ResolvedReference: &runtimev1alpha1.Reference{Name: referenced}},
+			},
+		},
+		"Ready": {
+			args: args{
+				from: dependent(waiting),
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(nil, withCondition(runtimev1alpha1.Available()))},
+			},
+			want: want{
+				rsp: reference.ResolutionResponse{ResolvedValue: resolved, ResolvedReference: &runtimev1alpha1.Reference{Name: referenced}},
+			},
+		},
+		"NotReady": {
+			args: args{
+				from: dependent(waiting),
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(nil, withCondition(runtimev1alpha1.Creating()))},
+			},
+			want: want{
+				err: errors.Errorf(errWaitingForDependency, referenced),
+				condition: func() *runtimev1alpha1.Condition {
+					c := WaitingForDependency(errors.Errorf(errWaitingForDependency, referenced))
+					return &c
+				}(),
+			},
+		},
+		"GetFailed": {
+			args: args{
+				from: dependent(waiting),
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, "cannot get referenced resource"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := NewAPIResolver(tc.args.kube, tc.args.from)
+			rsp, err := r.Resolve(context.Background(), reference.ResolutionRequest{
+				CurrentValue: tc.args.current,
+				Reference:    &runtimev1alpha1.Reference{Name: referenced},
+				To:           reference.To{Managed: &fake.Managed{}},
+				Extract:      func(resource.Managed) string { return resolved },
+			})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Resolve(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.rsp, rsp); diff != "" {
+				t.Errorf("Resolve(...): -want, +got:\n%s", diff)
+			}
+			if tc.want.condition == nil {
+				if len(tc.args.from.Conditions) != 0 {
+					t.Errorf("Resolve(...): unexpected conditions %v", tc.args.from.Conditions)
+				}
+				return
+			}
+			if diff := cmp.Diff(*tc.want.condition, tc.args.from.GetCondition(runtimev1alpha1.TypeReady)); diff != "" {
+				t.Errorf("Resolve(...): -want condition, +got condition:\n%s", diff)
+			}
+		})
+	}
+}
@@ -19,6 +19,8 @@ package v1alpha1
 import (
 	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
 )
 
 // Zone info for the workes
@@ -107,11 +109,33 @@ type ClusterCreateRequest struct {
 	// +immutable
 	DefaultWorkerPoolEntitlement string `json:"defaultWorkerPoolEntitlement"`
 
+	// Note:
+	//    One of 'CosInstanceCRN', 'CosInstanceCRNGenericRef' should be specified
+	//
 	// +immutable
-	CosInstanceCRN string `json:"cosInstanceCRN"`
+	// +optional
+	CosInstanceCRN string `json:"cosInstanceCRN,omitempty"`
+
+	// A generic reference to a field of any managed resource, used to set CosInstanceCRN
+	//
+	// Note:
+	//    One of 'CosInstanceCRN', 'CosInstanceCRNGenericRef' should be specified
+	//
+	// +immutable
+	// +optional
+	CosInstanceCRNGenericRef *v1beta1.GenericReference `json:"cosInstanceCRNGenericRef,omitempty"`
 
 	// +immutable
 	WorkerPools WorkerPoolConfig `json:"workerPool"`
+
+	// User tags attached to the cluster through the Global Tagging API. If not set, user tags are not managed.
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+
+	// Access tags attached to the cluster through the Global Tagging API. Access tags must be in the `key:value`
+	// format and can be used to control access with IAM policies. If not set, access tags are not managed.
+	// +optional
+	AccessTags []string `json:"accessTags,omitempty"`
 }
 
 // Feat ...
@@ -185,6 +209,10 @@ type ClusterObservation struct {
 	WorkerCount       int           `json:"workerCount"`
 	Ingress           IngresInfo    `json:"ingress"`
 	Features          Feat          `json:"features"`
+
+	// The estimated monthly cost of the workers (flavor × workers per zone × zones), from the pricing data of the
+	// global catalog. Only set if cost estimation is enabled in the ProviderConfig.
+	EstimatedMonthlyCost string `json:"estimatedMonthlyCost,omitempty"`
 }
 
 // ClusterSpec defines the desired state of a Cluster.
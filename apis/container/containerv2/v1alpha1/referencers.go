@@ -21,6 +21,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/common"
 	vpc "github.com/crossplane-contrib/provider-ibm-cloud/apis/vpcv1/v1alpha1"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
@@ -29,9 +30,15 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-// ResolveReferences resolves the crossplane reference to the VPC
+// ResolveReferences resolves the crossplane references to the COS instance and the VPC
 func (mg *Cluster) ResolveReferences(ctx context.Context, c client.Reader) error {
-	r := reference.NewAPIResolver(c, mg)
+	crn, err := common.ResolveGeneric(ctx, c, mg, mg.Spec.ForProvider.CosInstanceCRN, mg.Spec.ForProvider.CosInstanceCRNGenericRef)
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.cosInstanceCRN")
+	}
+	mg.Spec.ForProvider.CosInstanceCRN = crn
+
+	r := common.NewAPIResolver(c, mg)
 
 	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
 		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.WorkerPools.VpcID),
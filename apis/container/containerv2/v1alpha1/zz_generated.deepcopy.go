@@ -22,6 +22,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
 	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -76,7 +77,22 @@ func (in *ClusterCreateRequest) DeepCopyInto(out *ClusterCreateRequest) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.CosInstanceCRNGenericRef != nil {
+		in, out := &in.CosInstanceCRNGenericRef, &out.CosInstanceCRNGenericRef
+		*out = new(v1beta1.GenericReference)
+		**out = **in
+	}
 	in.WorkerPools.DeepCopyInto(&out.WorkerPools)
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AccessTags != nil {
+		in, out := &in.AccessTags, &out.AccessTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterCreateRequest.
@@ -0,0 +1,107 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/common"
+	rcv2 "github.com/crossplane-contrib/provider-ibm-cloud/apis/resourcecontrollerv2/v1alpha1"
+	vpcv1 "github.com/crossplane-contrib/provider-ibm-cloud/apis/vpcv1/v1alpha1"
+)
+
+// ResolveReferences of this Zone
+func (mg *Zone) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := common.NewAPIResolver(c, mg)
+
+	for i := range mg.Spec.ForProvider.Addresses {
+		a := &mg.Spec.ForProvider.Addresses[i]
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(a.Value),
+			Reference:    a.VPCRef,
+			Selector:     a.VPCSelector,
+			To:           reference.To{Managed: &vpcv1.VPC{}, List: &vpcv1.VPCList{}},
+			Extract:      vpcv1.VPCCRN(),
+		})
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("spec.forProvider.addresses[%d].value", i))
+		}
+		a.Value = reference.ToPtrValue(rsp.ResolvedValue)
+		a.VPCRef = rsp.ResolvedReference
+	}
+	return nil
+}
+
+// ResolveReferences of this Rule
+func (mg *Rule) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := common.NewAPIResolver(c, mg)
+
+	for i := range mg.Spec.ForProvider.Contexts {
+		for j := range mg.Spec.ForProvider.Contexts[i].Attributes {
+			a := &mg.Spec.ForProvider.Contexts[i].Attributes[j]
+			rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: reference.FromPtrValue(a.Value),
+				Reference:    a.ZoneIDRef,
+				Selector:     a.ZoneIDSelector,
+				To:           reference.To{Managed: &Zone{}, List: &ZoneList{}},
+				Extract:      ZoneID(),
+			})
+			if err != nil {
+				return errors.Wrap(err, fmt.Sprintf("spec.forProvider.contexts[%d].attributes[%d].value", i, j))
+			}
+			a.Value = reference.ToPtrValue(rsp.ResolvedValue)
+			a.ZoneIDRef = rsp.ResolvedReference
+		}
+	}
+
+	for i := range mg.Spec.ForProvider.Resources {
+		for j := range mg.Spec.ForProvider.Resources[i].Attributes {
+			a := &mg.Spec.ForProvider.Resources[i].Attributes[j]
+			rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: reference.FromPtrValue(a.Value),
+				Reference:    a.ServiceInstanceRef,
+				Selector:     a.ServiceInstanceSelector,
+				To:           reference.To{Managed: &rcv2.ResourceInstance{}, List: &rcv2.ResourceInstanceList{}},
+				Extract:      rcv2.SourceGUID(),
+			})
+			if err != nil {
+				return errors.Wrap(err, fmt.Sprintf("spec.forProvider.resources[%d].attributes[%d].value", i, j))
+			}
+			a.Value = reference.ToPtrValue(rsp.ResolvedValue)
+			a.ServiceInstanceRef = rsp.ResolvedReference
+		}
+	}
+	return nil
+}
+
+// ZoneID extracts the resolved ID of a Zone
+func ZoneID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		cr, ok := mg.(*Zone)
+		if !ok {
+			return ""
+		}
+		return cr.Status.AtProvider.ID
+	}
+}
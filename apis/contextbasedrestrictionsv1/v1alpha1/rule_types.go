@@ -0,0 +1,190 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// In spec mandatory fields should be by value, and optional fields pointers
+// In status, all fields should be by value, except timestamps - metav1.Time, and runtime.RawExtension which requires special treatment
+// https://github.com/crossplane/crossplane/blob/master/design/one-pager-managed-resource-api-design.md#pointer-types-and-markers
+
+// RuleParameters are the configurable fields of a Rule.
+type RuleParameters struct {
+	// The description of the rule.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// The contexts the resources of the rule can be accessed from.
+	Contexts []RuleContext `json:"contexts"`
+
+	// The resources the rule applies to.
+	Resources []RuleResource `json:"resources"`
+
+	// The enforcement mode of the rule; 'enabled' (the default) enforces the rule, 'report' only monitors the access
+	// to the resources, and 'disabled' neither enforces nor monitors it.
+	// +kubebuilder:validation:Enum=enabled;disabled;report
+	// +optional
+	EnforcementMode *string `json:"enforcementMode,omitempty"`
+}
+
+// RuleContext : A context of a rule; the access to the resources of the rule is allowed when it matches all the
+// attributes of one of the contexts.
+type RuleContext struct {
+	// The attributes of the context.
+	Attributes []RuleContextAttribute `json:"attributes"`
+}
+
+// RuleContextAttribute : An attribute of a context of a rule.
+type RuleContextAttribute struct {
+	// The name of the attribute (e.g. 'networkZoneId', 'endpointType').
+	Name string `json:"name"`
+
+	// The value of the attribute.
+	//
+	// Note:
+	//    One of 'Value', 'ZoneIDRef', 'ZoneIDSelector' should be specified
+	//
+	// +optional
+	Value *string `json:"value,omitempty"`
+
+	// Reference to a Zone, whose ID is used to set Value (of a 'networkZoneId' attribute)
+	// +optional
+	ZoneIDRef *runtimev1alpha1.Reference `json:"zoneIdRef,omitempty"`
+
+	// Selector for a Zone, whose ID is used to set Value (of a 'networkZoneId' attribute)
+	// +optional
+	ZoneIDSelector *runtimev1alpha1.Selector `json:"zoneIdSelector,omitempty"`
+}
+
+// RuleResource : A resource a rule applies to.
+type RuleResource struct {
+	// The attributes of the resource.
+	Attributes []RuleResourceAttribute `json:"attributes"`
+
+	// The tags of the resource.
+	// +optional
+	Tags []RuleResourceTag `json:"tags,omitempty"`
+}
+
+// RuleResourceAttribute : An attribute of a resource of a rule.
+type RuleResourceAttribute struct {
+	// The name of the attribute (e.g. 'accountId', 'serviceName', 'serviceInstance').
+	Name string `json:"name"`
+
+	// The value of the attribute.
+	//
+	// Note:
+	//    One of 'Value', 'ServiceInstanceRef', 'ServiceInstanceSelector' should be specified
+	//
+	// +optional
+	Value *string `json:"value,omitempty"`
+
+	// Reference to a ResourceInstance, whose GUID is used to set Value (of a 'serviceInstance' attribute)
+	// +optional
+	ServiceInstanceRef *runtimev1alpha1.Reference `json:"serviceInstanceRef,omitempty"`
+
+	// Selector for a ResourceInstance, whose GUID is used to set Value (of a 'serviceInstance' attribute)
+	// +optional
+	ServiceInstanceSelector *runtimev1alpha1.Selector `json:"serviceInstanceSelector,omitempty"`
+
+	// The operator of the attribute; 'stringEquals' when not set.
+	// +optional
+	Operator *string `json:"operator,omitempty"`
+}
+
+// RuleResourceTag : A tag of a resource of a rule.
+type RuleResourceTag struct {
+	// The name of the tag.
+	Name string `json:"name"`
+
+	// The value of the tag.
+	Value string `json:"value"`
+
+	// The operator of the tag; 'stringEquals' when not set.
+	// +optional
+	Operator *string `json:"operator,omitempty"`
+}
+
+// RuleObservation are the observable fields of a Rule.
+type RuleObservation struct {
+	// The globally unique ID of the rule.
+	ID string `json:"id,omitempty"`
+
+	// The rule CRN.
+	CRN string `json:"crn,omitempty"`
+
+	// The href link to the resource.
+	Href string `json:"href,omitempty"`
+
+	// The time the resource was created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// IAM ID of the user or service which created the resource.
+	CreatedByID string `json:"createdById,omitempty"`
+
+	// The last time the resource was modified.
+	LastModifiedAt *metav1.Time `json:"lastModifiedAt,omitempty"`
+
+	// IAM ID of the user or service which modified the resource.
+	LastModifiedByID string `json:"lastModifiedById,omitempty"`
+
+	// The current state of the rule
+	State string `json:"state,omitempty"`
+}
+
+// A RuleSpec defines the desired state of a Rule.
+type RuleSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  RuleParameters `json:"forProvider"`
+}
+
+// A RuleStatus represents the observed state of a Rule.
+type RuleStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     RuleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Rule represents a context-based restriction rule on IBM Cloud; it restricts the access to resources to the
+// given contexts, such as network zones.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.bindingPhase"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="CLASS",type="string",JSONPath=".spec.classRef.name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,ibmcloud}
+type Rule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RuleSpec   `json:"spec"`
+	Status RuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RuleList contains a list of Rule
+type RuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Rule `json:"items"`
+}
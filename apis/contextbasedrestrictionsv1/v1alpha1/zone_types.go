@@ -0,0 +1,171 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// In spec mandatory fields should be by value, and optional fields pointers
+// In status, all fields should be by value, except timestamps - metav1.Time, and runtime.RawExtension which requires special treatment
+// https://github.com/crossplane/crossplane/blob/master/design/one-pager-managed-resource-api-design.md#pointer-types-and-markers
+
+// ZoneParameters are the configurable fields of a Zone.
+type ZoneParameters struct {
+	// The name of the zone.
+	Name string `json:"name"`
+
+	// The ID of the account owning the zone.
+	// +immutable
+	AccountID string `json:"accountId"`
+
+	// The description of the zone.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// The addresses of the zone.
+	Addresses []ZoneAddress `json:"addresses"`
+
+	// The addresses excluded from the zone. Only addresses of type 'ipAddress', 'ipRange' and 'subnet' can be
+	// excluded.
+	// +optional
+	Excluded []ZoneAddress `json:"excluded,omitempty"`
+}
+
+// ZoneAddress : An address of a zone.
+type ZoneAddress struct {
+	// The type of the address.
+	// +kubebuilder:validation:Enum=ipAddress;ipRange;subnet;vpc;serviceRef
+	Type string `json:"type"`
+
+	// The value of the address: an IP address, an IP range (e.g. '169.23.22.0-169.23.22.255'), a subnet in CIDR
+	// notation, or the CRN of a VPC.
+	//
+	// Note:
+	//    One of 'Value', 'VPCRef', 'VPCSelector' should be specified, unless the type of the address is 'serviceRef'
+	//
+	// +optional
+	Value *string `json:"value,omitempty"`
+
+	// Reference to a VPC, whose CRN is used to set Value (of a 'vpc' address)
+	// +optional
+	VPCRef *runtimev1alpha1.Reference `json:"vpcRef,omitempty"`
+
+	// Selector for a VPC, whose CRN is used to set Value (of a 'vpc' address)
+	// +optional
+	VPCSelector *runtimev1alpha1.Selector `json:"vpcSelector,omitempty"`
+
+	// The service of a 'serviceRef' address.
+	// +optional
+	Ref *ServiceRefValue `json:"ref,omitempty"`
+}
+
+// ServiceRefValue : A service whose network traffic belongs to a zone.
+type ServiceRefValue struct {
+	// The ID of the account owning the service.
+	AccountID string `json:"accountId"`
+
+	// The service type.
+	// +optional
+	ServiceType *string `json:"serviceType,omitempty"`
+
+	// The service name.
+	// +optional
+	ServiceName *string `json:"serviceName,omitempty"`
+
+	// The service instance.
+	// +optional
+	ServiceInstance *string `json:"serviceInstance,omitempty"`
+
+	// The location.
+	// +optional
+	Location *string `json:"location,omitempty"`
+}
+
+// ZoneObservation are the observable fields of a Zone.
+type ZoneObservation struct {
+	// The globally unique ID of the zone.
+	ID string `json:"id,omitempty"`
+
+	// The zone CRN.
+	CRN string `json:"crn,omitempty"`
+
+	// The number of addresses in the zone.
+	AddressCount int64 `json:"addressCount,omitempty"`
+
+	// The number of excluded addresses in the zone.
+	ExcludedCount int64 `json:"excludedCount,omitempty"`
+
+	// The href link to the resource.
+	Href string `json:"href,omitempty"`
+
+	// The time the resource was created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// IAM ID of the user or service which created the resource.
+	CreatedByID string `json:"createdById,omitempty"`
+
+	// The last time the resource was modified.
+	LastModifiedAt *metav1.Time `json:"lastModifiedAt,omitempty"`
+
+	// IAM ID of the user or service which modified the resource.
+	LastModifiedByID string `json:"lastModifiedById,omitempty"`
+
+	// The current state of the zone
+	State string `json:"state,omitempty"`
+}
+
+// A ZoneSpec defines the desired state of a Zone.
+type ZoneSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ZoneParameters `json:"forProvider"`
+}
+
+// A ZoneStatus represents the observed state of a Zone.
+type ZoneStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ZoneObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Zone represents a network zone of the context-based restrictions of an account on IBM Cloud; a set of network
+// locations that rules can allow access from.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.bindingPhase"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="CLASS",type="string",JSONPath=".spec.classRef.name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,ibmcloud}
+type Zone struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ZoneSpec   `json:"spec"`
+	Status ZoneStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ZoneList contains a list of Zone
+type ZoneList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Zone `json:"items"`
+}
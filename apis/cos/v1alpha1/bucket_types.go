@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
 )
 
 // BucketPararams are input params when creating a bucket
@@ -36,7 +38,7 @@ type BucketPararams struct {
 	// The resource service instance where the bucket will be created and to which data usage will be billed. This value can be either the full Cloud Resource Name (CRN) or just the GUID segment that identifies the service instance.
 	//
 	// Note:
-	//    Only one of 'IbmServiceInstanceID', 'IbmServiceInstanceIDRef', 'IbmServiceInstanceIDSelector' should be != nil
+	//    Only one of 'IbmServiceInstanceID', 'IbmServiceInstanceIDRef', 'IbmServiceInstanceIDSelector', 'IbmServiceInstanceIDGenericRef' should be != nil
 	//
 	// Example: d6f76k03-6k4f-4a82-n165-697654o63903
 	//
@@ -47,7 +49,7 @@ type BucketPararams struct {
 	// Crossplane reference to a resource instance containing the bucket
 	//
 	// Note:
-	//    Only one of 'IbmServiceInstanceID', 'IbmServiceInstanceIDRef', 'IbmServiceInstanceIDSelector' should be != nil
+	//    Only one of 'IbmServiceInstanceID', 'IbmServiceInstanceIDRef', 'IbmServiceInstanceIDSelector', 'IbmServiceInstanceIDGenericRef' should be != nil
 	//
 	// +immutable
 	// +optional
@@ -56,12 +58,21 @@ type BucketPararams struct {
 	// Selects a reference to a resource instance containing the bucket
 	//
 	// Note:
-	//    Only one of 'IbmServiceInstanceID', 'IbmServiceInstanceIDRef', 'IbmServiceInstanceIDSelector' should be != nil
+	//    Only one of 'IbmServiceInstanceID', 'IbmServiceInstanceIDRef', 'IbmServiceInstanceIDSelector', 'IbmServiceInstanceIDGenericRef' should be != nil
 	//
 	// +immutable
 	// +optional
 	IbmServiceInstanceIDSelector *runtimev1alpha1.Selector `json:"ibmServiceInstanceIDSelector,omitempty"`
 
+	// A generic reference to a field of any managed resource, used to set IbmServiceInstanceID
+	//
+	// Note:
+	//    Only one of 'IbmServiceInstanceID', 'IbmServiceInstanceIDRef', 'IbmServiceInstanceIDSelector', 'IbmServiceInstanceIDGenericRef' should be != nil
+	//
+	// +immutable
+	// +optional
+	IbmServiceInstanceIDGenericRef *v1beta1.GenericReference `json:"ibmServiceInstanceIDGenericRef,omitempty"`
+
 	// The algorithm and key size used to for the managed encryption root key. Required if IbmSSEKpCustomerRootKeyCrn is also present.
 	//
 	// Allowable values: ``AES256''
@@ -78,10 +89,27 @@ type BucketPararams struct {
 	// +optional
 	IbmSSEKpCustomerRootKeyCrn *string `json:"ibmSSEKpCustomerRootKeyCrn,omitempty"`
 
+	// A generic reference to a field of any managed resource, used to set IbmSSEKpCustomerRootKeyCrn
+	//
+	// +immutable
+	// +optional
+	IbmSSEKpCustomerRootKeyCrnGenericRef *v1beta1.GenericReference `json:"ibmSSEKpCustomerRootKeyCrnGenericRef,omitempty"`
+
 	// Allowable values: ``us-standard'', ``us-cold''
 	//
 	// +immutable
 	LocationConstraint string `json:"locationConstraint"`
+
+	// User tags attached to the bucket through the Global Tagging API. If not set, user tags are not managed.
+	//
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+
+	// Access tags attached to the bucket through the Global Tagging API. Access tags must be in the `key:value`
+	// format and can be used to control access with IAM policies. If not set, access tags are not managed.
+	//
+	// +optional
+	AccessTags []string `json:"accessTags,omitempty"`
 }
 
 // BucketObservation contains the fields of a bucket that are "set" by the IBM cloud
@@ -89,6 +117,9 @@ type BucketObservation struct {
 	// When the bucket was created. Can change when making changes to it -
 	// such as editing its policy
 	CreationDate *metav1.Time `json:"creationDate"`
+
+	// The CRN of the bucket. Only retrieved when tags or access tags are managed.
+	CRN string `json:"crn,omitempty"`
 }
 
 // BucketSpec - desired end-state of a Bucket in the IBM cloud
@@ -21,6 +21,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/common"
 	rc2 "github.com/crossplane-contrib/provider-ibm-cloud/apis/resourcecontrollerv2/v1alpha1"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
@@ -31,7 +32,19 @@ import (
 
 // ResolveReferences resolves the crossplane reference id to the IBM Cloud reference instance id
 func (mg *Bucket) ResolveReferences(ctx context.Context, c client.Reader) error {
-	r := reference.NewAPIResolver(c, mg)
+	id, err := common.ResolveGeneric(ctx, c, mg, reference.FromPtrValue(mg.Spec.ForProvider.IbmServiceInstanceID), mg.Spec.ForProvider.IbmServiceInstanceIDGenericRef)
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.ibmServiceInstanceID")
+	}
+	mg.Spec.ForProvider.IbmServiceInstanceID = reference.ToPtrValue(id)
+
+	key, err := common.ResolveGeneric(ctx, c, mg, reference.FromPtrValue(mg.Spec.ForProvider.IbmSSEKpCustomerRootKeyCrn), mg.Spec.ForProvider.IbmSSEKpCustomerRootKeyCrnGenericRef)
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.ibmSSEKpCustomerRootKeyCrn")
+	}
+	mg.Spec.ForProvider.IbmSSEKpCustomerRootKeyCrn = reference.ToPtrValue(key)
+
+	r := common.NewAPIResolver(c, mg)
 
 	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
 		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.IbmServiceInstanceID),
@@ -53,7 +66,7 @@ func (mg *Bucket) ResolveReferences(ctx context.Context, c client.Reader) error
 
 // ResolveReferences resolves the crossplane reference
 func (mg *BucketConfig) ResolveReferences(ctx context.Context, c client.Reader) error {
-	r := reference.NewAPIResolver(c, mg)
+	r := common.NewAPIResolver(c, mg)
 
 	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
 		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Name),
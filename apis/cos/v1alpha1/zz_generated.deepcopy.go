@@ -22,6 +22,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
 	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -323,6 +324,11 @@ func (in *BucketPararams) DeepCopyInto(out *BucketPararams) {
 		*out = new(corev1alpha1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.IbmServiceInstanceIDGenericRef != nil {
+		in, out := &in.IbmServiceInstanceIDGenericRef, &out.IbmServiceInstanceIDGenericRef
+		*out = new(v1beta1.GenericReference)
+		**out = **in
+	}
 	if in.IbmSSEKpEncryptionAlgorithm != nil {
 		in, out := &in.IbmSSEKpEncryptionAlgorithm, &out.IbmSSEKpEncryptionAlgorithm
 		*out = new(string)
@@ -333,6 +339,21 @@ func (in *BucketPararams) DeepCopyInto(out *BucketPararams) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IbmSSEKpCustomerRootKeyCrnGenericRef != nil {
+		in, out := &in.IbmSSEKpCustomerRootKeyCrnGenericRef, &out.IbmSSEKpCustomerRootKeyCrnGenericRef
+		*out = new(v1beta1.GenericReference)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AccessTags != nil {
+		in, out := &in.AccessTags, &out.AccessTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketPararams.
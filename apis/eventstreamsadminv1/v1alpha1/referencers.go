@@ -29,6 +29,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/common"
 	rcv2 "github.com/crossplane-contrib/provider-ibm-cloud/apis/resourcecontrollerv2/v1alpha1"
 )
 
@@ -44,7 +45,7 @@ const (
 // 1. use the resolver on a resource key to obtain the namespage and name of the secret from writeConnectionSecretToRef
 // 2. use that namespace and name with the client.Reader to get the secret and extract the kafka_admin_url from there
 func (mg *Topic) ResolveReferences(ctx context.Context, c client.Reader) error {
-	r := reference.NewAPIResolver(c, mg)
+	r := common.NewAPIResolver(c, mg)
 
 	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
 		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.KafkaAdminURL),
@@ -46,6 +46,13 @@ type GroupMembershipParameters struct {
 	// An array of member objects to add to an access group.
 	Members []AddGroupMembersRequestMembersItem `json:"members"`
 
+	// Whether Members is the complete set of members of the access group (the default), in which case any other member
+	// is removed from the group. When false, the members added to the group by other means are left alone: only the
+	// members that this GroupMembership added, tracked in 'status.atProvider.managedMembers', are removed from the group
+	// when they are removed from Members, or when this GroupMembership is deleted.
+	// +optional
+	Exclusive *bool `json:"exclusive,omitempty"`
+
 	// An optional transaction id for the request.
 	//+optional
 	TransactionID *string `json:"transactionID,omitempty"`
@@ -53,10 +60,40 @@ type GroupMembershipParameters struct {
 
 // AddGroupMembersRequestMembersItem : AddGroupMembersRequestMembersItem struct
 type AddGroupMembersRequestMembersItem struct {
-	// The IBMid or Service Id of the member.
-	IamID string `json:"iamId"`
+	// The IBMid, Service Id or trusted profile ID of the member.
+	//
+	// Note:
+	//    One of 'IamID', 'ServiceIDRef', 'ServiceIDSelector', 'TrustedProfileRef', 'TrustedProfileSelector', 'UserRef',
+	//    'UserSelector' should be specified
+	//
+	// +optional
+	IamID string `json:"iamId,omitempty"`
+
+	// Reference to a ServiceID, whose iam_id is used to set IamID
+	// +optional
+	ServiceIDRef *runtimev1alpha1.Reference `json:"serviceIdRef,omitempty"`
+
+	// Selector for a ServiceID, whose iam_id is used to set IamID
+	// +optional
+	ServiceIDSelector *runtimev1alpha1.Selector `json:"serviceIdSelector,omitempty"`
+
+	// Reference to a TrustedProfile, whose iam_id is used to set IamID
+	// +optional
+	TrustedProfileRef *runtimev1alpha1.Reference `json:"trustedProfileRef,omitempty"`
+
+	// Selector for a TrustedProfile, whose iam_id is used to set IamID
+	// +optional
+	TrustedProfileSelector *runtimev1alpha1.Selector `json:"trustedProfileSelector,omitempty"`
 
-	// The type of the member, must be either "user" or "service".
+	// Reference to a User, whose iam_id is used to set IamID
+	// +optional
+	UserRef *runtimev1alpha1.Reference `json:"userRef,omitempty"`
+
+	// Selector for a User, whose iam_id is used to set IamID
+	// +optional
+	UserSelector *runtimev1alpha1.Selector `json:"userSelector,omitempty"`
+
+	// The type of the member, must be either "user", "service" or "profile".
 	Type string `json:"type"`
 }
 
@@ -67,11 +104,33 @@ type GroupMembershipObservation struct {
 
 	// The current state of the group
 	State string `json:"state,omitempty"`
+
+	// The IAM IDs of the members that this GroupMembership added to the access group, when it is not exclusive.
+	// They are saved in the ibmcloud.crossplane.io/managed-members annotation, which is what deletion relies on.
+	ManagedMembers []string `json:"managedMembers,omitempty"`
+
+	// The results of the last additions and removals of members, per member.
+	MemberResults []MemberResult `json:"memberResults,omitempty"`
+}
+
+// MemberResult : The result of the addition or removal of a member of an access group.
+type MemberResult struct {
+	// The IBMid, Service Id or trusted profile ID of the member.
+	IamID string `json:"iamId"`
+
+	// The operation on the member; either 'add' or 'remove'.
+	Operation string `json:"operation"`
+
+	// The HTTP status code of the operation on the member.
+	StatusCode int64 `json:"statusCode,omitempty"`
+
+	// The error of the operation on the member, if it failed.
+	Error string `json:"error,omitempty"`
 }
 
 // ListGroupMembersResponseMember : A single member of an access group in a list.
 type ListGroupMembersResponseMember struct {
-	// The IBMid or Service Id of the member.
+	// The IBMid, Service Id or trusted profile ID of the member.
 	IamID string `json:"iamId,omitempty"`
 
 	// The member type - either `user` or `service`.
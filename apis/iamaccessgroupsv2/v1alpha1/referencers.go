@@ -18,17 +18,22 @@ package v1alpha1
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/common"
+	iamidv1 "github.com/crossplane-contrib/provider-ibm-cloud/apis/iamidentityv1/v1alpha1"
+	umv1 "github.com/crossplane-contrib/provider-ibm-cloud/apis/usermanagementv1/v1alpha1"
 )
 
 // ResolveReferences of this GroupMembership
 func (mg *GroupMembership) ResolveReferences(ctx context.Context, c client.Reader) error {
-	r := reference.NewAPIResolver(c, mg)
+	r := common.NewAPIResolver(c, mg)
 
 	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
 		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.AccessGroupID),
@@ -42,12 +47,54 @@ func (mg *GroupMembership) ResolveReferences(ctx context.Context, c client.Reade
 	}
 	mg.Spec.ForProvider.AccessGroupID = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.AccessGroupIDRef = rsp.ResolvedReference
+
+	for i := range mg.Spec.ForProvider.Members {
+		m := &mg.Spec.ForProvider.Members[i]
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: m.IamID,
+			Reference:    m.ServiceIDRef,
+			Selector:     m.ServiceIDSelector,
+			To:           reference.To{Managed: &iamidv1.ServiceID{}, List: &iamidv1.ServiceIDList{}},
+			Extract:      iamidv1.ServiceIDIamID(),
+		})
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("spec.forProvider.members[%d].iamId", i))
+		}
+		m.IamID = rsp.ResolvedValue
+		m.ServiceIDRef = rsp.ResolvedReference
+
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: m.IamID,
+			Reference:    m.TrustedProfileRef,
+			Selector:     m.TrustedProfileSelector,
+			To:           reference.To{Managed: &iamidv1.TrustedProfile{}, List: &iamidv1.TrustedProfileList{}},
+			Extract:      iamidv1.TrustedProfileIamID(),
+		})
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("spec.forProvider.members[%d].iamId", i))
+		}
+		m.IamID = rsp.ResolvedValue
+		m.TrustedProfileRef = rsp.ResolvedReference
+
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: m.IamID,
+			Reference:    m.UserRef,
+			Selector:     m.UserSelector,
+			To:           reference.To{Managed: &umv1.User{}, List: &umv1.UserList{}},
+			Extract:      umv1.UserIamID(),
+		})
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("spec.forProvider.members[%d].iamId", i))
+		}
+		m.IamID = rsp.ResolvedValue
+		m.UserRef = rsp.ResolvedReference
+	}
 	return nil
 }
 
 // ResolveReferences of this AccessGroupRule
 func (mg *AccessGroupRule) ResolveReferences(ctx context.Context, c client.Reader) error {
-	r := reference.NewAPIResolver(c, mg)
+	r := common.NewAPIResolver(c, mg)
 
 	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
 		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.AccessGroupID),
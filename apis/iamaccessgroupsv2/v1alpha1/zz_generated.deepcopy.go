@@ -326,6 +326,36 @@ func (in *AccessGroupStatus) DeepCopy() *AccessGroupStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AddGroupMembersRequestMembersItem) DeepCopyInto(out *AddGroupMembersRequestMembersItem) {
 	*out = *in
+	if in.ServiceIDRef != nil {
+		in, out := &in.ServiceIDRef, &out.ServiceIDRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.ServiceIDSelector != nil {
+		in, out := &in.ServiceIDSelector, &out.ServiceIDSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TrustedProfileRef != nil {
+		in, out := &in.TrustedProfileRef, &out.TrustedProfileRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.TrustedProfileSelector != nil {
+		in, out := &in.TrustedProfileSelector, &out.TrustedProfileSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserRef != nil {
+		in, out := &in.UserRef, &out.UserRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.UserSelector != nil {
+		in, out := &in.UserSelector, &out.UserSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddGroupMembersRequestMembersItem.
@@ -407,6 +437,16 @@ func (in *GroupMembershipObservation) DeepCopyInto(out *GroupMembershipObservati
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ManagedMembers != nil {
+		in, out := &in.ManagedMembers, &out.ManagedMembers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MemberResults != nil {
+		in, out := &in.MemberResults, &out.MemberResults
+		*out = make([]MemberResult, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupMembershipObservation.
@@ -440,7 +480,14 @@ func (in *GroupMembershipParameters) DeepCopyInto(out *GroupMembershipParameters
 	if in.Members != nil {
 		in, out := &in.Members, &out.Members
 		*out = make([]AddGroupMembersRequestMembersItem, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Exclusive != nil {
+		in, out := &in.Exclusive, &out.Exclusive
+		*out = new(bool)
+		**out = **in
 	}
 	if in.TransactionID != nil {
 		in, out := &in.TransactionID, &out.TransactionID
@@ -512,6 +559,21 @@ func (in *ListGroupMembersResponseMember) DeepCopy() *ListGroupMembersResponseMe
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemberResult) DeepCopyInto(out *MemberResult) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberResult.
+func (in *MemberResult) DeepCopy() *MemberResult {
+	if in == nil {
+		return nil
+	}
+	out := new(MemberResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RuleCondition) DeepCopyInto(out *RuleCondition) {
 	*out = *in
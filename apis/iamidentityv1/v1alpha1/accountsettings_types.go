@@ -0,0 +1,120 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// In spec mandatory fields should be by value, and optional fields pointers
+// In status, all fields should be by value, except timestamps - metav1.Time, and runtime.RawExtension which requires special treatment
+// https://github.com/crossplane/crossplane/blob/master/design/one-pager-managed-resource-api-design.md#pointer-types-and-markers
+
+// AccountSettingsParameters are the configurable fields of the IAM settings of an account.
+type AccountSettingsParameters struct {
+	// ID of the account the settings belong to. There is a single set of settings per account, so there should be a
+	// single AccountSettings per account.
+	// +immutable
+	AccountID string `json:"accountId"`
+
+	// Defines whether or not creating a Service Id is access controlled.
+	// +kubebuilder:validation:Enum=RESTRICTED;NOT_RESTRICTED;NOT_SET
+	// +optional
+	RestrictCreateServiceID *string `json:"restrictCreateServiceId,omitempty"`
+
+	// Defines whether or not creating platform API keys is access controlled.
+	// +kubebuilder:validation:Enum=RESTRICTED;NOT_RESTRICTED;NOT_SET
+	// +optional
+	RestrictCreatePlatformApikey *string `json:"restrictCreatePlatformApikey,omitempty"`
+
+	// Defines the IP addresses and subnets from which IAM tokens can be created for the account, as a comma separated
+	// list.
+	// +optional
+	AllowedIPAddresses *string `json:"allowedIpAddresses,omitempty"`
+
+	// Defines the MFA trait for the account. Valid values:
+	//   * NONE - No MFA trait set
+	//   * TOTP - For all non-federated IBMId users
+	//   * TOTP4ALL - For all users
+	//   * LEVEL1 - Email-based MFA for all users
+	//   * LEVEL2 - TOTP-based MFA for all users
+	//   * LEVEL3 - U2F MFA for all users.
+	// +kubebuilder:validation:Enum=NONE;TOTP;TOTP4ALL;LEVEL1;LEVEL2;LEVEL3
+	// +optional
+	Mfa *string `json:"mfa,omitempty"`
+
+	// Defines the session expiration in seconds for the account: any whole number between '900' and '86400', or
+	// NOT_SET to use the service default.
+	// +optional
+	SessionExpirationInSeconds *string `json:"sessionExpirationInSeconds,omitempty"`
+
+	// Defines the period of time in seconds in which a session will be invalidated due to inactivity: any whole number
+	// between '900' and '7200', or NOT_SET to use the service default.
+	// +optional
+	SessionInvalidationInSeconds *string `json:"sessionInvalidationInSeconds,omitempty"`
+}
+
+// AccountSettingsObservation are the observable fields of the IAM settings of an account.
+type AccountSettingsObservation struct {
+	// Version of the account settings. You need to specify this value when updating the settings to avoid stale
+	// updates.
+	EntityTag string `json:"entityTag,omitempty"`
+
+	// The current state of the account settings
+	State string `json:"state,omitempty"`
+}
+
+// An AccountSettingsSpec defines the desired state of an AccountSettings.
+type AccountSettingsSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  AccountSettingsParameters `json:"forProvider"`
+}
+
+// An AccountSettingsStatus represents the observed state of an AccountSettings.
+type AccountSettingsStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     AccountSettingsObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AccountSettings represents the IAM settings of an account on IBM Cloud. The settings always exist: creating an
+// AccountSettings starts managing them, and deleting it leaves them as they are.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.bindingPhase"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="CLASS",type="string",JSONPath=".spec.classRef.name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,ibmcloud}
+type AccountSettings struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccountSettingsSpec   `json:"spec"`
+	Status AccountSettingsStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccountSettingsList contains a list of AccountSettings
+type AccountSettingsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccountSettings `json:"items"`
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// In spec mandatory fields should be by value, and optional fields pointers
+// In status, all fields should be by value, except timestamps - metav1.Time, and runtime.RawExtension which requires special treatment
+// https://github.com/crossplane/crossplane/blob/master/design/one-pager-managed-resource-api-design.md#pointer-types-and-markers
+
+// APIKeyParameters are the configurable fields of an APIKey.
+type APIKeyParameters struct {
+	// Name of the API key. The name is not checked for uniqueness. Therefore multiple names with the same value can
+	// exist. Access is done via the UUID of the API key.
+	Name string `json:"name"`
+
+	// The optional description of the API key.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// The iam_id that this API key authenticates.
+	// +immutable
+	// +optional
+	IamID *string `json:"iamId,omitempty"`
+
+	// Reference to the ServiceID whose iam_id the API key authenticates
+	// +immutable
+	// +optional
+	IamIDRef *runtimev1alpha1.Reference `json:"iamIdRef,omitempty"`
+
+	// Selector for the ServiceID whose iam_id the API key authenticates
+	// +immutable
+	// +optional
+	IamIDSelector *runtimev1alpha1.Selector `json:"iamIdSelector,omitempty"`
+
+	// The account ID of the API key.
+	// +immutable
+	// +optional
+	AccountID *string `json:"accountId,omitempty"`
+
+	// Set to true to allow the value of the API key to be retrieved later, e.g. by the provider when the connection
+	// secret has to be written again. By default the value is only returned when the API key is created.
+	// +immutable
+	// +optional
+	StoreValue *bool `json:"storeValue,omitempty"`
+
+	// Set to true to lock the API key, which then cannot be changed or deleted, unless it is unlocked first. The
+	// provider unlocks the API key when it has to update or delete it.
+	// +optional
+	Locked *bool `json:"locked,omitempty"`
+}
+
+// APIKeyObservation are the observable fields of an APIKey.
+type APIKeyObservation struct {
+	// Unique identifier of this API Key.
+	ID string `json:"id,omitempty"`
+
+	// Version of the API Key details object. You need to specify this value when updating the API key to avoid stale
+	// updates.
+	EntityTag string `json:"entityTag,omitempty"`
+
+	// Cloud Resource Name of the item. Example Cloud Resource Name:
+	// 'crn:v1:bluemix:public:iam-identity:us-south:a/myaccount::apikey:1234-9012-5678'.
+	CRN string `json:"crn,omitempty"`
+
+	// The API key cannot be changed if set to true.
+	Locked bool `json:"locked,omitempty"`
+
+	// If set contains a date time string of the creation date in ISO format.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// IAM ID of the user or service which created the API key.
+	CreatedBy string `json:"createdBy,omitempty"`
+
+	// If set contains a date time string of the last modification date in ISO format.
+	ModifiedAt *metav1.Time `json:"modifiedAt,omitempty"`
+
+	// The current state of the API key
+	State string `json:"state,omitempty"`
+}
+
+// An APIKeySpec defines the desired state of an APIKey.
+type APIKeySpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  APIKeyParameters `json:"forProvider"`
+}
+
+// An APIKeyStatus represents the observed state of an APIKey.
+type APIKeyStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     APIKeyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An APIKey represents an instance of an IAM API key on IBM Cloud. The value of the API key is published in the
+// `apikey` key of its connection secret.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.bindingPhase"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="LOCKED",type="boolean",JSONPath=".status.atProvider.locked"
+// +kubebuilder:printcolumn:name="CLASS",type="string",JSONPath=".spec.classRef.name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,ibmcloud}
+type APIKey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   APIKeySpec   `json:"spec"`
+	Status APIKeyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// APIKeyList contains a list of APIKey
+type APIKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []APIKey `json:"items"`
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/common"
+	containerv2 "github.com/crossplane-contrib/provider-ibm-cloud/apis/container/containerv2/v1alpha1"
+)
+
+// ResolveReferences of this APIKey
+func (mg *APIKey) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := common.NewAPIResolver(c, mg)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.IamID),
+		Reference:    mg.Spec.ForProvider.IamIDRef,
+		Selector:     mg.Spec.ForProvider.IamIDSelector,
+		To:           reference.To{Managed: &ServiceID{}, List: &ServiceIDList{}},
+		Extract:      ServiceIDIamID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.iamId")
+	}
+	mg.Spec.ForProvider.IamID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.IamIDRef = rsp.ResolvedReference
+	return nil
+}
+
+// ResolveReferences of this TrustedProfileClaimRule
+func (mg *TrustedProfileClaimRule) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := common.NewAPIResolver(c, mg)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ProfileID),
+		Reference:    mg.Spec.ForProvider.ProfileIDRef,
+		Selector:     mg.Spec.ForProvider.ProfileIDSelector,
+		To:           reference.To{Managed: &TrustedProfile{}, List: &TrustedProfileList{}},
+		Extract:      TrustedProfileID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.profileId")
+	}
+	mg.Spec.ForProvider.ProfileID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ProfileIDRef = rsp.ResolvedReference
+	return nil
+}
+
+// ResolveReferences of this TrustedProfileLink
+func (mg *TrustedProfileLink) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := common.NewAPIResolver(c, mg)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ProfileID),
+		Reference:    mg.Spec.ForProvider.ProfileIDRef,
+		Selector:     mg.Spec.ForProvider.ProfileIDSelector,
+		To:           reference.To{Managed: &TrustedProfile{}, List: &TrustedProfileList{}},
+		Extract:      TrustedProfileID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.profileId")
+	}
+	mg.Spec.ForProvider.ProfileID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ProfileIDRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Link.CRN,
+		Reference:    mg.Spec.ForProvider.Link.ClusterRef,
+		Selector:     mg.Spec.ForProvider.Link.ClusterSelector,
+		To:           reference.To{Managed: &containerv2.Cluster{}, List: &containerv2.ClusterList{}},
+		Extract:      clusterCRN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.link.crn")
+	}
+	mg.Spec.ForProvider.Link.CRN = rsp.ResolvedValue
+	mg.Spec.ForProvider.Link.ClusterRef = rsp.ResolvedReference
+	return nil
+}
+
+// ServiceIDIamID extracts the resolved iam_id of a ServiceID
+func ServiceIDIamID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		cr, ok := mg.(*ServiceID)
+		if !ok {
+			return ""
+		}
+		return cr.Status.AtProvider.IamID
+	}
+}
+
+// TrustedProfileID extracts the resolved ID of a TrustedProfile
+func TrustedProfileID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		cr, ok := mg.(*TrustedProfile)
+		if !ok {
+			return ""
+		}
+		return cr.Status.AtProvider.ID
+	}
+}
+
+// TrustedProfileIamID extracts the resolved iam_id of a TrustedProfile
+func TrustedProfileIamID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		cr, ok := mg.(*TrustedProfile)
+		if !ok {
+			return ""
+		}
+		return cr.Status.AtProvider.IamID
+	}
+}
+
+// Extracts the resolved CRN of a Cluster
+func clusterCRN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		cr, ok := mg.(*containerv2.Cluster)
+		if !ok {
+			return ""
+		}
+		return cr.Status.AtProvider.CRN
+	}
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "iamidentityv1.ibmcloud.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// Iamidentityv1 types metadata.
+var (
+	ServiceIDKind             = reflect.TypeOf(ServiceID{}).Name()
+	ServiceIDGroupKind        = schema.GroupKind{Group: Group, Kind: ServiceIDKind}.String()
+	ServiceIDKindAPIVersion   = ServiceIDKind + "." + SchemeGroupVersion.String()
+	ServiceIDGroupVersionKind = SchemeGroupVersion.WithKind(ServiceIDKind)
+
+	APIKeyKind             = reflect.TypeOf(APIKey{}).Name()
+	APIKeyGroupKind        = schema.GroupKind{Group: Group, Kind: APIKeyKind}.String()
+	APIKeyKindAPIVersion   = APIKeyKind + "." + SchemeGroupVersion.String()
+	APIKeyGroupVersionKind = SchemeGroupVersion.WithKind(APIKeyKind)
+
+	TrustedProfileKind             = reflect.TypeOf(TrustedProfile{}).Name()
+	TrustedProfileGroupKind        = schema.GroupKind{Group: Group, Kind: TrustedProfileKind}.String()
+	TrustedProfileKindAPIVersion   = TrustedProfileKind + "." + SchemeGroupVersion.String()
+	TrustedProfileGroupVersionKind = SchemeGroupVersion.WithKind(TrustedProfileKind)
+
+	TrustedProfileClaimRuleKind             = reflect.TypeOf(TrustedProfileClaimRule{}).Name()
+	TrustedProfileClaimRuleGroupKind        = schema.GroupKind{Group: Group, Kind: TrustedProfileClaimRuleKind}.String()
+	TrustedProfileClaimRuleKindAPIVersion   = TrustedProfileClaimRuleKind + "." + SchemeGroupVersion.String()
+	TrustedProfileClaimRuleGroupVersionKind = SchemeGroupVersion.WithKind(TrustedProfileClaimRuleKind)
+
+	TrustedProfileLinkKind             = reflect.TypeOf(TrustedProfileLink{}).Name()
+	TrustedProfileLinkGroupKind        = schema.GroupKind{Group: Group, Kind: TrustedProfileLinkKind}.String()
+	TrustedProfileLinkKindAPIVersion   = TrustedProfileLinkKind + "." + SchemeGroupVersion.String()
+	TrustedProfileLinkGroupVersionKind = SchemeGroupVersion.WithKind(TrustedProfileLinkKind)
+
+	AccountSettingsKind             = reflect.TypeOf(AccountSettings{}).Name()
+	AccountSettingsGroupKind        = schema.GroupKind{Group: Group, Kind: AccountSettingsKind}.String()
+	AccountSettingsKindAPIVersion   = AccountSettingsKind + "." + SchemeGroupVersion.String()
+	AccountSettingsGroupVersionKind = SchemeGroupVersion.WithKind(AccountSettingsKind)
+)
+
+func init() {
+	SchemeBuilder.Register(
+		&ServiceID{},
+		&ServiceIDList{},
+		&APIKey{},
+		&APIKeyList{},
+		&TrustedProfile{},
+		&TrustedProfileList{},
+		&TrustedProfileClaimRule{},
+		&TrustedProfileClaimRuleList{},
+		&TrustedProfileLink{},
+		&TrustedProfileLinkList{},
+		&AccountSettings{},
+		&AccountSettingsList{},
+	)
+}
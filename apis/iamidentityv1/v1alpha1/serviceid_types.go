@@ -0,0 +1,114 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// In spec mandatory fields should be by value, and optional fields pointers
+// In status, all fields should be by value, except timestamps - metav1.Time, and runtime.RawExtension which requires special treatment
+// https://github.com/crossplane/crossplane/blob/master/design/one-pager-managed-resource-api-design.md#pointer-types-and-markers
+
+// ServiceIDParameters are the configurable fields of a ServiceID.
+type ServiceIDParameters struct {
+	// ID of the account the service ID belongs to.
+	// +immutable
+	AccountID string `json:"accountId"`
+
+	// Name of the Service Id. The name is not checked for uniqueness. Therefore multiple names with the same value can
+	// exist. Access is done via the UUID of the Service Id.
+	Name string `json:"name"`
+
+	// The optional description of the Service Id. The 'description' property is only available if a description was
+	// provided during a create of a Service Id.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Optional list of CRNs (string array) which point to the services connected to the Service Id.
+	// +optional
+	UniqueInstanceCrns []string `json:"uniqueInstanceCrns,omitempty"`
+}
+
+// ServiceIDObservation are the observable fields of a ServiceID.
+type ServiceIDObservation struct {
+	// Unique identifier of this Service Id.
+	ID string `json:"id,omitempty"`
+
+	// Cloud wide identifier for identities of this service ID.
+	IamID string `json:"iamId,omitempty"`
+
+	// Version of the service ID details object. You need to specify this value when updating the service ID to avoid
+	// stale updates.
+	EntityTag string `json:"entityTag,omitempty"`
+
+	// Cloud Resource Name of the item. Example Cloud Resource Name:
+	// 'crn:v1:bluemix:public:iam-identity:us-south:a/myaccount::serviceid:1234-5678-9012'.
+	CRN string `json:"crn,omitempty"`
+
+	// The service ID cannot be changed if set to true.
+	Locked bool `json:"locked,omitempty"`
+
+	// If set contains a date time string of the creation date in ISO format.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// If set contains a date time string of the last modification date in ISO format.
+	ModifiedAt *metav1.Time `json:"modifiedAt,omitempty"`
+
+	// The current state of the service ID
+	State string `json:"state,omitempty"`
+}
+
+// A ServiceIDSpec defines the desired state of a ServiceID.
+type ServiceIDSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ServiceIDParameters `json:"forProvider"`
+}
+
+// A ServiceIDStatus represents the observed state of a ServiceID.
+type ServiceIDStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ServiceIDObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ServiceID represents an instance of an IAM service ID on IBM Cloud
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.bindingPhase"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="CLASS",type="string",JSONPath=".spec.classRef.name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,ibmcloud}
+type ServiceID struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceIDSpec   `json:"spec"`
+	Status ServiceIDStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceIDList contains a list of ServiceID
+type ServiceIDList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceID `json:"items"`
+}
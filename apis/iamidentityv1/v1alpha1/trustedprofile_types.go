@@ -0,0 +1,106 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// In spec mandatory fields should be by value, and optional fields pointers
+// In status, all fields should be by value, except timestamps - metav1.Time, and runtime.RawExtension which requires special treatment
+// https://github.com/crossplane/crossplane/blob/master/design/one-pager-managed-resource-api-design.md#pointer-types-and-markers
+
+// TrustedProfileParameters are the configurable fields of a TrustedProfile.
+type TrustedProfileParameters struct {
+	// ID of the account the trusted profile belongs to.
+	// +immutable
+	AccountID string `json:"accountId"`
+
+	// Name of the trusted profile. The name is checked for uniqueness within the account.
+	Name string `json:"name"`
+
+	// The optional description of the trusted profile.
+	// +optional
+	Description *string `json:"description,omitempty"`
+}
+
+// TrustedProfileObservation are the observable fields of a TrustedProfile.
+type TrustedProfileObservation struct {
+	// Unique identifier of this trusted profile.
+	ID string `json:"id,omitempty"`
+
+	// Cloud wide identifier for identities of this trusted profile.
+	IamID string `json:"iamId,omitempty"`
+
+	// Version of the trusted profile details object. You need to specify this value when updating the trusted profile
+	// to avoid stale updates.
+	EntityTag string `json:"entityTag,omitempty"`
+
+	// Cloud Resource Name of the item. Example Cloud Resource Name:
+	// 'crn:v1:bluemix:public:iam-identity:us-south:a/myaccount::profile:Profile-1234-5678-9012'.
+	CRN string `json:"crn,omitempty"`
+
+	// If set contains a date time string of the creation date in ISO format.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// If set contains a date time string of the last modification date in ISO format.
+	ModifiedAt *metav1.Time `json:"modifiedAt,omitempty"`
+
+	// The current state of the trusted profile
+	State string `json:"state,omitempty"`
+}
+
+// A TrustedProfileSpec defines the desired state of a TrustedProfile.
+type TrustedProfileSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  TrustedProfileParameters `json:"forProvider"`
+}
+
+// A TrustedProfileStatus represents the observed state of a TrustedProfile.
+type TrustedProfileStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     TrustedProfileObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TrustedProfile represents an instance of an IAM trusted profile on IBM Cloud. Federated users and compute
+// resources (see TrustedProfileClaimRule and TrustedProfileLink) can assume the IAM identity of a trusted profile.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.bindingPhase"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="CLASS",type="string",JSONPath=".spec.classRef.name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,ibmcloud}
+type TrustedProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TrustedProfileSpec   `json:"spec"`
+	Status TrustedProfileStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TrustedProfileList contains a list of TrustedProfile
+type TrustedProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TrustedProfile `json:"items"`
+}
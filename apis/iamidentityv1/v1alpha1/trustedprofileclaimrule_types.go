@@ -0,0 +1,139 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// In spec mandatory fields should be by value, and optional fields pointers
+// In status, all fields should be by value, except timestamps - metav1.Time, and runtime.RawExtension which requires special treatment
+// https://github.com/crossplane/crossplane/blob/master/design/one-pager-managed-resource-api-design.md#pointer-types-and-markers
+
+// ProfileClaimRuleCondition is a condition on a claim of the identity trying to assume the trusted profile.
+type ProfileClaimRuleCondition struct {
+	// The claim to evaluate against, e.g. 'blueGroups' for a SAML assertion, or 'namespace' for a compute resource.
+	Claim string `json:"claim"`
+
+	// The operation to perform on the claim.
+	// +kubebuilder:validation:Enum=EQUALS;NOT_EQUALS;EQUALS_IGNORE_CASE;NOT_EQUALS_IGNORE_CASE;CONTAINS;IN
+	Operator string `json:"operator"`
+
+	// The stringified JSON value that the claim is compared to using the operator.
+	Value string `json:"value"`
+}
+
+// TrustedProfileClaimRuleParameters are the configurable fields of a TrustedProfileClaimRule.
+type TrustedProfileClaimRuleParameters struct {
+	// ID of the trusted profile the claim rule belongs to.
+	// +immutable
+	// +optional
+	ProfileID *string `json:"profileId,omitempty"`
+
+	// Reference to the TrustedProfile the claim rule belongs to
+	// +immutable
+	// +optional
+	ProfileIDRef *runtimev1alpha1.Reference `json:"profileIdRef,omitempty"`
+
+	// Selector for the TrustedProfile the claim rule belongs to
+	// +immutable
+	// +optional
+	ProfileIDSelector *runtimev1alpha1.Selector `json:"profileIdSelector,omitempty"`
+
+	// Type of the claim rule, either 'Profile-SAML' (for federated users) or 'Profile-CR' (for compute resources).
+	// +kubebuilder:validation:Enum=Profile-SAML;Profile-CR
+	Type string `json:"type"`
+
+	// Conditions of the claim rule. All conditions must be met for an identity to assume the trusted profile.
+	Conditions []ProfileClaimRuleCondition `json:"conditions"`
+
+	// Name of the claim rule.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// The realm name of the identity provider. Required for 'Profile-SAML' claim rules.
+	// +optional
+	RealmName *string `json:"realmName,omitempty"`
+
+	// The compute resource type the claim rule applies to. Required for 'Profile-CR' claim rules.
+	// +kubebuilder:validation:Enum=IKS_SA;ROKS_SA;VSI
+	// +optional
+	CRType *string `json:"crType,omitempty"`
+
+	// Session expiration in seconds, only for 'Profile-SAML' claim rules.
+	// +optional
+	Expiration *int64 `json:"expiration,omitempty"`
+}
+
+// TrustedProfileClaimRuleObservation are the observable fields of a TrustedProfileClaimRule.
+type TrustedProfileClaimRuleObservation struct {
+	// Unique identifier of this claim rule.
+	ID string `json:"id,omitempty"`
+
+	// Version of the claim rule. You need to specify this value when updating the claim rule to avoid stale updates.
+	EntityTag string `json:"entityTag,omitempty"`
+
+	// If set contains a date time string of the creation date in ISO format.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// If set contains a date time string of the last modification date in ISO format.
+	ModifiedAt *metav1.Time `json:"modifiedAt,omitempty"`
+
+	// The current state of the claim rule
+	State string `json:"state,omitempty"`
+}
+
+// A TrustedProfileClaimRuleSpec defines the desired state of a TrustedProfileClaimRule.
+type TrustedProfileClaimRuleSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  TrustedProfileClaimRuleParameters `json:"forProvider"`
+}
+
+// A TrustedProfileClaimRuleStatus represents the observed state of a TrustedProfileClaimRule.
+type TrustedProfileClaimRuleStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     TrustedProfileClaimRuleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TrustedProfileClaimRule represents a claim rule of an IAM trusted profile on IBM Cloud. Identities whose claims
+// meet the conditions of the rule can assume the trusted profile.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.bindingPhase"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="CLASS",type="string",JSONPath=".spec.classRef.name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,ibmcloud}
+type TrustedProfileClaimRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TrustedProfileClaimRuleSpec   `json:"spec"`
+	Status TrustedProfileClaimRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TrustedProfileClaimRuleList contains a list of TrustedProfileClaimRule
+type TrustedProfileClaimRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TrustedProfileClaimRule `json:"items"`
+}
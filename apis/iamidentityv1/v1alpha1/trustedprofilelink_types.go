@@ -0,0 +1,144 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// In spec mandatory fields should be by value, and optional fields pointers
+// In status, all fields should be by value, except timestamps - metav1.Time, and runtime.RawExtension which requires special treatment
+// https://github.com/crossplane/crossplane/blob/master/design/one-pager-managed-resource-api-design.md#pointer-types-and-markers
+
+// ProfileLinkLink identifies the compute resource linked to the trusted profile.
+type ProfileLinkLink struct {
+	// The CRN of the compute resource, e.g. of the Kubernetes or OpenShift cluster.
+	//
+	// Note:
+	//    One of 'CRN', 'ClusterRef', 'ClusterSelector' should be specified
+	//
+	// +optional
+	CRN string `json:"crn,omitempty"`
+
+	// Reference to a Cluster, whose CRN is used to set CRN
+	// +optional
+	ClusterRef *runtimev1alpha1.Reference `json:"clusterRef,omitempty"`
+
+	// Selector for a Cluster, whose CRN is used to set CRN
+	// +optional
+	ClusterSelector *runtimev1alpha1.Selector `json:"clusterSelector,omitempty"`
+
+	// The namespace of the service account in the cluster.
+	Namespace string `json:"namespace"`
+
+	// The name of the service account in the cluster.
+	// +optional
+	ServiceAccount *string `json:"serviceAccount,omitempty"`
+}
+
+// TrustedProfileLinkParameters are the configurable fields of a TrustedProfileLink. The parameters of a link cannot be
+// changed once it has been created.
+type TrustedProfileLinkParameters struct {
+	// ID of the trusted profile the link belongs to.
+	// +immutable
+	// +optional
+	ProfileID *string `json:"profileId,omitempty"`
+
+	// Reference to the TrustedProfile the link belongs to
+	// +immutable
+	// +optional
+	ProfileIDRef *runtimev1alpha1.Reference `json:"profileIdRef,omitempty"`
+
+	// Selector for the TrustedProfile the link belongs to
+	// +immutable
+	// +optional
+	ProfileIDSelector *runtimev1alpha1.Selector `json:"profileIdSelector,omitempty"`
+
+	// Name of the link.
+	// +immutable
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// The compute resource type, 'IKS_SA' for a service account of a Kubernetes cluster, or 'ROKS_SA' for a service
+	// account of an OpenShift cluster.
+	// +kubebuilder:validation:Enum=IKS_SA;ROKS_SA
+	// +immutable
+	CRType string `json:"crType"`
+
+	// The compute resource linked to the trusted profile.
+	// +immutable
+	Link ProfileLinkLink `json:"link"`
+}
+
+// TrustedProfileLinkObservation are the observable fields of a TrustedProfileLink.
+type TrustedProfileLinkObservation struct {
+	// Unique identifier of this link.
+	ID string `json:"id,omitempty"`
+
+	// Version of the link.
+	EntityTag string `json:"entityTag,omitempty"`
+
+	// If set contains a date time string of the creation date in ISO format.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// If set contains a date time string of the last modification date in ISO format.
+	ModifiedAt *metav1.Time `json:"modifiedAt,omitempty"`
+
+	// The current state of the link
+	State string `json:"state,omitempty"`
+}
+
+// A TrustedProfileLinkSpec defines the desired state of a TrustedProfileLink.
+type TrustedProfileLinkSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  TrustedProfileLinkParameters `json:"forProvider"`
+}
+
+// A TrustedProfileLinkStatus represents the observed state of a TrustedProfileLink.
+type TrustedProfileLinkStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     TrustedProfileLinkObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TrustedProfileLink represents a link between an IAM trusted profile and a compute resource on IBM Cloud, i.e. a
+// service account of a Kubernetes or OpenShift cluster, which can then assume the trusted profile.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.bindingPhase"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="CLASS",type="string",JSONPath=".spec.classRef.name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,ibmcloud}
+type TrustedProfileLink struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TrustedProfileLinkSpec   `json:"spec"`
+	Status TrustedProfileLinkStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TrustedProfileLinkList contains a list of TrustedProfileLink
+type TrustedProfileLinkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TrustedProfileLink `json:"items"`
+}
@@ -0,0 +1,991 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIKey) DeepCopyInto(out *APIKey) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIKey.
+func (in *APIKey) DeepCopy() *APIKey {
+	if in == nil {
+		return nil
+	}
+	out := new(APIKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *APIKey) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIKeyList) DeepCopyInto(out *APIKeyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]APIKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIKeyList.
+func (in *APIKeyList) DeepCopy() *APIKeyList {
+	if in == nil {
+		return nil
+	}
+	out := new(APIKeyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *APIKeyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIKeyObservation) DeepCopyInto(out *APIKeyObservation) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ModifiedAt != nil {
+		in, out := &in.ModifiedAt, &out.ModifiedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIKeyObservation.
+func (in *APIKeyObservation) DeepCopy() *APIKeyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(APIKeyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIKeyParameters) DeepCopyInto(out *APIKeyParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.IamID != nil {
+		in, out := &in.IamID, &out.IamID
+		*out = new(string)
+		**out = **in
+	}
+	if in.IamIDRef != nil {
+		in, out := &in.IamIDRef, &out.IamIDRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.IamIDSelector != nil {
+		in, out := &in.IamIDSelector, &out.IamIDSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AccountID != nil {
+		in, out := &in.AccountID, &out.AccountID
+		*out = new(string)
+		**out = **in
+	}
+	if in.StoreValue != nil {
+		in, out := &in.StoreValue, &out.StoreValue
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Locked != nil {
+		in, out := &in.Locked, &out.Locked
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIKeyParameters.
+func (in *APIKeyParameters) DeepCopy() *APIKeyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(APIKeyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIKeySpec) DeepCopyInto(out *APIKeySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIKeySpec.
+func (in *APIKeySpec) DeepCopy() *APIKeySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(APIKeySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIKeyStatus) DeepCopyInto(out *APIKeyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIKeyStatus.
+func (in *APIKeyStatus) DeepCopy() *APIKeyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(APIKeyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountSettings) DeepCopyInto(out *AccountSettings) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountSettings.
+func (in *AccountSettings) DeepCopy() *AccountSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccountSettings) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountSettingsList) DeepCopyInto(out *AccountSettingsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AccountSettings, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountSettingsList.
+func (in *AccountSettingsList) DeepCopy() *AccountSettingsList {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountSettingsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccountSettingsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountSettingsObservation) DeepCopyInto(out *AccountSettingsObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountSettingsObservation.
+func (in *AccountSettingsObservation) DeepCopy() *AccountSettingsObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountSettingsObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountSettingsParameters) DeepCopyInto(out *AccountSettingsParameters) {
+	*out = *in
+	if in.RestrictCreateServiceID != nil {
+		in, out := &in.RestrictCreateServiceID, &out.RestrictCreateServiceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.RestrictCreatePlatformApikey != nil {
+		in, out := &in.RestrictCreatePlatformApikey, &out.RestrictCreatePlatformApikey
+		*out = new(string)
+		**out = **in
+	}
+	if in.AllowedIPAddresses != nil {
+		in, out := &in.AllowedIPAddresses, &out.AllowedIPAddresses
+		*out = new(string)
+		**out = **in
+	}
+	if in.Mfa != nil {
+		in, out := &in.Mfa, &out.Mfa
+		*out = new(string)
+		**out = **in
+	}
+	if in.SessionExpirationInSeconds != nil {
+		in, out := &in.SessionExpirationInSeconds, &out.SessionExpirationInSeconds
+		*out = new(string)
+		**out = **in
+	}
+	if in.SessionInvalidationInSeconds != nil {
+		in, out := &in.SessionInvalidationInSeconds, &out.SessionInvalidationInSeconds
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountSettingsParameters.
+func (in *AccountSettingsParameters) DeepCopy() *AccountSettingsParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountSettingsParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountSettingsSpec) DeepCopyInto(out *AccountSettingsSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountSettingsSpec.
+func (in *AccountSettingsSpec) DeepCopy() *AccountSettingsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountSettingsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountSettingsStatus) DeepCopyInto(out *AccountSettingsStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountSettingsStatus.
+func (in *AccountSettingsStatus) DeepCopy() *AccountSettingsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountSettingsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfileClaimRuleCondition) DeepCopyInto(out *ProfileClaimRuleCondition) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileClaimRuleCondition.
+func (in *ProfileClaimRuleCondition) DeepCopy() *ProfileClaimRuleCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(ProfileClaimRuleCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfileLinkLink) DeepCopyInto(out *ProfileLinkLink) {
+	*out = *in
+	if in.ClusterRef != nil {
+		in, out := &in.ClusterRef, &out.ClusterRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.ClusterSelector != nil {
+		in, out := &in.ClusterSelector, &out.ClusterSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceAccount != nil {
+		in, out := &in.ServiceAccount, &out.ServiceAccount
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileLinkLink.
+func (in *ProfileLinkLink) DeepCopy() *ProfileLinkLink {
+	if in == nil {
+		return nil
+	}
+	out := new(ProfileLinkLink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceID) DeepCopyInto(out *ServiceID) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceID.
+func (in *ServiceID) DeepCopy() *ServiceID {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceID)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceID) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceIDList) DeepCopyInto(out *ServiceIDList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ServiceID, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceIDList.
+func (in *ServiceIDList) DeepCopy() *ServiceIDList {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceIDList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceIDList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceIDObservation) DeepCopyInto(out *ServiceIDObservation) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ModifiedAt != nil {
+		in, out := &in.ModifiedAt, &out.ModifiedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceIDObservation.
+func (in *ServiceIDObservation) DeepCopy() *ServiceIDObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceIDObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceIDParameters) DeepCopyInto(out *ServiceIDParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.UniqueInstanceCrns != nil {
+		in, out := &in.UniqueInstanceCrns, &out.UniqueInstanceCrns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceIDParameters.
+func (in *ServiceIDParameters) DeepCopy() *ServiceIDParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceIDParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceIDSpec) DeepCopyInto(out *ServiceIDSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceIDSpec.
+func (in *ServiceIDSpec) DeepCopy() *ServiceIDSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceIDSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceIDStatus) DeepCopyInto(out *ServiceIDStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceIDStatus.
+func (in *ServiceIDStatus) DeepCopy() *ServiceIDStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceIDStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustedProfile) DeepCopyInto(out *TrustedProfile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustedProfile.
+func (in *TrustedProfile) DeepCopy() *TrustedProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustedProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TrustedProfile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustedProfileClaimRule) DeepCopyInto(out *TrustedProfileClaimRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustedProfileClaimRule.
+func (in *TrustedProfileClaimRule) DeepCopy() *TrustedProfileClaimRule {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustedProfileClaimRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TrustedProfileClaimRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustedProfileClaimRuleList) DeepCopyInto(out *TrustedProfileClaimRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TrustedProfileClaimRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustedProfileClaimRuleList.
+func (in *TrustedProfileClaimRuleList) DeepCopy() *TrustedProfileClaimRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustedProfileClaimRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TrustedProfileClaimRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustedProfileClaimRuleObservation) DeepCopyInto(out *TrustedProfileClaimRuleObservation) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ModifiedAt != nil {
+		in, out := &in.ModifiedAt, &out.ModifiedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustedProfileClaimRuleObservation.
+func (in *TrustedProfileClaimRuleObservation) DeepCopy() *TrustedProfileClaimRuleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustedProfileClaimRuleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustedProfileClaimRuleParameters) DeepCopyInto(out *TrustedProfileClaimRuleParameters) {
+	*out = *in
+	if in.ProfileID != nil {
+		in, out := &in.ProfileID, &out.ProfileID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProfileIDRef != nil {
+		in, out := &in.ProfileIDRef, &out.ProfileIDRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.ProfileIDSelector != nil {
+		in, out := &in.ProfileIDSelector, &out.ProfileIDSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]ProfileClaimRuleCondition, len(*in))
+		copy(*out, *in)
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.RealmName != nil {
+		in, out := &in.RealmName, &out.RealmName
+		*out = new(string)
+		**out = **in
+	}
+	if in.CRType != nil {
+		in, out := &in.CRType, &out.CRType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Expiration != nil {
+		in, out := &in.Expiration, &out.Expiration
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustedProfileClaimRuleParameters.
+func (in *TrustedProfileClaimRuleParameters) DeepCopy() *TrustedProfileClaimRuleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustedProfileClaimRuleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustedProfileClaimRuleSpec) DeepCopyInto(out *TrustedProfileClaimRuleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustedProfileClaimRuleSpec.
+func (in *TrustedProfileClaimRuleSpec) DeepCopy() *TrustedProfileClaimRuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustedProfileClaimRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustedProfileClaimRuleStatus) DeepCopyInto(out *TrustedProfileClaimRuleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustedProfileClaimRuleStatus.
+func (in *TrustedProfileClaimRuleStatus) DeepCopy() *TrustedProfileClaimRuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustedProfileClaimRuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustedProfileLink) DeepCopyInto(out *TrustedProfileLink) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustedProfileLink.
+func (in *TrustedProfileLink) DeepCopy() *TrustedProfileLink {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustedProfileLink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TrustedProfileLink) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustedProfileLinkList) DeepCopyInto(out *TrustedProfileLinkList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TrustedProfileLink, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustedProfileLinkList.
+func (in *TrustedProfileLinkList) DeepCopy() *TrustedProfileLinkList {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustedProfileLinkList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TrustedProfileLinkList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustedProfileLinkObservation) DeepCopyInto(out *TrustedProfileLinkObservation) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ModifiedAt != nil {
+		in, out := &in.ModifiedAt, &out.ModifiedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustedProfileLinkObservation.
+func (in *TrustedProfileLinkObservation) DeepCopy() *TrustedProfileLinkObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustedProfileLinkObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustedProfileLinkParameters) DeepCopyInto(out *TrustedProfileLinkParameters) {
+	*out = *in
+	if in.ProfileID != nil {
+		in, out := &in.ProfileID, &out.ProfileID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProfileIDRef != nil {
+		in, out := &in.ProfileIDRef, &out.ProfileIDRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.ProfileIDSelector != nil {
+		in, out := &in.ProfileIDSelector, &out.ProfileIDSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	in.Link.DeepCopyInto(&out.Link)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustedProfileLinkParameters.
+func (in *TrustedProfileLinkParameters) DeepCopy() *TrustedProfileLinkParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustedProfileLinkParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustedProfileLinkSpec) DeepCopyInto(out *TrustedProfileLinkSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustedProfileLinkSpec.
+func (in *TrustedProfileLinkSpec) DeepCopy() *TrustedProfileLinkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustedProfileLinkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustedProfileLinkStatus) DeepCopyInto(out *TrustedProfileLinkStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustedProfileLinkStatus.
+func (in *TrustedProfileLinkStatus) DeepCopy() *TrustedProfileLinkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustedProfileLinkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustedProfileList) DeepCopyInto(out *TrustedProfileList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TrustedProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustedProfileList.
+func (in *TrustedProfileList) DeepCopy() *TrustedProfileList {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustedProfileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TrustedProfileList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustedProfileObservation) DeepCopyInto(out *TrustedProfileObservation) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ModifiedAt != nil {
+		in, out := &in.ModifiedAt, &out.ModifiedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustedProfileObservation.
+func (in *TrustedProfileObservation) DeepCopy() *TrustedProfileObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustedProfileObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustedProfileParameters) DeepCopyInto(out *TrustedProfileParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustedProfileParameters.
+func (in *TrustedProfileParameters) DeepCopy() *TrustedProfileParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustedProfileParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustedProfileSpec) DeepCopyInto(out *TrustedProfileSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustedProfileSpec.
+func (in *TrustedProfileSpec) DeepCopy() *TrustedProfileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustedProfileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustedProfileStatus) DeepCopyInto(out *TrustedProfileStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustedProfileStatus.
+func (in *TrustedProfileStatus) DeepCopy() *TrustedProfileStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustedProfileStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -31,7 +31,7 @@ type CustomRoleParameters struct {
 	// The display name of the role that is shown in the console.
 	DisplayName string `json:"displayName"`
 
-	// The actions of the role.
+	// The actions of the role. They must be supported by the service, i.e. be actions of its service or system roles.
 	Actions []string `json:"actions"`
 
 	// The name of the role that is used in the CRN. Can only be alphanumeric and has to be capitalized.
@@ -73,6 +73,9 @@ type CustomRoleObservation struct {
 
 	// The current state of the role
 	State string `json:"state,omitempty"`
+
+	// The CRNs of the system roles of the service whose actions include all the actions of the role
+	SupersetSystemRoles []string `json:"supersetSystemRoles,omitempty"`
 }
 
 // A CustomRoleSpec defines the desired state of a CustomRole.
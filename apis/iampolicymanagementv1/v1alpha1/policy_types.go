@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
 )
 
 // In spec mandatory fields should be by value, and optional fields pointers
@@ -32,19 +34,103 @@ type PolicyParameters struct {
 	Type string `json:"type"`
 
 	// The subjects associated with a policy.
-	Subjects []PolicySubject `json:"subjects"`
+	//
+	// Note:
+	//    One of 'Subjects', 'Source' should be specified
+	//
+	// +optional
+	Subjects []PolicySubject `json:"subjects,omitempty"`
+
+	// The source of an authorization policy, from which the subject of the policy is generated.
+	//
+	// Note:
+	//    One of 'Subjects', 'Source' should be specified; only valid for policies of type 'authorization'
+	//
+	// +optional
+	Source *AuthorizationSource `json:"source,omitempty"`
 
 	// A set of role cloud resource names (CRNs) granted by the policy.
 	Roles []PolicyRole `json:"roles"`
 
 	// The resources associated with a policy.
-	Resources []PolicyResource `json:"resources"`
+	//
+	// Note:
+	//    One of 'Resources', 'Target' should be specified
+	//
+	// +optional
+	Resources []PolicyResource `json:"resources,omitempty"`
+
+	// The target of an authorization policy, from which the resource of the policy is generated.
+	//
+	// Note:
+	//    One of 'Resources', 'Target' should be specified; only valid for policies of type 'authorization'
+	//
+	// +optional
+	Target *AuthorizationTarget `json:"target,omitempty"`
 
 	// Customer-defined description.
 	// +optional
 	Description *string `json:"description,omitempty"`
 }
 
+// AuthorizationSource : The service (instance) granted access by an authorization policy.
+type AuthorizationSource struct {
+	// The name of the source service (e.g. 'cloud-object-storage').
+	ServiceName string `json:"serviceName"`
+
+	// The GUID of the source service instance. When not set, all the instances of the source service are granted access.
+	//
+	// Note:
+	//    At most one of 'ServiceInstance', 'ServiceInstanceRef', 'ServiceInstanceSelector' should be specified
+	//
+	// +immutable
+	// +optional
+	ServiceInstance *string `json:"serviceInstance,omitempty"`
+
+	// Reference to a ResourceInstance, whose GUID is used to set ServiceInstance
+	// +immutable
+	// +optional
+	ServiceInstanceRef *runtimev1alpha1.Reference `json:"serviceInstanceRef,omitempty"`
+
+	// Selector for a ResourceInstance, whose GUID is used to set ServiceInstance
+	// +immutable
+	// +optional
+	ServiceInstanceSelector *runtimev1alpha1.Selector `json:"serviceInstanceSelector,omitempty"`
+
+	// The ID of the account of the source service (instance).
+	// +optional
+	AccountID *string `json:"accountId,omitempty"`
+}
+
+// AuthorizationTarget : The service (instance) that the source of an authorization policy is granted access to.
+type AuthorizationTarget struct {
+	// The name of the target service (e.g. 'kms').
+	ServiceName string `json:"serviceName"`
+
+	// The GUID of the target service instance. When not set, access is granted to all the instances of the target service.
+	//
+	// Note:
+	//    At most one of 'ServiceInstance', 'ServiceInstanceRef', 'ServiceInstanceSelector' should be specified
+	//
+	// +immutable
+	// +optional
+	ServiceInstance *string `json:"serviceInstance,omitempty"`
+
+	// Reference to a ResourceInstance, whose GUID is used to set ServiceInstance
+	// +immutable
+	// +optional
+	ServiceInstanceRef *runtimev1alpha1.Reference `json:"serviceInstanceRef,omitempty"`
+
+	// Selector for a ResourceInstance, whose GUID is used to set ServiceInstance
+	// +immutable
+	// +optional
+	ServiceInstanceSelector *runtimev1alpha1.Selector `json:"serviceInstanceSelector,omitempty"`
+
+	// The ID of the account of the target service (instance).
+	// +optional
+	AccountID *string `json:"accountId,omitempty"`
+}
+
 // PolicyRole : A role associated with a policy.
 type PolicyRole struct {
 	// The role cloud resource name granted by the policy.
@@ -73,7 +159,36 @@ type ResourceAttribute struct {
 	Name *string `json:"name" validate:"required"`
 
 	// The value of an attribute.
-	Value *string `json:"value" validate:"required"`
+	//
+	// Note:
+	//    One of 'Value', 'ValueGenericRef', 'ServiceInstanceRef', 'ServiceInstanceSelector' should be specified
+	//
+	// +optional
+	Value *string `json:"value,omitempty"`
+
+	// A generic reference to a field of any managed resource, used to set Value
+	//
+	// Note:
+	//    One of 'Value', 'ValueGenericRef', 'ServiceInstanceRef', 'ServiceInstanceSelector' should be specified
+	//
+	// +optional
+	ValueGenericRef *v1beta1.GenericReference `json:"valueGenericRef,omitempty"`
+
+	// Reference to a ResourceInstance, whose GUID is used to set Value (of a `serviceInstance` attribute)
+	//
+	// Note:
+	//    One of 'Value', 'ValueGenericRef', 'ServiceInstanceRef', 'ServiceInstanceSelector' should be specified
+	//
+	// +optional
+	ServiceInstanceRef *runtimev1alpha1.Reference `json:"serviceInstanceRef,omitempty"`
+
+	// Selector for a ResourceInstance, whose GUID is used to set Value (of a `serviceInstance` attribute)
+	//
+	// Note:
+	//    One of 'Value', 'ValueGenericRef', 'ServiceInstanceRef', 'ServiceInstanceSelector' should be specified
+	//
+	// +optional
+	ServiceInstanceSelector *runtimev1alpha1.Selector `json:"serviceInstanceSelector,omitempty"`
 
 	// The operator of an attribute.
 	Operator *string `json:"operator,omitempty"`
@@ -91,7 +206,76 @@ type SubjectAttribute struct {
 	Name *string `json:"name" validate:"required"`
 
 	// The value of an attribute.
-	Value *string `json:"value" validate:"required"`
+	//
+	// Note:
+	//    One of 'Value', 'ValueGenericRef', 'ServiceIDRef', 'ServiceIDSelector', 'TrustedProfileRef',
+	//    'TrustedProfileSelector', 'AccessGroupIDRef', 'AccessGroupIDSelector' should be specified
+	//
+	// +optional
+	Value *string `json:"value,omitempty"`
+
+	// A generic reference to a field of any managed resource, used to set Value
+	//
+	// Note:
+	//    One of 'Value', 'ValueGenericRef', 'ServiceIDRef', 'ServiceIDSelector', 'TrustedProfileRef',
+	//    'TrustedProfileSelector', 'AccessGroupIDRef', 'AccessGroupIDSelector' should be specified
+	//
+	// +optional
+	ValueGenericRef *v1beta1.GenericReference `json:"valueGenericRef,omitempty"`
+
+	// Reference to a ServiceID, whose iam_id is used to set Value (of an `iam_id` attribute)
+	//
+	// Note:
+	//    One of 'Value', 'ValueGenericRef', 'ServiceIDRef', 'ServiceIDSelector', 'TrustedProfileRef',
+	//    'TrustedProfileSelector', 'AccessGroupIDRef', 'AccessGroupIDSelector' should be specified
+	//
+	// +optional
+	ServiceIDRef *runtimev1alpha1.Reference `json:"serviceIdRef,omitempty"`
+
+	// Selector for a ServiceID, whose iam_id is used to set Value (of an `iam_id` attribute)
+	//
+	// Note:
+	//    One of 'Value', 'ValueGenericRef', 'ServiceIDRef', 'ServiceIDSelector', 'TrustedProfileRef',
+	//    'TrustedProfileSelector', 'AccessGroupIDRef', 'AccessGroupIDSelector' should be specified
+	//
+	// +optional
+	ServiceIDSelector *runtimev1alpha1.Selector `json:"serviceIdSelector,omitempty"`
+
+	// Reference to a TrustedProfile, whose iam_id is used to set Value (of an `iam_id` attribute)
+	//
+	// Note:
+	//    One of 'Value', 'ValueGenericRef', 'ServiceIDRef', 'ServiceIDSelector', 'TrustedProfileRef',
+	//    'TrustedProfileSelector', 'AccessGroupIDRef', 'AccessGroupIDSelector' should be specified
+	//
+	// +optional
+	TrustedProfileRef *runtimev1alpha1.Reference `json:"trustedProfileRef,omitempty"`
+
+	// Selector for a TrustedProfile, whose iam_id is used to set Value (of an `iam_id` attribute)
+	//
+	// Note:
+	//    One of 'Value', 'ValueGenericRef', 'ServiceIDRef', 'ServiceIDSelector', 'TrustedProfileRef',
+	//    'TrustedProfileSelector', 'AccessGroupIDRef', 'AccessGroupIDSelector' should be specified
+	//
+	// +optional
+	TrustedProfileSelector *runtimev1alpha1.Selector `json:"trustedProfileSelector,omitempty"`
+
+	// Reference to an AccessGroup, whose ID is used to set Value (of an `access_group_id` attribute)
+	//
+	// Note:
+	//    One of 'Value', 'ValueGenericRef', 'ServiceIDRef', 'ServiceIDSelector', 'TrustedProfileRef',
+	//    'TrustedProfileSelector', 'AccessGroupIDRef', 'AccessGroupIDSelector' should be specified
+	//
+	// +optional
+	AccessGroupIDRef *runtimev1alpha1.Reference `json:"accessGroupIdRef,omitempty"`
+
+	// Selector for an AccessGroup, whose ID is used to set Value (of an `access_group_id` attribute)
+	//
+	// Note:
+	//    One of 'Value', 'ValueGenericRef', 'ServiceIDRef', 'ServiceIDSelector', 'TrustedProfileRef',
+	//    'TrustedProfileSelector', 'AccessGroupIDRef', 'AccessGroupIDSelector' should be specified
+	//
+	// +optional
+	AccessGroupIDSelector *runtimev1alpha1.Selector `json:"accessGroupIdSelector,omitempty"`
 }
 
 // PolicyObservation are the observable fields of a Policy.
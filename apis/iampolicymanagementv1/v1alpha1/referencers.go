@@ -0,0 +1,214 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/common"
+	iamagv2 "github.com/crossplane-contrib/provider-ibm-cloud/apis/iamaccessgroupsv2/v1alpha1"
+	iamidv1 "github.com/crossplane-contrib/provider-ibm-cloud/apis/iamidentityv1/v1alpha1"
+	rcv2 "github.com/crossplane-contrib/provider-ibm-cloud/apis/resourcecontrollerv2/v1alpha1"
+)
+
+// ResolveReferences of this Policy
+func (mg *Policy) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := common.NewAPIResolver(c, mg)
+
+	for i := range mg.Spec.ForProvider.Subjects {
+		for j := range mg.Spec.ForProvider.Subjects[i].Attributes {
+			a := &mg.Spec.ForProvider.Subjects[i].Attributes[j]
+			v, err := common.ResolveGeneric(ctx, c, mg, reference.FromPtrValue(a.Value), a.ValueGenericRef)
+			if err != nil {
+				return errors.Wrap(err, fmt.Sprintf("spec.forProvider.subjects[%d].attributes[%d].value", i, j))
+			}
+
+			rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: v,
+				Reference:    a.ServiceIDRef,
+				Selector:     a.ServiceIDSelector,
+				To:           reference.To{Managed: &iamidv1.ServiceID{}, List: &iamidv1.ServiceIDList{}},
+				Extract:      iamidv1.ServiceIDIamID(),
+			})
+			if err != nil {
+				return errors.Wrap(err, fmt.Sprintf("spec.forProvider.subjects[%d].attributes[%d].value", i, j))
+			}
+			a.Value = reference.ToPtrValue(rsp.ResolvedValue)
+			a.ServiceIDRef = rsp.ResolvedReference
+
+			rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: reference.FromPtrValue(a.Value),
+				Reference:    a.TrustedProfileRef,
+				Selector:     a.TrustedProfileSelector,
+				To:           reference.To{Managed: &iamidv1.TrustedProfile{}, List: &iamidv1.TrustedProfileList{}},
+				Extract:      iamidv1.TrustedProfileIamID(),
+			})
+			if err != nil {
+				return errors.Wrap(err, fmt.Sprintf("spec.forProvider.subjects[%d].attributes[%d].value", i, j))
+			}
+			a.Value = reference.ToPtrValue(rsp.ResolvedValue)
+			a.TrustedProfileRef = rsp.ResolvedReference
+
+			rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: reference.FromPtrValue(a.Value),
+				Reference:    a.AccessGroupIDRef,
+				Selector:     a.AccessGroupIDSelector,
+				To:           reference.To{Managed: &iamagv2.AccessGroup{}, List: &iamagv2.AccessGroupList{}},
+				Extract:      iamagv2.AccessGroupID(),
+			})
+			if err != nil {
+				return errors.Wrap(err, fmt.Sprintf("spec.forProvider.subjects[%d].attributes[%d].value", i, j))
+			}
+			a.Value = reference.ToPtrValue(rsp.ResolvedValue)
+			a.AccessGroupIDRef = rsp.ResolvedReference
+		}
+	}
+
+	for i := range mg.Spec.ForProvider.Resources {
+		for j := range mg.Spec.ForProvider.Resources[i].Attributes {
+			a := &mg.Spec.ForProvider.Resources[i].Attributes[j]
+			v, err := common.ResolveGeneric(ctx, c, mg, reference.FromPtrValue(a.Value), a.ValueGenericRef)
+			if err != nil {
+				return errors.Wrap(err, fmt.Sprintf("spec.forProvider.resources[%d].attributes[%d].value", i, j))
+			}
+
+			rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: v,
+				Reference:    a.ServiceInstanceRef,
+				Selector:     a.ServiceInstanceSelector,
+				To:           reference.To{Managed: &rcv2.ResourceInstance{}, List: &rcv2.ResourceInstanceList{}},
+				Extract:      rcv2.SourceGUID(),
+			})
+			if err != nil {
+				return errors.Wrap(err, fmt.Sprintf("spec.forProvider.resources[%d].attributes[%d].value", i, j))
+			}
+			a.Value = reference.ToPtrValue(rsp.ResolvedValue)
+			a.ServiceInstanceRef = rsp.ResolvedReference
+		}
+	}
+
+	if src := mg.Spec.ForProvider.Source; src != nil {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(src.ServiceInstance),
+			Reference:    src.ServiceInstanceRef,
+			Selector:     src.ServiceInstanceSelector,
+			To:           reference.To{Managed: &rcv2.ResourceInstance{}, List: &rcv2.ResourceInstanceList{}},
+			Extract:      rcv2.SourceGUID(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.source.serviceInstance")
+		}
+		src.ServiceInstance = reference.ToPtrValue(rsp.ResolvedValue)
+		src.ServiceInstanceRef = rsp.ResolvedReference
+	}
+
+	if tgt := mg.Spec.ForProvider.Target; tgt != nil {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(tgt.ServiceInstance),
+			Reference:    tgt.ServiceInstanceRef,
+			Selector:     tgt.ServiceInstanceSelector,
+			To:           reference.To{Managed: &rcv2.ResourceInstance{}, List: &rcv2.ResourceInstanceList{}},
+			Extract:      rcv2.SourceGUID(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.target.serviceInstance")
+		}
+		tgt.ServiceInstance = reference.ToPtrValue(rsp.ResolvedValue)
+		tgt.ServiceInstanceRef = rsp.ResolvedReference
+	}
+	return nil
+}
+
+// ResolveReferences of this V2Policy
+func (mg *V2Policy) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := common.NewAPIResolver(c, mg)
+
+	for i := range mg.Spec.ForProvider.Subject.Attributes {
+		a := &mg.Spec.ForProvider.Subject.Attributes[i]
+		v, err := common.ResolveGeneric(ctx, c, mg, reference.FromPtrValue(a.Value), a.ValueGenericRef)
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("spec.forProvider.subject.attributes[%d].value", i))
+		}
+
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: v,
+			Reference:    a.ServiceIDRef,
+			Selector:     a.ServiceIDSelector,
+			To:           reference.To{Managed: &iamidv1.ServiceID{}, List: &iamidv1.ServiceIDList{}},
+			Extract:      iamidv1.ServiceIDIamID(),
+		})
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("spec.forProvider.subject.attributes[%d].value", i))
+		}
+		a.Value = reference.ToPtrValue(rsp.ResolvedValue)
+		a.ServiceIDRef = rsp.ResolvedReference
+
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(a.Value),
+			Reference:    a.TrustedProfileRef,
+			Selector:     a.TrustedProfileSelector,
+			To:           reference.To{Managed: &iamidv1.TrustedProfile{}, List: &iamidv1.TrustedProfileList{}},
+			Extract:      iamidv1.TrustedProfileIamID(),
+		})
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("spec.forProvider.subject.attributes[%d].value", i))
+		}
+		a.Value = reference.ToPtrValue(rsp.ResolvedValue)
+		a.TrustedProfileRef = rsp.ResolvedReference
+
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(a.Value),
+			Reference:    a.AccessGroupIDRef,
+			Selector:     a.AccessGroupIDSelector,
+			To:           reference.To{Managed: &iamagv2.AccessGroup{}, List: &iamagv2.AccessGroupList{}},
+			Extract:      iamagv2.AccessGroupID(),
+		})
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("spec.forProvider.subject.attributes[%d].value", i))
+		}
+		a.Value = reference.ToPtrValue(rsp.ResolvedValue)
+		a.AccessGroupIDRef = rsp.ResolvedReference
+	}
+
+	for i := range mg.Spec.ForProvider.Resource.Attributes {
+		a := &mg.Spec.ForProvider.Resource.Attributes[i]
+		v, err := common.ResolveGeneric(ctx, c, mg, reference.FromPtrValue(a.Value), a.ValueGenericRef)
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("spec.forProvider.resource.attributes[%d].value", i))
+		}
+
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: v,
+			Reference:    a.ServiceInstanceRef,
+			Selector:     a.ServiceInstanceSelector,
+			To:           reference.To{Managed: &rcv2.ResourceInstance{}, List: &rcv2.ResourceInstanceList{}},
+			Extract:      rcv2.SourceGUID(),
+		})
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("spec.forProvider.resource.attributes[%d].value", i))
+		}
+		a.Value = reference.ToPtrValue(rsp.ResolvedValue)
+		a.ServiceInstanceRef = rsp.ResolvedReference
+	}
+	return nil
+}
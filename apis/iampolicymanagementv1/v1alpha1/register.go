@@ -47,6 +47,10 @@ var (
 	CustomRoleGroupKind        = schema.GroupKind{Group: Group, Kind: CustomRoleKind}.String()
 	CustomRoleKindAPIVersion   = CustomRoleKind + "." + SchemeGroupVersion.String()
 	CustomRoleGroupVersionKind = SchemeGroupVersion.WithKind(CustomRoleKind)
+	V2PolicyKind               = reflect.TypeOf(V2Policy{}).Name()
+	V2PolicyGroupKind          = schema.GroupKind{Group: Group, Kind: V2PolicyKind}.String()
+	V2PolicyKindAPIVersion     = V2PolicyKind + "." + SchemeGroupVersion.String()
+	V2PolicyGroupVersionKind   = SchemeGroupVersion.WithKind(V2PolicyKind)
 )
 
 func init() {
@@ -54,5 +58,7 @@ func init() {
 		&Policy{},
 		&PolicyList{},
 		&CustomRole{},
-		&CustomRoleList{})
+		&CustomRoleList{},
+		&V2Policy{},
+		&V2PolicyList{})
 }
@@ -0,0 +1,277 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
+)
+
+// V2PolicyParameters are the configurable fields of a V2Policy.
+type V2PolicyParameters struct {
+	// The policy type; either 'access' or 'authorization'.
+	// +kubebuilder:validation:Enum=access;authorization
+	Type string `json:"type"`
+
+	// Customer-defined description.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// The subject attributes for whom the policy grants access.
+	Subject V2PolicySubject `json:"subject"`
+
+	// The roles granted by the policy.
+	Roles []PolicyRole `json:"roles"`
+
+	// The resource attributes to which the policy grants access.
+	Resource V2PolicyResource `json:"resource"`
+
+	// The pattern of the rule of the policy, such as 'time-based-conditions:once',
+	// 'time-based-conditions:weekly:all-day' or 'time-based-conditions:weekly:custom-hours'.
+	// +optional
+	Pattern *string `json:"pattern,omitempty"`
+
+	// Additional access conditions of the policy. For instance, a rule with the 'time-based-conditions:once' pattern
+	// and conditions on '{{environment.attributes.current_date_time}}' grants temporary access, that IAM stops
+	// granting once the end of the date range is reached.
+	// +optional
+	Rule *V2PolicyRule `json:"rule,omitempty"`
+}
+
+// V2PolicySubject : The subject attributes for whom the policy grants access.
+type V2PolicySubject struct {
+	// List of subject attributes.
+	Attributes []V2PolicySubjectAttribute `json:"attributes"`
+}
+
+// V2PolicySubjectAttribute : An attribute associated with the subject of a policy.
+type V2PolicySubjectAttribute struct {
+	// The name of an attribute (e.g. 'iam_id', 'access_group_id').
+	Key string `json:"key"`
+
+	// The operator of an attribute; 'stringEquals' when not set.
+	// +optional
+	Operator *string `json:"operator,omitempty"`
+
+	// The value of an attribute.
+	//
+	// Note:
+	//    One of 'Value', 'ValueGenericRef', 'ServiceIDRef', 'ServiceIDSelector', 'TrustedProfileRef',
+	//    'TrustedProfileSelector', 'AccessGroupIDRef', 'AccessGroupIDSelector' should be specified
+	//
+	// +optional
+	Value *string `json:"value,omitempty"`
+
+	// A generic reference to a field of any managed resource, used to set Value
+	// +optional
+	ValueGenericRef *v1beta1.GenericReference `json:"valueGenericRef,omitempty"`
+
+	// Reference to a ServiceID, whose iam_id is used to set Value (of an `iam_id` attribute)
+	// +optional
+	ServiceIDRef *runtimev1alpha1.Reference `json:"serviceIdRef,omitempty"`
+
+	// Selector for a ServiceID, whose iam_id is used to set Value (of an `iam_id` attribute)
+	// +optional
+	ServiceIDSelector *runtimev1alpha1.Selector `json:"serviceIdSelector,omitempty"`
+
+	// Reference to a TrustedProfile, whose iam_id is used to set Value (of an `iam_id` attribute)
+	// +optional
+	TrustedProfileRef *runtimev1alpha1.Reference `json:"trustedProfileRef,omitempty"`
+
+	// Selector for a TrustedProfile, whose iam_id is used to set Value (of an `iam_id` attribute)
+	// +optional
+	TrustedProfileSelector *runtimev1alpha1.Selector `json:"trustedProfileSelector,omitempty"`
+
+	// Reference to an AccessGroup, whose ID is used to set Value (of an `access_group_id` attribute)
+	// +optional
+	AccessGroupIDRef *runtimev1alpha1.Reference `json:"accessGroupIdRef,omitempty"`
+
+	// Selector for an AccessGroup, whose ID is used to set Value (of an `access_group_id` attribute)
+	// +optional
+	AccessGroupIDSelector *runtimev1alpha1.Selector `json:"accessGroupIdSelector,omitempty"`
+}
+
+// V2PolicyResource : The resource attributes to which the policy grants access.
+type V2PolicyResource struct {
+	// List of resource attributes.
+	Attributes []V2PolicyResourceAttribute `json:"attributes"`
+
+	// Optional list of resource tags.
+	// +optional
+	Tags []V2PolicyResourceTag `json:"tags,omitempty"`
+}
+
+// V2PolicyResourceAttribute : An attribute associated with the resource of a policy.
+type V2PolicyResourceAttribute struct {
+	// The name of an attribute (e.g. 'accountId', 'serviceName', 'serviceInstance').
+	Key string `json:"key"`
+
+	// The operator of an attribute; 'stringEquals' when not set.
+	// +optional
+	Operator *string `json:"operator,omitempty"`
+
+	// The value of an attribute ('true' or 'false' for the 'stringExists' operator).
+	//
+	// Note:
+	//    One of 'Value', 'ValueGenericRef', 'ServiceInstanceRef', 'ServiceInstanceSelector' should be specified
+	//
+	// +optional
+	Value *string `json:"value,omitempty"`
+
+	// A generic reference to a field of any managed resource, used to set Value
+	// +optional
+	ValueGenericRef *v1beta1.GenericReference `json:"valueGenericRef,omitempty"`
+
+	// Reference to a ResourceInstance, whose GUID is used to set Value (of a `serviceInstance` attribute)
+	// +optional
+	ServiceInstanceRef *runtimev1alpha1.Reference `json:"serviceInstanceRef,omitempty"`
+
+	// Selector for a ResourceInstance, whose GUID is used to set Value (of a `serviceInstance` attribute)
+	// +optional
+	ServiceInstanceSelector *runtimev1alpha1.Selector `json:"serviceInstanceSelector,omitempty"`
+}
+
+// V2PolicyResourceTag : A tag associated with the resource of a policy.
+type V2PolicyResourceTag struct {
+	// The name of an access management tag.
+	Key string `json:"key"`
+
+	// The value of an access management tag.
+	Value string `json:"value"`
+
+	// The operator of an access management tag; 'stringEquals' when not set.
+	// +optional
+	Operator *string `json:"operator,omitempty"`
+}
+
+// V2PolicyRule : The conditions of a policy; either a single condition, or several ones combined by a logical
+// operator.
+type V2PolicyRule struct {
+	// The key of a single condition (e.g. '{{environment.attributes.current_date_time}}').
+	//
+	// Note:
+	//    One of 'Key', 'Conditions' should be specified
+	//
+	// +optional
+	Key *string `json:"key,omitempty"`
+
+	// The operator of a single condition (e.g. 'dateTimeLessThan'), or the logical operator ('and', 'or') that
+	// combines the conditions.
+	Operator string `json:"operator"`
+
+	// The value of a single condition.
+	// +optional
+	Value *string `json:"value,omitempty"`
+
+	// The values of a single condition, for operators that take several ones (e.g. 'dayOfWeekAnyOf').
+	// +optional
+	Values []string `json:"values,omitempty"`
+
+	// The conditions combined by the logical operator.
+	// +optional
+	Conditions []V2PolicyRuleCondition `json:"conditions,omitempty"`
+}
+
+// V2PolicyRuleCondition : A condition of the rule of a policy.
+type V2PolicyRuleCondition struct {
+	// The key of the condition (e.g. '{{environment.attributes.current_time}}').
+	Key string `json:"key"`
+
+	// The operator of the condition (e.g. 'timeGreaterThanOrEquals').
+	Operator string `json:"operator"`
+
+	// The value of the condition.
+	//
+	// Note:
+	//    One of 'Value', 'Values' should be specified
+	//
+	// +optional
+	Value *string `json:"value,omitempty"`
+
+	// The values of the condition, for operators that take several ones (e.g. 'dayOfWeekAnyOf').
+	//
+	// Note:
+	//    One of 'Value', 'Values' should be specified
+	//
+	// +optional
+	Values []string `json:"values,omitempty"`
+}
+
+// V2PolicyObservation are the observable fields of a V2Policy.
+type V2PolicyObservation struct {
+	// The policy ID.
+	ID string `json:"id,omitempty"`
+
+	// The href link back to the policy.
+	Href string `json:"href,omitempty"`
+
+	// The UTC timestamp when the policy was created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// The iam ID of the entity that created the policy.
+	CreatedByID string `json:"createdById,omitempty"`
+
+	// The UTC timestamp when the policy was last modified.
+	LastModifiedAt *metav1.Time `json:"lastModifiedAt,omitempty"`
+
+	// The iam ID of the entity that last modified the policy.
+	LastModifiedByID string `json:"lastModifiedById,omitempty"`
+
+	// The current state of the policy
+	State string `json:"state,omitempty"`
+}
+
+// A V2PolicySpec defines the desired state of a V2Policy.
+type V2PolicySpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  V2PolicyParameters `json:"forProvider"`
+}
+
+// A V2PolicyStatus represents the observed state of a V2Policy.
+type V2PolicyStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     V2PolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A V2Policy represents an instance of an IAM v2 policy on IBM Cloud, which supports rule conditions
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.bindingPhase"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="CLASS",type="string",JSONPath=".spec.classRef.name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,ibmcloud}
+type V2Policy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   V2PolicySpec   `json:"spec"`
+	Status V2PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// V2PolicyList contains a list of V2Policy
+type V2PolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []V2Policy `json:"items"`
+}
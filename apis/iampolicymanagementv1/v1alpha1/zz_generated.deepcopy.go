@@ -22,9 +22,81 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
+	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationSource) DeepCopyInto(out *AuthorizationSource) {
+	*out = *in
+	if in.ServiceInstance != nil {
+		in, out := &in.ServiceInstance, &out.ServiceInstance
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServiceInstanceRef != nil {
+		in, out := &in.ServiceInstanceRef, &out.ServiceInstanceRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.ServiceInstanceSelector != nil {
+		in, out := &in.ServiceInstanceSelector, &out.ServiceInstanceSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AccountID != nil {
+		in, out := &in.AccountID, &out.AccountID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationSource.
+func (in *AuthorizationSource) DeepCopy() *AuthorizationSource {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationTarget) DeepCopyInto(out *AuthorizationTarget) {
+	*out = *in
+	if in.ServiceInstance != nil {
+		in, out := &in.ServiceInstance, &out.ServiceInstance
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServiceInstanceRef != nil {
+		in, out := &in.ServiceInstanceRef, &out.ServiceInstanceRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.ServiceInstanceSelector != nil {
+		in, out := &in.ServiceInstanceSelector, &out.ServiceInstanceSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AccountID != nil {
+		in, out := &in.AccountID, &out.AccountID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationTarget.
+func (in *AuthorizationTarget) DeepCopy() *AuthorizationTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomRole) DeepCopyInto(out *CustomRole) {
 	*out = *in
@@ -95,6 +167,11 @@ func (in *CustomRoleObservation) DeepCopyInto(out *CustomRoleObservation) {
 		in, out := &in.LastModifiedAt, &out.LastModifiedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.SupersetSystemRoles != nil {
+		in, out := &in.SupersetSystemRoles, &out.SupersetSystemRoles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomRoleObservation.
@@ -258,6 +335,11 @@ func (in *PolicyParameters) DeepCopyInto(out *PolicyParameters) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(AuthorizationSource)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Roles != nil {
 		in, out := &in.Roles, &out.Roles
 		*out = make([]PolicyRole, len(*in))
@@ -270,6 +352,11 @@ func (in *PolicyParameters) DeepCopyInto(out *PolicyParameters) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(AuthorizationTarget)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Description != nil {
 		in, out := &in.Description, &out.Description
 		*out = new(string)
@@ -393,6 +480,21 @@ func (in *ResourceAttribute) DeepCopyInto(out *ResourceAttribute) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ValueGenericRef != nil {
+		in, out := &in.ValueGenericRef, &out.ValueGenericRef
+		*out = new(v1beta1.GenericReference)
+		**out = **in
+	}
+	if in.ServiceInstanceRef != nil {
+		in, out := &in.ServiceInstanceRef, &out.ServiceInstanceRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.ServiceInstanceSelector != nil {
+		in, out := &in.ServiceInstanceSelector, &out.ServiceInstanceSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Operator != nil {
 		in, out := &in.Operator, &out.Operator
 		*out = new(string)
@@ -423,6 +525,41 @@ func (in *SubjectAttribute) DeepCopyInto(out *SubjectAttribute) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ValueGenericRef != nil {
+		in, out := &in.ValueGenericRef, &out.ValueGenericRef
+		*out = new(v1beta1.GenericReference)
+		**out = **in
+	}
+	if in.ServiceIDRef != nil {
+		in, out := &in.ServiceIDRef, &out.ServiceIDRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.ServiceIDSelector != nil {
+		in, out := &in.ServiceIDSelector, &out.ServiceIDSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TrustedProfileRef != nil {
+		in, out := &in.TrustedProfileRef, &out.TrustedProfileRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.TrustedProfileSelector != nil {
+		in, out := &in.TrustedProfileSelector, &out.TrustedProfileSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AccessGroupIDRef != nil {
+		in, out := &in.AccessGroupIDRef, &out.AccessGroupIDRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.AccessGroupIDSelector != nil {
+		in, out := &in.AccessGroupIDSelector, &out.AccessGroupIDSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubjectAttribute.
@@ -434,3 +571,389 @@ func (in *SubjectAttribute) DeepCopy() *SubjectAttribute {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *V2Policy) DeepCopyInto(out *V2Policy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new V2Policy.
+func (in *V2Policy) DeepCopy() *V2Policy {
+	if in == nil {
+		return nil
+	}
+	out := new(V2Policy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *V2Policy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *V2PolicyList) DeepCopyInto(out *V2PolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]V2Policy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new V2PolicyList.
+func (in *V2PolicyList) DeepCopy() *V2PolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(V2PolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *V2PolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *V2PolicyObservation) DeepCopyInto(out *V2PolicyObservation) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.LastModifiedAt != nil {
+		in, out := &in.LastModifiedAt, &out.LastModifiedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new V2PolicyObservation.
+func (in *V2PolicyObservation) DeepCopy() *V2PolicyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(V2PolicyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *V2PolicyParameters) DeepCopyInto(out *V2PolicyParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	in.Subject.DeepCopyInto(&out.Subject)
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]PolicyRole, len(*in))
+		copy(*out, *in)
+	}
+	in.Resource.DeepCopyInto(&out.Resource)
+	if in.Pattern != nil {
+		in, out := &in.Pattern, &out.Pattern
+		*out = new(string)
+		**out = **in
+	}
+	if in.Rule != nil {
+		in, out := &in.Rule, &out.Rule
+		*out = new(V2PolicyRule)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new V2PolicyParameters.
+func (in *V2PolicyParameters) DeepCopy() *V2PolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(V2PolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *V2PolicyResource) DeepCopyInto(out *V2PolicyResource) {
+	*out = *in
+	if in.Attributes != nil {
+		in, out := &in.Attributes, &out.Attributes
+		*out = make([]V2PolicyResourceAttribute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]V2PolicyResourceTag, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new V2PolicyResource.
+func (in *V2PolicyResource) DeepCopy() *V2PolicyResource {
+	if in == nil {
+		return nil
+	}
+	out := new(V2PolicyResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *V2PolicyResourceAttribute) DeepCopyInto(out *V2PolicyResourceAttribute) {
+	*out = *in
+	if in.Operator != nil {
+		in, out := &in.Operator, &out.Operator
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+	if in.ValueGenericRef != nil {
+		in, out := &in.ValueGenericRef, &out.ValueGenericRef
+		*out = new(v1beta1.GenericReference)
+		**out = **in
+	}
+	if in.ServiceInstanceRef != nil {
+		in, out := &in.ServiceInstanceRef, &out.ServiceInstanceRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.ServiceInstanceSelector != nil {
+		in, out := &in.ServiceInstanceSelector, &out.ServiceInstanceSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new V2PolicyResourceAttribute.
+func (in *V2PolicyResourceAttribute) DeepCopy() *V2PolicyResourceAttribute {
+	if in == nil {
+		return nil
+	}
+	out := new(V2PolicyResourceAttribute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *V2PolicyResourceTag) DeepCopyInto(out *V2PolicyResourceTag) {
+	*out = *in
+	if in.Operator != nil {
+		in, out := &in.Operator, &out.Operator
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new V2PolicyResourceTag.
+func (in *V2PolicyResourceTag) DeepCopy() *V2PolicyResourceTag {
+	if in == nil {
+		return nil
+	}
+	out := new(V2PolicyResourceTag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *V2PolicyRule) DeepCopyInto(out *V2PolicyRule) {
+	*out = *in
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]V2PolicyRuleCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new V2PolicyRule.
+func (in *V2PolicyRule) DeepCopy() *V2PolicyRule {
+	if in == nil {
+		return nil
+	}
+	out := new(V2PolicyRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *V2PolicyRuleCondition) DeepCopyInto(out *V2PolicyRuleCondition) {
+	*out = *in
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new V2PolicyRuleCondition.
+func (in *V2PolicyRuleCondition) DeepCopy() *V2PolicyRuleCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(V2PolicyRuleCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *V2PolicySpec) DeepCopyInto(out *V2PolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new V2PolicySpec.
+func (in *V2PolicySpec) DeepCopy() *V2PolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(V2PolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *V2PolicyStatus) DeepCopyInto(out *V2PolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new V2PolicyStatus.
+func (in *V2PolicyStatus) DeepCopy() *V2PolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(V2PolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *V2PolicySubject) DeepCopyInto(out *V2PolicySubject) {
+	*out = *in
+	if in.Attributes != nil {
+		in, out := &in.Attributes, &out.Attributes
+		*out = make([]V2PolicySubjectAttribute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new V2PolicySubject.
+func (in *V2PolicySubject) DeepCopy() *V2PolicySubject {
+	if in == nil {
+		return nil
+	}
+	out := new(V2PolicySubject)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *V2PolicySubjectAttribute) DeepCopyInto(out *V2PolicySubjectAttribute) {
+	*out = *in
+	if in.Operator != nil {
+		in, out := &in.Operator, &out.Operator
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+	if in.ValueGenericRef != nil {
+		in, out := &in.ValueGenericRef, &out.ValueGenericRef
+		*out = new(v1beta1.GenericReference)
+		**out = **in
+	}
+	if in.ServiceIDRef != nil {
+		in, out := &in.ServiceIDRef, &out.ServiceIDRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.ServiceIDSelector != nil {
+		in, out := &in.ServiceIDSelector, &out.ServiceIDSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TrustedProfileRef != nil {
+		in, out := &in.TrustedProfileRef, &out.TrustedProfileRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.TrustedProfileSelector != nil {
+		in, out := &in.TrustedProfileSelector, &out.TrustedProfileSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AccessGroupIDRef != nil {
+		in, out := &in.AccessGroupIDRef, &out.AccessGroupIDRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.AccessGroupIDSelector != nil {
+		in, out := &in.AccessGroupIDSelector, &out.AccessGroupIDSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new V2PolicySubjectAttribute.
+func (in *V2PolicySubjectAttribute) DeepCopy() *V2PolicySubjectAttribute {
+	if in == nil {
+		return nil
+	}
+	out := new(V2PolicySubjectAttribute)
+	in.DeepCopyInto(out)
+	return out
+}
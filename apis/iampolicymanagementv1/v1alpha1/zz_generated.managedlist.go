@@ -37,3 +37,12 @@ func (l *PolicyList) GetItems() []resource.Managed {
 	}
 	return items
 }
+
+// GetItems of this V2PolicyList.
+func (l *V2PolicyList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
@@ -22,12 +22,16 @@ import (
 
 	cv1 "github.com/crossplane-contrib/provider-ibm-cloud/apis/cloudantv1/v1alpha1"
 	contv2 "github.com/crossplane-contrib/provider-ibm-cloud/apis/container/containerv2/v1alpha1"
+	cbrv1 "github.com/crossplane-contrib/provider-ibm-cloud/apis/contextbasedrestrictionsv1/v1alpha1"
 	cos "github.com/crossplane-contrib/provider-ibm-cloud/apis/cos/v1alpha1"
 	esav1 "github.com/crossplane-contrib/provider-ibm-cloud/apis/eventstreamsadminv1/v1alpha1"
 	iamagv2 "github.com/crossplane-contrib/provider-ibm-cloud/apis/iamaccessgroupsv2/v1alpha1"
+	iamidv1 "github.com/crossplane-contrib/provider-ibm-cloud/apis/iamidentityv1/v1alpha1"
 	iampmv1 "github.com/crossplane-contrib/provider-ibm-cloud/apis/iampolicymanagementv1/v1alpha1"
 	icdv5 "github.com/crossplane-contrib/provider-ibm-cloud/apis/ibmclouddatabasesv5/v1alpha1"
 	rcv2 "github.com/crossplane-contrib/provider-ibm-cloud/apis/resourcecontrollerv2/v1alpha1"
+	rmgrv2 "github.com/crossplane-contrib/provider-ibm-cloud/apis/resourcemanagerv2/v1alpha1"
+	umv1 "github.com/crossplane-contrib/provider-ibm-cloud/apis/usermanagementv1/v1alpha1"
 	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
 	vpcv1 "github.com/crossplane-contrib/provider-ibm-cloud/apis/vpcv1/v1alpha1"
 )
@@ -37,9 +41,13 @@ func init() {
 	AddToSchemes = append(AddToSchemes,
 		v1beta1.SchemeBuilder.AddToScheme,
 		rcv2.SchemeBuilder.AddToScheme,
+		rmgrv2.SchemeBuilder.AddToScheme,
 		icdv5.SchemeBuilder.AddToScheme,
 		iampmv1.SchemeBuilder.AddToScheme,
 		iamagv2.SchemeBuilder.AddToScheme,
+		iamidv1.SchemeBuilder.AddToScheme,
+		umv1.SchemeBuilder.AddToScheme,
+		cbrv1.SchemeBuilder.AddToScheme,
 		esav1.SchemeBuilder.AddToScheme,
 		cv1.SchemeBuilder.AddToScheme,
 		cos.SchemeBuilder.AddToScheme,
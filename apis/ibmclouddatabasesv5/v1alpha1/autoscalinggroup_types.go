@@ -21,6 +21,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 
 	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
 )
 
 // In spec mandatory fields should be by value, and optional fields pointers
@@ -48,6 +50,11 @@ type AutoscalingGroupParameters struct {
 	// +optional
 	IDSelector *runtimev1alpha1.Selector `json:"idSelector,omitempty"`
 
+	// A generic reference to a field of any managed resource, used to set ID
+	// +immutable
+	// +optional
+	IDGenericRef *v1beta1.GenericReference `json:"idGenericRef,omitempty"`
+
 	// Disk -
 	// +optional
 	Disk *AutoscalingDiskGroupDisk `json:"disk,omitempty"`
@@ -25,13 +25,19 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/common"
 	rcv2 "github.com/crossplane-contrib/provider-ibm-cloud/apis/resourcecontrollerv2/v1alpha1"
 )
 
 // ResolveReferences of this ScalingGroup
 func (mg *ScalingGroup) ResolveReferences(ctx context.Context, c client.Reader) error {
-	r := reference.NewAPIResolver(c, mg)
+	id, err := common.ResolveGeneric(ctx, c, mg, reference.FromPtrValue(mg.Spec.ForProvider.ID), mg.Spec.ForProvider.IDGenericRef)
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.id")
+	}
+	mg.Spec.ForProvider.ID = reference.ToPtrValue(id)
 
+	r := common.NewAPIResolver(c, mg)
 	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
 		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ID),
 		Reference:    mg.Spec.ForProvider.IDRef,
@@ -49,8 +55,13 @@ func (mg *ScalingGroup) ResolveReferences(ctx context.Context, c client.Reader)
 
 // ResolveReferences of this Whitelist
 func (mg *Whitelist) ResolveReferences(ctx context.Context, c client.Reader) error {
-	r := reference.NewAPIResolver(c, mg)
+	id, err := common.ResolveGeneric(ctx, c, mg, reference.FromPtrValue(mg.Spec.ForProvider.ID), mg.Spec.ForProvider.IDGenericRef)
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.id")
+	}
+	mg.Spec.ForProvider.ID = reference.ToPtrValue(id)
 
+	r := common.NewAPIResolver(c, mg)
 	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
 		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ID),
 		Reference:    mg.Spec.ForProvider.IDRef,
@@ -68,8 +79,13 @@ func (mg *Whitelist) ResolveReferences(ctx context.Context, c client.Reader) err
 
 // ResolveReferences of this AutoScalingGroup
 func (mg *AutoscalingGroup) ResolveReferences(ctx context.Context, c client.Reader) error {
-	r := reference.NewAPIResolver(c, mg)
+	id, err := common.ResolveGeneric(ctx, c, mg, reference.FromPtrValue(mg.Spec.ForProvider.ID), mg.Spec.ForProvider.IDGenericRef)
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.id")
+	}
+	mg.Spec.ForProvider.ID = reference.ToPtrValue(id)
 
+	r := common.NewAPIResolver(c, mg)
 	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
 		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ID),
 		Reference:    mg.Spec.ForProvider.IDRef,
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
 )
 
 // In spec mandatory fields should be by value, and optional fields pointers
@@ -43,6 +45,11 @@ type ScalingGroupParameters struct {
 	// +optional
 	IDSelector *runtimev1alpha1.Selector `json:"idSelector,omitempty"`
 
+	// A generic reference to a field of any managed resource, used to set ID
+	// +immutable
+	// +optional
+	IDGenericRef *v1beta1.GenericReference `json:"idGenericRef,omitempty"`
+
 	// Members -
 	Members *SetMembersGroupMembers `json:"members,omitempty"`
 
@@ -88,6 +95,10 @@ type ScalingGroupObservation struct {
 	Groups []Group `json:"groups,omitempty"`
 	// The current state of the scaling group
 	State string `json:"state,omitempty"`
+
+	// The estimated monthly cost of the memory, disk and CPU allocations of the groups, from the pricing data of the
+	// global catalog. Only set if cost estimation is enabled in the ProviderConfig.
+	EstimatedMonthlyCost string `json:"estimatedMonthlyCost,omitempty"`
 }
 
 // Group : Group struct
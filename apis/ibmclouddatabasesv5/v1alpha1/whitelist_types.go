@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
 )
 
 // In spec mandatory fields should be by value, and optional fields pointers
@@ -43,6 +45,11 @@ type WhitelistParameters struct {
 	// +optional
 	IDSelector *runtimev1alpha1.Selector `json:"idSelector,omitempty"`
 
+	// A generic reference to a field of any managed resource, used to set ID
+	// +immutable
+	// +optional
+	IDGenericRef *v1beta1.GenericReference `json:"idGenericRef,omitempty"`
+
 	// An array of allowlist entries.
 	IPAddresses []WhitelistEntry `json:"ipAddresses,omitempty"`
 
@@ -22,6 +22,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
 	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -318,6 +319,11 @@ func (in *AutoscalingGroupParameters) DeepCopyInto(out *AutoscalingGroupParamete
 		*out = new(corev1alpha1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.IDGenericRef != nil {
+		in, out := &in.IDGenericRef, &out.IDGenericRef
+		*out = new(v1beta1.GenericReference)
+		**out = **in
+	}
 	if in.Disk != nil {
 		in, out := &in.Disk, &out.Disk
 		*out = new(AutoscalingDiskGroupDisk)
@@ -814,6 +820,11 @@ func (in *ScalingGroupParameters) DeepCopyInto(out *ScalingGroupParameters) {
 		*out = new(corev1alpha1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.IDGenericRef != nil {
+		in, out := &in.IDGenericRef, &out.IDGenericRef
+		*out = new(v1beta1.GenericReference)
+		**out = **in
+	}
 	if in.Members != nil {
 		in, out := &in.Members, &out.Members
 		*out = new(SetMembersGroupMembers)
@@ -1059,6 +1070,11 @@ func (in *WhitelistParameters) DeepCopyInto(out *WhitelistParameters) {
 		*out = new(corev1alpha1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.IDGenericRef != nil {
+		in, out := &in.IDGenericRef, &out.IDGenericRef
+		*out = new(v1beta1.GenericReference)
+		**out = **in
+	}
 	if in.IPAddresses != nil {
 		in, out := &in.IPAddresses, &out.IPAddresses
 		*out = make([]WhitelistEntry, len(*in))
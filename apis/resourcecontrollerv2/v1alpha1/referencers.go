@@ -24,11 +24,36 @@ import (
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/common"
+	rmgrv2 "github.com/crossplane-contrib/provider-ibm-cloud/apis/resourcemanagerv2/v1alpha1"
 )
 
 // ResolveReferences of this ResourceKey
 func (mg *ResourceKey) ResolveReferences(ctx context.Context, c client.Reader) error {
-	r := reference.NewAPIResolver(c, mg)
+	source, err := common.ResolveGeneric(ctx, c, mg, reference.FromPtrValue(mg.Spec.ForProvider.Source), mg.Spec.ForProvider.SourceGenericRef)
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.Source")
+	}
+	mg.Spec.ForProvider.Source = reference.ToPtrValue(source)
+
+	r := common.NewAPIResolver(c, mg)
+
+	if mg.Spec.ForProvider.SourceAliasRef != nil || mg.Spec.ForProvider.SourceAliasSelector != nil {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Source),
+			Reference:    mg.Spec.ForProvider.SourceAliasRef,
+			Selector:     mg.Spec.ForProvider.SourceAliasSelector,
+			To:           reference.To{Managed: &ResourceAlias{}, List: &ResourceAliasList{}},
+			Extract:      aliasGUID(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.Source")
+		}
+		mg.Spec.ForProvider.Source = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.SourceAliasRef = rsp.ResolvedReference
+		return nil
+	}
 
 	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
 		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Source),
@@ -55,3 +80,69 @@ func SourceGUID() reference.ExtractValueFn {
 		return cr.Status.AtProvider.GUID
 	}
 }
+
+// aliasGUID extracts the resolved ResourceAlias's GUID
+func aliasGUID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		cr, ok := mg.(*ResourceAlias)
+		if !ok {
+			return ""
+		}
+		return cr.Status.AtProvider.GUID
+	}
+}
+
+// ResolveReferences of this ResourceAlias
+func (mg *ResourceAlias) ResolveReferences(ctx context.Context, c client.Reader) error {
+	source, err := common.ResolveGeneric(ctx, c, mg, reference.FromPtrValue(mg.Spec.ForProvider.Source), mg.Spec.ForProvider.SourceGenericRef)
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.Source")
+	}
+	mg.Spec.ForProvider.Source = reference.ToPtrValue(source)
+
+	r := common.NewAPIResolver(c, mg)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Source),
+		Reference:    mg.Spec.ForProvider.SourceRef,
+		Selector:     mg.Spec.ForProvider.SourceSelector,
+		To:           reference.To{Managed: &ResourceInstance{}, List: &ResourceInstanceList{}},
+		Extract:      SourceGUID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.Source")
+	}
+	mg.Spec.ForProvider.Source = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SourceRef = rsp.ResolvedReference
+	return nil
+}
+
+// ResolveReferences of this ResourceInstance
+func (mg *ResourceInstance) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := common.NewAPIResolver(c, mg)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ResourceGroupName),
+		Reference:    mg.Spec.ForProvider.ResourceGroupNameRef,
+		Selector:     mg.Spec.ForProvider.ResourceGroupNameSelector,
+		To:           reference.To{Managed: &rmgrv2.ResourceGroup{}, List: &rmgrv2.ResourceGroupList{}},
+		Extract:      resourceGroupName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceGroupName")
+	}
+	mg.Spec.ForProvider.ResourceGroupName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
+	return nil
+}
+
+// resourceGroupName extracts the name of the resolved ResourceGroup, once it has been created
+func resourceGroupName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		cr, ok := mg.(*rmgrv2.ResourceGroup)
+		if !ok || cr.Status.AtProvider.ID == "" {
+			return ""
+		}
+		return cr.Spec.ForProvider.Name
+	}
+}
@@ -48,6 +48,11 @@ var (
 	ResourceKeyGroupKind        = schema.GroupKind{Group: Group, Kind: ResourceKeyKind}.String()
 	ResourceKeyKindAPIVersion   = ResourceKeyKind + "." + SchemeGroupVersion.String()
 	ResourceKeyGroupVersionKind = SchemeGroupVersion.WithKind(ResourceKeyKind)
+
+	ResourceAliasKind             = reflect.TypeOf(ResourceAlias{}).Name()
+	ResourceAliasGroupKind        = schema.GroupKind{Group: Group, Kind: ResourceAliasKind}.String()
+	ResourceAliasKindAPIVersion   = ResourceAliasKind + "." + SchemeGroupVersion.String()
+	ResourceAliasGroupVersionKind = SchemeGroupVersion.WithKind(ResourceAliasKind)
 )
 
 func init() {
@@ -55,5 +60,7 @@ func init() {
 		&ResourceInstance{},
 		&ResourceInstanceList{},
 		&ResourceKey{},
-		&ResourceKeyList{})
+		&ResourceKeyList{},
+		&ResourceAlias{},
+		&ResourceAliasList{})
 }
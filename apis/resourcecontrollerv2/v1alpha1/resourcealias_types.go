@@ -0,0 +1,166 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
+)
+
+// ResourceAliasParameters are the configurable fields of a ResourceAlias.
+type ResourceAliasParameters struct {
+	// The name of the alias. Must be 180 characters or less and cannot include any special characters other than
+	// `(space) - . _ :`.
+	Name string `json:"name"`
+
+	// The short or long ID of the resource instance being aliased.
+	// +immutable
+	// +optional
+	Source *string `json:"source,omitempty"`
+
+	// A reference to a ResourceInstance used to set Source
+	// +immutable
+	// +optional
+	SourceRef *runtimev1alpha1.Reference `json:"sourceRef,omitempty"`
+
+	// SourceSelector selects a reference to a ResourceInstance used to set Source
+	// +immutable
+	// +optional
+	SourceSelector *runtimev1alpha1.Selector `json:"sourceSelector,omitempty"`
+
+	// A generic reference to a field of any managed resource, used to set Source
+	// +immutable
+	// +optional
+	SourceGenericRef *v1beta1.GenericReference `json:"sourceGenericRef,omitempty"`
+
+	// The CRN of target name(space) in a specific environment, e.g. space in Dallas YP, CFEE instance etc.
+	// +immutable
+	Target string `json:"target"`
+
+	// User tags attached to the alias through the Global Tagging API. If not set, user tags are not managed.
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+
+	// Access tags attached to the alias through the Global Tagging API. Access tags must be in the `key:value`
+	// format and can be used to control access with IAM policies. If not set, access tags are not managed.
+	// +optional
+	AccessTags []string `json:"accessTags,omitempty"`
+}
+
+// ResourceAliasObservation are the observable fields of a ResourceAlias.
+type ResourceAliasObservation struct {
+	// The ID associated with the alias.
+	ID string `json:"id,omitempty"`
+
+	// When you create a new alias, a globally unique identifier (GUID) is assigned. This GUID is a unique internal
+	// identifier managed by the resource controller that corresponds to the alias.
+	GUID string `json:"guid,omitempty"`
+
+	// The full Cloud Resource Name (CRN) associated with the alias. For more information about this format, see [Cloud
+	// Resource Names](https://cloud.ibm.com/docs/overview?topic=overview-crn).
+	CRN string `json:"crn,omitempty"`
+
+	// When you created a new alias, a relative URL path is created identifying the location of the alias.
+	URL string `json:"url,omitempty"`
+
+	// An alpha-numeric value identifying the account ID.
+	AccountID string `json:"accountId,omitempty"`
+
+	// The short ID of the resource group.
+	ResourceGroupID string `json:"resourceGroupId,omitempty"`
+
+	// The long ID (full CRN) of the resource group.
+	ResourceGroupCRN string `json:"resourceGroupCrn,omitempty"`
+
+	// The state of the alias.
+	State string `json:"state,omitempty"`
+
+	// The short ID of the resource instance that is being aliased.
+	ResourceInstanceID string `json:"resourceInstanceId,omitempty"`
+
+	// The short ID of the instance in the specific target environment, e.g. `service_instance_id` in a given IBM Cloud
+	// environment.
+	RegionInstanceID string `json:"regionInstanceId,omitempty"`
+
+	// The relative path to the instance.
+	ResourceInstanceURL string `json:"resourceInstanceUrl,omitempty"`
+
+	// The relative path to the resource bindings for the alias.
+	ResourceBindingsURL string `json:"resourceBindingsUrl,omitempty"`
+
+	// The relative path to the resource keys for the alias.
+	ResourceKeysURL string `json:"resourceKeysUrl,omitempty"`
+
+	// The date when the alias was created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// The date when the alias was last updated.
+	UpdatedAt *metav1.Time `json:"updatedAt,omitempty"`
+
+	// The date when the alias was deleted.
+	DeletedAt *metav1.Time `json:"deletedAt,omitempty"`
+
+	// The subject who created the alias.
+	CreatedBy string `json:"createdBy,omitempty"`
+
+	// The subject who updated the alias.
+	UpdatedBy string `json:"updatedBy,omitempty"`
+
+	// The subject who deleted the alias.
+	DeletedBy string `json:"deletedBy,omitempty"`
+}
+
+// A ResourceAliasSpec defines the desired state of a ResourceAlias.
+type ResourceAliasSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ResourceAliasParameters `json:"forProvider"`
+}
+
+// A ResourceAliasStatus represents the observed state of a ResourceAlias.
+type ResourceAliasStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ResourceAliasObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ResourceAlias binds a resource instance into another region or Cloud Foundry space on IBM Cloud
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.bindingPhase"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="CLASS",type="string",JSONPath=".spec.classRef.name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,ibmcloud}
+type ResourceAlias struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ResourceAliasSpec   `json:"spec"`
+	Status ResourceAliasStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ResourceAliasList contains a list of ResourceAlias
+type ResourceAliasList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ResourceAlias `json:"items"`
+}
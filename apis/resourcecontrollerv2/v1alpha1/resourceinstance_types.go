@@ -38,6 +38,16 @@ type ResourceInstanceParameters struct {
 	// +optional
 	ResourceGroupName *string `json:"resourceGroupName,omitempty"`
 
+	// A reference to the ResourceGroup used to set ResourceGroupName
+	// +immutable
+	// +optional
+	ResourceGroupNameRef *runtimev1alpha1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// Selects a reference to the ResourceGroup used to set ResourceGroupName
+	// +immutable
+	// +optional
+	ResourceGroupNameSelector *runtimev1alpha1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
 	// The name of the service offering like cloud-object-storage, kms etc
 	// +immutable
 	ServiceName string `json:"serviceName"`
@@ -50,6 +60,11 @@ type ResourceInstanceParameters struct {
 	// +optional
 	Tags []string `json:"tags,omitempty"`
 
+	// Access tags attached to the instance through the Global Tagging API. Access tags must be in the `key:value`
+	// format and can be used to control access with IAM policies. If not set, access tags are not managed.
+	// +optional
+	AccessTags []string `json:"accessTags,omitempty"`
+
 	// A boolean that dictates if the resource instance should be deleted (cleaned up) during the processing of a region
 	// instance delete call.
 	// +optional
@@ -157,6 +172,10 @@ type ResourceInstanceObservation struct {
 
 	// The subject who restored the instance back from reclamation.
 	RestoredBy string `json:"restoredBy,omitempty"`
+
+	// The estimated monthly cost of the fixed (i.e. instance or hourly) charges of the plan, from the pricing data of
+	// the global catalog. Only set if cost estimation is enabled in the ProviderConfig.
+	EstimatedMonthlyCost string `json:"estimatedMonthlyCost,omitempty"`
 }
 
 // PlanHistoryItem : An element of the plan history of the instance.
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
 )
 
 // ResourceKeyParameters are the configurable fields of a ResourceKey.
@@ -42,6 +44,22 @@ type ResourceKeyParameters struct {
 	// +optional
 	SourceSelector *runtimev1alpha1.Selector `json:"sourceSelector,omitempty"`
 
+	// A reference to a ResourceAlias used to set Source, when the key should be created against an alias
+	// rather than the instance itself
+	// +immutable
+	// +optional
+	SourceAliasRef *runtimev1alpha1.Reference `json:"sourceAliasRef,omitempty"`
+
+	// SourceAliasSelector selects a reference to a ResourceAlias used to set Source
+	// +immutable
+	// +optional
+	SourceAliasSelector *runtimev1alpha1.Selector `json:"sourceAliasSelector,omitempty"`
+
+	// A generic reference to a field of any managed resource, used to set Source
+	// +immutable
+	// +optional
+	SourceGenericRef *v1beta1.GenericReference `json:"sourceGenericRef,omitempty"`
+
 	// Configuration options represented as key-value pairs. Service defined options are passed through to the target
 	// resource brokers, whereas platform defined options are not.
 	// +optional
@@ -50,6 +68,15 @@ type ResourceKeyParameters struct {
 	// The role name or it's CRN.
 	// +optional
 	Role *string `json:"role,omitempty"`
+
+	// User tags attached to the key through the Global Tagging API. If not set, user tags are not managed.
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+
+	// Access tags attached to the key through the Global Tagging API. Access tags must be in the `key:value`
+	// format and can be used to control access with IAM policies. If not set, access tags are not managed.
+	// +optional
+	AccessTags []string `json:"accessTags,omitempty"`
 }
 
 // ResourceKeyPostParameters : Configuration options represented as key-value pairs. Service defined options are passed through to the target
@@ -130,17 +157,75 @@ type Credentials struct {
 	IamServiceidCRN string `json:"iamServiceidCrn,omitempty"`
 }
 
+// ResourceKeyRotation configures the periodic rotation of the credentials of a ResourceKey. On each rotation a
+// new key is created and published to the connection secret, and the replaced key is deleted once the overlap
+// window has passed. Exactly one of Interval or Schedule must be set.
+type ResourceKeyRotation struct {
+	// The time between two rotations, e.g. "2160h" for 90 days.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// A schedule in the standard 5-field cron format (minute hour day-of-month month day-of-week), evaluated
+	// in UTC, e.g. "0 3 1 */3 *".
+	// +optional
+	Schedule *string `json:"schedule,omitempty"`
+
+	// How long a replaced key is kept before being deleted, so consumers have time to pick up the new
+	// credentials. Defaults to 0, in which case the replaced key is deleted at the next reconciliation.
+	// +optional
+	Overlap *metav1.Duration `json:"overlap,omitempty"`
+}
+
+// RotatedResourceKey : a key that has been replaced by a rotation.
+type RotatedResourceKey struct {
+	// The ID of the replaced key.
+	ID string `json:"id"`
+
+	// The date when the key was replaced.
+	RotatedAt metav1.Time `json:"rotatedAt"`
+
+	// The date after which the key is deleted.
+	DeleteAfter metav1.Time `json:"deleteAfter"`
+
+	// The date when the key was deleted.
+	// +optional
+	DeletedAt *metav1.Time `json:"deletedAt,omitempty"`
+}
+
+// ResourceKeyRotationStatus : the observed state of the rotation of a ResourceKey.
+type ResourceKeyRotationStatus struct {
+	// The date of the last rotation.
+	// +optional
+	LastRotationTime *metav1.Time `json:"lastRotationTime,omitempty"`
+
+	// The date of the next rotation.
+	// +optional
+	NextRotationTime *metav1.Time `json:"nextRotationTime,omitempty"`
+
+	// The keys replaced by the most recent rotations, oldest first.
+	// +optional
+	History []RotatedResourceKey `json:"history,omitempty"`
+}
+
 // A ResourceKeySpec defines the desired state of a ResourceKey.
 type ResourceKeySpec struct {
 	runtimev1alpha1.ResourceSpec `json:",inline"`
 	ConnectionTemplates          map[string]string     `json:"connectionTemplates,omitempty"`
 	ForProvider                  ResourceKeyParameters `json:"forProvider"`
+
+	// Rotation configures the periodic rotation of the credentials of the key.
+	// +optional
+	Rotation *ResourceKeyRotation `json:"rotation,omitempty"`
 }
 
 // A ResourceKeyStatus represents the observed state of a ResourceKey.
 type ResourceKeyStatus struct {
 	runtimev1alpha1.ResourceStatus `json:",inline"`
 	AtProvider                     ResourceKeyObservation `json:"atProvider,omitempty"`
+
+	// Rotation is the observed state of the credential rotation, if any.
+	// +optional
+	Rotation *ResourceKeyRotationStatus `json:"rotation,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -22,7 +22,9 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
 	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -60,6 +62,171 @@ func (in *PlanHistoryItem) DeepCopy() *PlanHistoryItem {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceAlias) DeepCopyInto(out *ResourceAlias) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceAlias.
+func (in *ResourceAlias) DeepCopy() *ResourceAlias {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceAlias)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourceAlias) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceAliasList) DeepCopyInto(out *ResourceAliasList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ResourceAlias, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceAliasList.
+func (in *ResourceAliasList) DeepCopy() *ResourceAliasList {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceAliasList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourceAliasList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceAliasObservation) DeepCopyInto(out *ResourceAliasObservation) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.DeletedAt != nil {
+		in, out := &in.DeletedAt, &out.DeletedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceAliasObservation.
+func (in *ResourceAliasObservation) DeepCopy() *ResourceAliasObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceAliasObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceAliasParameters) DeepCopyInto(out *ResourceAliasParameters) {
+	*out = *in
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceRef != nil {
+		in, out := &in.SourceRef, &out.SourceRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.SourceSelector != nil {
+		in, out := &in.SourceSelector, &out.SourceSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceGenericRef != nil {
+		in, out := &in.SourceGenericRef, &out.SourceGenericRef
+		*out = new(v1beta1.GenericReference)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AccessTags != nil {
+		in, out := &in.AccessTags, &out.AccessTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceAliasParameters.
+func (in *ResourceAliasParameters) DeepCopy() *ResourceAliasParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceAliasParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceAliasSpec) DeepCopyInto(out *ResourceAliasSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceAliasSpec.
+func (in *ResourceAliasSpec) DeepCopy() *ResourceAliasSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceAliasSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceAliasStatus) DeepCopyInto(out *ResourceAliasStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceAliasStatus.
+func (in *ResourceAliasStatus) DeepCopy() *ResourceAliasStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceAliasStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceInstance) DeepCopyInto(out *ResourceInstance) {
 	*out = *in
@@ -179,11 +346,26 @@ func (in *ResourceInstanceParameters) DeepCopyInto(out *ResourceInstanceParamete
 		*out = new(string)
 		**out = **in
 	}
+	if in.ResourceGroupNameRef != nil {
+		in, out := &in.ResourceGroupNameRef, &out.ResourceGroupNameRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.ResourceGroupNameSelector != nil {
+		in, out := &in.ResourceGroupNameSelector, &out.ResourceGroupNameSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AccessTags != nil {
+		in, out := &in.AccessTags, &out.AccessTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.AllowCleanup != nil {
 		in, out := &in.AllowCleanup, &out.AllowCleanup
 		*out = new(bool)
@@ -344,6 +526,21 @@ func (in *ResourceKeyParameters) DeepCopyInto(out *ResourceKeyParameters) {
 		*out = new(corev1alpha1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SourceAliasRef != nil {
+		in, out := &in.SourceAliasRef, &out.SourceAliasRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.SourceAliasSelector != nil {
+		in, out := &in.SourceAliasSelector, &out.SourceAliasSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceGenericRef != nil {
+		in, out := &in.SourceGenericRef, &out.SourceGenericRef
+		*out = new(v1beta1.GenericReference)
+		**out = **in
+	}
 	if in.Parameters != nil {
 		in, out := &in.Parameters, &out.Parameters
 		*out = new(ResourceKeyPostParameters)
@@ -354,6 +551,16 @@ func (in *ResourceKeyParameters) DeepCopyInto(out *ResourceKeyParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AccessTags != nil {
+		in, out := &in.AccessTags, &out.AccessTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceKeyParameters.
@@ -381,6 +588,66 @@ func (in *ResourceKeyPostParameters) DeepCopy() *ResourceKeyPostParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceKeyRotation) DeepCopyInto(out *ResourceKeyRotation) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(string)
+		**out = **in
+	}
+	if in.Overlap != nil {
+		in, out := &in.Overlap, &out.Overlap
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceKeyRotation.
+func (in *ResourceKeyRotation) DeepCopy() *ResourceKeyRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceKeyRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceKeyRotationStatus) DeepCopyInto(out *ResourceKeyRotationStatus) {
+	*out = *in
+	if in.LastRotationTime != nil {
+		in, out := &in.LastRotationTime, &out.LastRotationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextRotationTime != nil {
+		in, out := &in.NextRotationTime, &out.NextRotationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]RotatedResourceKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceKeyRotationStatus.
+func (in *ResourceKeyRotationStatus) DeepCopy() *ResourceKeyRotationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceKeyRotationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceKeySpec) DeepCopyInto(out *ResourceKeySpec) {
 	*out = *in
@@ -393,6 +660,11 @@ func (in *ResourceKeySpec) DeepCopyInto(out *ResourceKeySpec) {
 		}
 	}
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.Rotation != nil {
+		in, out := &in.Rotation, &out.Rotation
+		*out = new(ResourceKeyRotation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceKeySpec.
@@ -410,6 +682,11 @@ func (in *ResourceKeyStatus) DeepCopyInto(out *ResourceKeyStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	if in.Rotation != nil {
+		in, out := &in.Rotation, &out.Rotation
+		*out = new(ResourceKeyRotationStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceKeyStatus.
@@ -421,3 +698,24 @@ func (in *ResourceKeyStatus) DeepCopy() *ResourceKeyStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RotatedResourceKey) DeepCopyInto(out *RotatedResourceKey) {
+	*out = *in
+	in.RotatedAt.DeepCopyInto(&out.RotatedAt)
+	in.DeleteAfter.DeepCopyInto(&out.DeleteAfter)
+	if in.DeletedAt != nil {
+		in, out := &in.DeletedAt, &out.DeletedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RotatedResourceKey.
+func (in *RotatedResourceKey) DeepCopy() *RotatedResourceKey {
+	if in == nil {
+		return nil
+	}
+	out := new(RotatedResourceKey)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -20,6 +20,15 @@ package v1alpha1
 
 import resource "github.com/crossplane/crossplane-runtime/pkg/resource"
 
+// GetItems of this ResourceAliasList.
+func (l *ResourceAliasList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this ResourceInstanceList.
 func (l *ResourceInstanceList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -0,0 +1,156 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// ResourceGroupParameters are the configurable fields of a ResourceGroup.
+type ResourceGroupParameters struct {
+	// The name of the resource group.
+	Name string `json:"name"`
+
+	// The account id of the resource group. Defaults to the account of the credentials used by the provider.
+	// +immutable
+	// +optional
+	AccountID *string `json:"accountId,omitempty"`
+}
+
+// ResourceQuota : A resource quota.
+type ResourceQuota struct {
+	// An alpha-numeric value identifying the quota.
+	ID string `json:"id,omitempty"`
+
+	// The human-readable name of the quota.
+	ResourceID string `json:"resourceId,omitempty"`
+
+	// The full CRN (cloud resource name) associated with the quota.
+	CRN string `json:"crn,omitempty"`
+
+	// The limit number of this resource.
+	Limit int64 `json:"limit,omitempty"`
+}
+
+// QuotaDefinitionObservation : The quota definition of a resource group.
+type QuotaDefinitionObservation struct {
+	// An alpha-numeric value identifying the quota.
+	ID string `json:"id,omitempty"`
+
+	// The human-readable name of the quota.
+	Name string `json:"name,omitempty"`
+
+	// The type of the quota.
+	Type string `json:"type,omitempty"`
+
+	// The total app limit.
+	NumberOfApps int64 `json:"numberOfApps,omitempty"`
+
+	// The total service instances limit per app.
+	NumberOfServiceInstances int64 `json:"numberOfServiceInstances,omitempty"`
+
+	// Default number of instances per lite plan.
+	DefaultNumberOfInstancesPerLitePlan int64 `json:"defaultNumberOfInstancesPerLitePlan,omitempty"`
+
+	// The total instances limit per app.
+	InstancesPerApp int64 `json:"instancesPerApp,omitempty"`
+
+	// The total instance memory.
+	InstanceMemory string `json:"instanceMemory,omitempty"`
+
+	// The total app memory capacity.
+	TotalAppMemory string `json:"totalAppMemory,omitempty"`
+
+	// The VSI limit.
+	VSILimit int64 `json:"vsiLimit,omitempty"`
+
+	// The resource quotas associated with the quota definition.
+	ResourceQuotas []ResourceQuota `json:"resourceQuotas,omitempty"`
+}
+
+// ResourceGroupObservation are the observable fields of a ResourceGroup.
+type ResourceGroupObservation struct {
+	// An alpha-numeric value identifying the resource group.
+	ID string `json:"id,omitempty"`
+
+	// The full CRN (cloud resource name) associated with the resource group.
+	CRN string `json:"crn,omitempty"`
+
+	// An alpha-numeric value identifying the account ID.
+	AccountID string `json:"accountId,omitempty"`
+
+	// The state of the resource group.
+	State string `json:"state,omitempty"`
+
+	// Identify if this resource group is default of the account or not.
+	Default bool `json:"default,omitempty"`
+
+	// An alpha-numeric value identifying the quota ID associated with the resource group.
+	QuotaID string `json:"quotaId,omitempty"`
+
+	// The URL to access the quota details that associated with the resource group.
+	QuotaURL string `json:"quotaUrl,omitempty"`
+
+	// The quota definition associated with the resource group.
+	Quota *QuotaDefinitionObservation `json:"quota,omitempty"`
+
+	// The date when the resource group was initially created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// The date when the resource group was last updated.
+	UpdatedAt *metav1.Time `json:"updatedAt,omitempty"`
+}
+
+// A ResourceGroupSpec defines the desired state of a ResourceGroup.
+type ResourceGroupSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ResourceGroupParameters `json:"forProvider"`
+}
+
+// A ResourceGroupStatus represents the observed state of a ResourceGroup.
+type ResourceGroupStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ResourceGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ResourceGroup represents a resource group in an IBM Cloud account
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.bindingPhase"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="CLASS",type="string",JSONPath=".spec.classRef.name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,ibmcloud}
+type ResourceGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ResourceGroupSpec   `json:"spec"`
+	Status ResourceGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ResourceGroupList contains a list of ResourceGroup
+type ResourceGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ResourceGroup `json:"items"`
+}
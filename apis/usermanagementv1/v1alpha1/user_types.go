@@ -0,0 +1,123 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// In spec mandatory fields should be by value, and optional fields pointers
+// In status, all fields should be by value, except timestamps - metav1.Time, and runtime.RawExtension which requires special treatment
+// https://github.com/crossplane/crossplane/blob/master/design/one-pager-managed-resource-api-design.md#pointer-types-and-markers
+
+// UserParameters are the configurable fields of a User.
+type UserParameters struct {
+	// ID of the account the user is invited to.
+	// +immutable
+	AccountID string `json:"accountId"`
+
+	// The email address the invitation to join the account is sent to.
+	// +immutable
+	Email string `json:"email"`
+
+	// The account role of the user, e.g. 'Member'.
+	// +immutable
+	// +optional
+	AccountRole *string `json:"accountRole,omitempty"`
+
+	// The console UI language of the user.
+	// +optional
+	Language *string `json:"language,omitempty"`
+
+	// The language for the email and phone notifications of the user.
+	// +optional
+	NotificationLanguage *string `json:"notificationLanguage,omitempty"`
+
+	// The IP addresses from which the user can log in to the account, as a comma separated list.
+	// +optional
+	AllowedIPAddresses *string `json:"allowedIpAddresses,omitempty"`
+
+	// Whether user managed login is enabled.
+	// +optional
+	SelfManage *bool `json:"selfManage,omitempty"`
+}
+
+// UserObservation are the observable fields of a User.
+type UserObservation struct {
+	// The IAM ID of the user.
+	IamID string `json:"iamId,omitempty"`
+
+	// An alphanumeric value identifying the user profile.
+	ID string `json:"id,omitempty"`
+
+	// The realm of the user, either 'IBMid' or 'SL'.
+	Realm string `json:"realm,omitempty"`
+
+	// The user ID used for login.
+	UserID string `json:"userId,omitempty"`
+
+	// The first name of the user.
+	Firstname string `json:"firstname,omitempty"`
+
+	// The last name of the user.
+	Lastname string `json:"lastname,omitempty"`
+
+	// The state of the user; one of 'PROCESSING', 'PENDING' (until the invitation is accepted), 'ACTIVE',
+	// 'DISABLED_CLASSIC_INFRASTRUCTURE' and 'VPN_ONLY'.
+	State string `json:"state,omitempty"`
+}
+
+// A UserSpec defines the desired state of a User.
+type UserSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  UserParameters `json:"forProvider"`
+}
+
+// A UserStatus represents the observed state of a User.
+type UserStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     UserObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A User represents a user of an account on IBM Cloud. Creating a User invites it to the account, and deleting it
+// removes the user from the account.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.bindingPhase"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="CLASS",type="string",JSONPath=".spec.classRef.name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,ibmcloud}
+type User struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   UserSpec   `json:"spec"`
+	Status UserStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// UserList contains a list of User
+type UserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []User `json:"items"`
+}
@@ -27,6 +27,92 @@ type ProviderConfigSpec struct {
 	v1alpha1.ProviderConfigSpec `json:",inline"`
 	// Region for IBM Cloud API
 	Region string `json:"region,omitempty"`
+
+	// Tags attached to the resources using this ProviderConfig that do not specify their own tags.
+	// +optional
+	DefaultTags []string `json:"defaultTags,omitempty"`
+
+	// Name of the resource group used by the resources using this ProviderConfig that do not specify
+	// their own resource group. If not set, the default resource group of the account is used.
+	// +optional
+	DefaultResourceGroupName *string `json:"defaultResourceGroupName,omitempty"`
+
+	// Policy the names of the resources using this ProviderConfig must comply with. Resources violating
+	// it are not created.
+	// +optional
+	NamingPolicy *NamingPolicy `json:"namingPolicy,omitempty"`
+
+	// Guardrails restricting the values the resources using this ProviderConfig may request. Resources requesting
+	// disallowed values are neither created nor updated.
+	// +optional
+	Guardrails *Guardrails `json:"guardrails,omitempty"`
+
+	// CostEstimation enables the estimation of the monthly cost of the resources using this ProviderConfig, from the
+	// pricing data of the global catalog. The estimates are written to the status of the resources that support it.
+	// +optional
+	CostEstimation *CostEstimation `json:"costEstimation,omitempty"`
+}
+
+// CostEstimation configures the monthly cost estimates of resources.
+type CostEstimation struct {
+	// Country whose prices are used, as an ISO 3166-1 alpha-3 code. Defaults to `USA`.
+	// +optional
+	Country *string `json:"country,omitempty"`
+
+	// Currency of the estimates, as an ISO 4217 code. Defaults to `USD`.
+	// +optional
+	Currency *string `json:"currency,omitempty"`
+
+	// The maximum estimated monthly cost of a single resource, in the currency of the estimates (e.g. `250.00`).
+	// Resources whose estimate exceeds it are not created, and scaling groups are not scaled beyond it.
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	// +optional
+	MonthlyBudget *string `json:"monthlyBudget,omitempty"`
+}
+
+// Guardrails restrict the values resources may request.
+type Guardrails struct {
+	// Service names, as in the global catalog (e.g. `databases-for-postgresql`).
+	// +optional
+	Services *AllowDenyList `json:"services,omitempty"`
+
+	// Resource plan names, as in the global catalog (e.g. `standard`).
+	// +optional
+	Plans *AllowDenyList `json:"plans,omitempty"`
+
+	// Regions, or `global`, resource instances are deployed to (e.g. `us-south`).
+	// +optional
+	Regions *AllowDenyList `json:"regions,omitempty"`
+
+	// VPC zones subnets and cluster workers are deployed to (e.g. `us-south-1`).
+	// +optional
+	Zones *AllowDenyList `json:"zones,omitempty"`
+
+	// Flavors of cluster workers (e.g. `bx2.4x16`).
+	// +optional
+	ClusterFlavors *AllowDenyList `json:"clusterFlavors,omitempty"`
+}
+
+// AllowDenyList restricts the values of a field.
+type AllowDenyList struct {
+	// Values that are allowed. If empty, all values not explicitly denied are allowed.
+	// +optional
+	Allowed []string `json:"allowed,omitempty"`
+
+	// Values that are denied, even if they are also allowed.
+	// +optional
+	Denied []string `json:"denied,omitempty"`
+}
+
+// NamingPolicy constrains the names given to resources in IBM Cloud.
+type NamingPolicy struct {
+	// Prefix every name must start with.
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+
+	// Regular expression every name must match.
+	// +optional
+	Regex *string `json:"regex,omitempty"`
 }
 
 // A ProviderConfigStatus represents the status of a ProviderConfig.
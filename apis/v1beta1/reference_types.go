@@ -0,0 +1,33 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// A GenericReference references a value held in one of the fields of any managed resource of this provider. Values
+// of connection secrets cannot be referenced, as the resolved value is written to the spec of the referencing resource.
+type GenericReference struct {
+	// APIVersion of the referenced managed resource, e.g. `resourcecontrollerv2.ibmcloud.crossplane.io/v1alpha1`
+	APIVersion string `json:"apiVersion"`
+
+	// Kind of the referenced managed resource, e.g. `ResourceInstance`
+	Kind string `json:"kind"`
+
+	// Name of the referenced managed resource
+	Name string `json:"name"`
+
+	// Path of the referenced field in the managed resource, e.g. `status.atProvider.crn`
+	FieldPath string `json:"fieldPath"`
+}
@@ -25,6 +25,141 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AllowDenyList) DeepCopyInto(out *AllowDenyList) {
+	*out = *in
+	if in.Allowed != nil {
+		in, out := &in.Allowed, &out.Allowed
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Denied != nil {
+		in, out := &in.Denied, &out.Denied
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AllowDenyList.
+func (in *AllowDenyList) DeepCopy() *AllowDenyList {
+	if in == nil {
+		return nil
+	}
+	out := new(AllowDenyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CostEstimation) DeepCopyInto(out *CostEstimation) {
+	*out = *in
+	if in.Country != nil {
+		in, out := &in.Country, &out.Country
+		*out = new(string)
+		**out = **in
+	}
+	if in.Currency != nil {
+		in, out := &in.Currency, &out.Currency
+		*out = new(string)
+		**out = **in
+	}
+	if in.MonthlyBudget != nil {
+		in, out := &in.MonthlyBudget, &out.MonthlyBudget
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CostEstimation.
+func (in *CostEstimation) DeepCopy() *CostEstimation {
+	if in == nil {
+		return nil
+	}
+	out := new(CostEstimation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GenericReference) DeepCopyInto(out *GenericReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GenericReference.
+func (in *GenericReference) DeepCopy() *GenericReference {
+	if in == nil {
+		return nil
+	}
+	out := new(GenericReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Guardrails) DeepCopyInto(out *Guardrails) {
+	*out = *in
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = new(AllowDenyList)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Plans != nil {
+		in, out := &in.Plans, &out.Plans
+		*out = new(AllowDenyList)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Regions != nil {
+		in, out := &in.Regions, &out.Regions
+		*out = new(AllowDenyList)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = new(AllowDenyList)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClusterFlavors != nil {
+		in, out := &in.ClusterFlavors, &out.ClusterFlavors
+		*out = new(AllowDenyList)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Guardrails.
+func (in *Guardrails) DeepCopy() *Guardrails {
+	if in == nil {
+		return nil
+	}
+	out := new(Guardrails)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamingPolicy) DeepCopyInto(out *NamingPolicy) {
+	*out = *in
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.Regex != nil {
+		in, out := &in.Regex, &out.Regex
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamingPolicy.
+func (in *NamingPolicy) DeepCopy() *NamingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(NamingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProviderConfig) DeepCopyInto(out *ProviderConfig) {
 	*out = *in
@@ -88,6 +223,31 @@ func (in *ProviderConfigList) DeepCopyObject() runtime.Object {
 func (in *ProviderConfigSpec) DeepCopyInto(out *ProviderConfigSpec) {
 	*out = *in
 	in.ProviderConfigSpec.DeepCopyInto(&out.ProviderConfigSpec)
+	if in.DefaultTags != nil {
+		in, out := &in.DefaultTags, &out.DefaultTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DefaultResourceGroupName != nil {
+		in, out := &in.DefaultResourceGroupName, &out.DefaultResourceGroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.NamingPolicy != nil {
+		in, out := &in.NamingPolicy, &out.NamingPolicy
+		*out = new(NamingPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Guardrails != nil {
+		in, out := &in.Guardrails, &out.Guardrails
+		*out = new(Guardrails)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CostEstimation != nil {
+		in, out := &in.CostEstimation, &out.CostEstimation
+		*out = new(CostEstimation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigSpec.
@@ -25,11 +25,14 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/common"
+	rmgrv2 "github.com/crossplane-contrib/provider-ibm-cloud/apis/resourcemanagerv2/v1alpha1"
 )
 
 // ResolveReferences resolves the crossplane reference to the VPC
 func (mg *Subnet) ResolveReferences(ctx context.Context, c client.Reader) error {
-	r := reference.NewAPIResolver(c, mg)
+	r := common.NewAPIResolver(c, mg)
 
 	if mg.Spec.ForProvider.ByTocalCount != nil {
 		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
@@ -46,6 +49,10 @@ func (mg *Subnet) ResolveReferences(ctx context.Context, c client.Reader) error
 
 		mg.Spec.ForProvider.ByTocalCount.VPC.ID = reference.ToPtrValue(rsp.ResolvedValue)
 		mg.Spec.ForProvider.ByTocalCount.VPC.VPCRef = rsp.ResolvedReference
+
+		if err := resolveResourceGroup(ctx, r, mg.Spec.ForProvider.ByTocalCount.ResourceGroup); err != nil {
+			return errors.Wrap(err, "spec.forProvider.ByTocalCount.resourceGroup")
+		}
 	} else {
 		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
 			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ByCIDR.VPC.ID),
@@ -61,11 +68,61 @@ func (mg *Subnet) ResolveReferences(ctx context.Context, c client.Reader) error
 
 		mg.Spec.ForProvider.ByCIDR.VPC.ID = reference.ToPtrValue(rsp.ResolvedValue)
 		mg.Spec.ForProvider.ByCIDR.VPC.VPCRef = rsp.ResolvedReference
+
+		if err := resolveResourceGroup(ctx, r, mg.Spec.ForProvider.ByCIDR.ResourceGroup); err != nil {
+			return errors.Wrap(err, "spec.forProvider.ByCIDR.resourceGroup")
+		}
 	}
 
 	return nil
 }
 
+// ResolveReferences resolves the crossplane reference to the resource group
+func (mg *VPC) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := common.NewAPIResolver(c, mg)
+
+	if err := resolveResourceGroup(ctx, r, mg.Spec.ForProvider.ResourceGroup); err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceGroup")
+	}
+
+	return nil
+}
+
+// Resolves the crossplane reference to the resource group (if any) of the given identity
+func resolveResourceGroup(ctx context.Context, r *common.APIResolver, rg *ResourceGroupIdentity) error {
+	if rg == nil {
+		return nil
+	}
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: rg.ID,
+		Reference:    rg.ResourceGroupRef,
+		Selector:     rg.ResourceGroupSelector,
+		To:           reference.To{Managed: &rmgrv2.ResourceGroup{}, List: &rmgrv2.ResourceGroupList{}},
+		Extract:      resourceGroupID(),
+	})
+	if err != nil {
+		return err
+	}
+
+	rg.ID = rsp.ResolvedValue
+	rg.ResourceGroupRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// Extracts the resolved ResourceGroup ID - "" if it cannot
+func resourceGroupID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		cr, ok := mg.(*rmgrv2.ResourceGroup)
+		if !ok {
+			return ""
+		}
+
+		return cr.Status.AtProvider.ID
+	}
+}
+
 // Extracts the resolved VPC ID - "" if it cannot
 func vpcID() reference.ExtractValueFn {
 	return func(mg resource.Managed) string {
@@ -77,3 +134,15 @@ func vpcID() reference.ExtractValueFn {
 		return cr.Status.AtProvider.ID
 	}
 }
+
+// VPCCRN extracts the resolved VPC CRN - "" if it cannot
+func VPCCRN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		cr, ok := mg.(*VPC)
+		if !ok {
+			return ""
+		}
+
+		return cr.Status.AtProvider.CRN
+	}
+}
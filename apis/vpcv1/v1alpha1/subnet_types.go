@@ -197,6 +197,15 @@ type SubnetParameters struct {
 	// +immutable
 	// _optional
 	ByCIDR *SubnetPrototypeSubnetByCIDR `json:"byCIDR,omitempty"`
+
+	// User tags attached to the subnet through the Global Tagging API. If not set, user tags are not managed.
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+
+	// Access tags attached to the subnet through the Global Tagging API. Access tags must be in the `key:value`
+	// format and can be used to control access with IAM policies. If not set, access tags are not managed.
+	// +optional
+	AccessTags []string `json:"accessTags,omitempty"`
 }
 
 // SubnetSpec is the desired end-state of a subnet in the IBM cloud
@@ -26,6 +26,18 @@ import (
 type ResourceGroupIdentity struct {
 	// The unique identifier for this resource group.
 	ID string `json:"id,omitempty"`
+
+	// Crossplane reference of the resource group
+	//
+	// +immutable
+	// +optional
+	ResourceGroupRef *runtimev1alpha1.Reference `json:"resourceGroupRef,omitempty"`
+
+	// Selects a reference to a resource group
+	//
+	// +immutable
+	// +optional
+	ResourceGroupSelector *runtimev1alpha1.Selector `json:"resourceGroupSelector,omitempty"`
 }
 
 // VPCParameters are input params when creating a VPC
@@ -60,6 +72,15 @@ type VPCParameters struct {
 	// +immutable
 	// +optional
 	ResourceGroup *ResourceGroupIdentity `json:"resourceGroup,omitempty"`
+
+	// User tags attached to the VPC through the Global Tagging API. If not set, user tags are not managed.
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+
+	// Access tags attached to the VPC through the Global Tagging API. Access tags must be in the `key:value`
+	// format and can be used to control access with IAM policies. If not set, access tags are not managed.
+	// +optional
+	AccessTags []string `json:"accessTags,omitempty"`
 }
 
 // VPCSpec is the desired end-state of a VPC in the IBM cloud
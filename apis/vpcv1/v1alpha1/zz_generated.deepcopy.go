@@ -144,6 +144,16 @@ func (in *PublicGatewayReferenceDeleted) DeepCopy() *PublicGatewayReferenceDelet
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceGroupIdentity) DeepCopyInto(out *ResourceGroupIdentity) {
 	*out = *in
+	if in.ResourceGroupRef != nil {
+		in, out := &in.ResourceGroupRef, &out.ResourceGroupRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.ResourceGroupSelector != nil {
+		in, out := &in.ResourceGroupSelector, &out.ResourceGroupSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceGroupIdentity.
@@ -353,6 +363,16 @@ func (in *SubnetParameters) DeepCopyInto(out *SubnetParameters) {
 		*out = new(SubnetPrototypeSubnetByCIDR)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AccessTags != nil {
+		in, out := &in.AccessTags, &out.AccessTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetParameters.
@@ -391,7 +411,7 @@ func (in *SubnetPrototypeSubnetByCIDR) DeepCopyInto(out *SubnetPrototypeSubnetBy
 	if in.ResourceGroup != nil {
 		in, out := &in.ResourceGroup, &out.ResourceGroup
 		*out = new(ResourceGroupIdentity)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.RoutingTable != nil {
 		in, out := &in.RoutingTable, &out.RoutingTable
@@ -442,7 +462,7 @@ func (in *SubnetPrototypeSubnetByTotalCount) DeepCopyInto(out *SubnetPrototypeSu
 	if in.ResourceGroup != nil {
 		in, out := &in.ResourceGroup, &out.ResourceGroup
 		*out = new(ResourceGroupIdentity)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.RoutingTable != nil {
 		in, out := &in.RoutingTable, &out.RoutingTable
@@ -630,7 +650,17 @@ func (in *VPCParameters) DeepCopyInto(out *VPCParameters) {
 	if in.ResourceGroup != nil {
 		in, out := &in.ResourceGroup, &out.ResourceGroup
 		*out = new(ResourceGroupIdentity)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AccessTags != nil {
+		in, out := &in.AccessTags, &out.AccessTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
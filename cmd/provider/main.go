@@ -27,7 +27,9 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 
 	"github.com/crossplane-contrib/provider-ibm-cloud/apis"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
 	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/controller"
+	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/webhook"
 )
 
 func main() {
@@ -36,6 +38,13 @@ func main() {
 		debug          = app.Flag("debug", "Run with debug logging.").Short('d').Bool()
 		syncPeriod     = app.Flag("sync", "Controller manager sync period such as 300ms, 1.5h, or 2h45m").Short('s').Default("1h").Duration()
 		leaderElection = app.Flag("leader-election", "Use leader election for the conroller manager.").Short('l').Default("false").OverrideDefaultFromEnvar("LEADER_ELECTION").Bool()
+		lookupCacheTTL = app.Flag("lookup-cache-ttl", "How long catalog plan and resource group lookups are cached, such as 15m or 1h. 0 disables caching.").Default(ibmc.DefaultLookupCacheTTL.String()).Duration()
+		auditLog       = app.Flag("audit-log", "File the mutating IBM Cloud API calls are logged to, as JSON lines. - logs them to stdout.").String()
+
+		deletionProtectionWebhook = app.Flag("deletion-protection-webhook", "Serve the webhook that denies the deletion of protected resources.").Default("false").Bool()
+		accountSettingsWebhook    = app.Flag("account-settings-webhook", "Serve the webhook that denies the AccountSettings of accounts whose settings are already managed.").Default("false").Bool()
+		webhookPort               = app.Flag("webhook-port", "Port the webhook server listens on.").Default("9443").Int()
+		webhookCertDir            = app.Flag("webhook-cert-dir", "Directory holding the tls.crt and tls.key files of the webhook server.").Default("/tmp/k8s-webhook-server/serving-certs").String()
 	)
 	kingpin.MustParse(app.Parse(os.Args[1:]))
 
@@ -48,7 +57,20 @@ func main() {
 		ctrl.SetLogger(zl)
 	}
 
-	log.Debug("Starting", "sync-period", syncPeriod.String())
+	log.Debug("Starting", "sync-period", syncPeriod.String(), "lookup-cache-ttl", lookupCacheTTL.String())
+
+	ibmc.SetLookupCacheTTL(*lookupCacheTTL)
+
+	switch *auditLog {
+	case "":
+	case "-":
+		ibmc.SetAuditLog(os.Stdout)
+	default:
+		f, err := os.OpenFile(filepath.Clean(*auditLog), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		kingpin.FatalIfError(err, "Cannot open the audit log")
+		defer f.Close() //nolint:errcheck
+		ibmc.SetAuditLog(f)
+	}
 
 	cfg, err := ctrl.GetConfig()
 	kingpin.FatalIfError(err, "Cannot get API server rest config")
@@ -57,10 +79,18 @@ func main() {
 		LeaderElection:   *leaderElection,
 		LeaderElectionID: "crossplane-leader-election-provider-ibm-cloud",
 		SyncPeriod:       syncPeriod,
+		Port:             *webhookPort,
+		CertDir:          *webhookCertDir,
 	})
 	kingpin.FatalIfError(err, "Cannot create controller manager")
 
 	kingpin.FatalIfError(apis.AddToScheme(mgr.GetScheme()), "Cannot add IBM Cloud APIs to scheme")
 	kingpin.FatalIfError(controller.Setup(mgr, log), "Cannot setup IBM Cloud controllers")
+	if *deletionProtectionWebhook {
+		kingpin.FatalIfError(webhook.SetupDeletionProtection(mgr, log), "Cannot setup the deletion protection webhook")
+	}
+	if *accountSettingsWebhook {
+		kingpin.FatalIfError(webhook.SetupAccountSettings(mgr, log), "Cannot setup the account settings webhook")
+	}
 	kingpin.FatalIfError(mgr.Start(ctrl.SetupSignalHandler()), "Cannot start controller manager")
 }
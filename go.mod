@@ -9,6 +9,7 @@ require (
 	github.com/IBM/experimental-go-sdk v0.0.0-20210112204617-192fc5b15655
 	github.com/IBM/go-sdk-core v1.1.0
 	github.com/IBM/go-sdk-core/v4 v4.10.0
+	github.com/IBM/go-sdk-core/v5 v5.9.1
 	github.com/IBM/ibm-cos-sdk-go v1.7.0
 	github.com/IBM/ibm-cos-sdk-go-config v1.2.0
 	github.com/IBM/platform-services-go-sdk v0.17.18
@@ -30,7 +31,6 @@ require (
 
 require (
 	github.com/IBM/go-sdk-core/v3 v3.0.0 // indirect
-	github.com/IBM/go-sdk-core/v5 v5.9.1 // indirect
 	github.com/alecthomas/template v0.0.0-20190718012654-fb15b899a751 // indirect
 	github.com/alecthomas/units v0.0.0-20190924025748-f65c72e2690d // indirect
 	github.com/asaskevich/govalidator v0.0.0-20200907205600-7a23bdc65eef // indirect
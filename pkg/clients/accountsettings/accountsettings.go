@@ -0,0 +1,113 @@
+package accountsettings
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	iamidv1 "github.com/IBM/platform-services-go-sdk/iamidentityv1"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/iamidentityv1/v1alpha1"
+)
+
+const (
+	// StateActive represents account settings in a running, available, and ready state
+	StateActive = "active"
+
+	errListAccountSettings = "cannot list AccountSettings custom resources"
+	errAlreadyManaged      = "the settings of account %s are already managed by AccountSettings %s"
+)
+
+// CheckNotManaged returns an error if the settings of the account of the given AccountSettings are already managed by
+// another AccountSettings, as an account has a single set of settings.
+func CheckNotManaged(ctx context.Context, kube client.Reader, cr *v1alpha1.AccountSettings) error {
+	l := &v1alpha1.AccountSettingsList{}
+	if err := kube.List(ctx, l); err != nil {
+		return errors.Wrap(err, errListAccountSettings)
+	}
+	for _, o := range l.Items {
+		if o.GetName() != cr.GetName() && o.Spec.ForProvider.AccountID == cr.Spec.ForProvider.AccountID {
+			return errors.Errorf(errAlreadyManaged, cr.Spec.ForProvider.AccountID, o.GetName())
+		}
+	}
+	return nil
+}
+
+// LateInitializeSpec fills optional and unassigned fields with the values in *iamidv1.AccountSettingsResponse object.
+func LateInitializeSpec(spec *v1alpha1.AccountSettingsParameters, in *iamidv1.AccountSettingsResponse) error {
+	if spec.RestrictCreateServiceID == nil {
+		spec.RestrictCreateServiceID = in.RestrictCreateServiceID
+	}
+	if spec.RestrictCreatePlatformApikey == nil {
+		spec.RestrictCreatePlatformApikey = in.RestrictCreatePlatformApikey
+	}
+	if spec.AllowedIPAddresses == nil {
+		spec.AllowedIPAddresses = in.AllowedIPAddresses
+	}
+	if spec.Mfa == nil {
+		spec.Mfa = in.Mfa
+	}
+	if spec.SessionExpirationInSeconds == nil {
+		spec.SessionExpirationInSeconds = in.SessionExpirationInSeconds
+	}
+	if spec.SessionInvalidationInSeconds == nil {
+		spec.SessionInvalidationInSeconds = in.SessionInvalidationInSeconds
+	}
+	return nil
+}
+
+// GenerateUpdateAccountSettingsOptions produces UpdateAccountSettingsOptions object from AccountSettingsParameters
+// object.
+func GenerateUpdateAccountSettingsOptions(accountID, eTag string, in v1alpha1.AccountSettingsParameters, o *iamidv1.UpdateAccountSettingsOptions) error {
+	o.AccountID = reference.ToPtrValue(accountID)
+	o.RestrictCreateServiceID = in.RestrictCreateServiceID
+	o.RestrictCreatePlatformApikey = in.RestrictCreatePlatformApikey
+	o.AllowedIPAddresses = in.AllowedIPAddresses
+	o.Mfa = in.Mfa
+	o.SessionExpirationInSeconds = in.SessionExpirationInSeconds
+	o.SessionInvalidationInSeconds = in.SessionInvalidationInSeconds
+	o.SetIfMatch(eTag)
+	return nil
+}
+
+// GenerateObservation produces AccountSettingsObservation object from *iamidv1.AccountSettingsResponse object.
+func GenerateObservation(in *iamidv1.AccountSettingsResponse) (v1alpha1.AccountSettingsObservation, error) {
+	o := v1alpha1.AccountSettingsObservation{
+		EntityTag: reference.FromPtrValue(in.EntityTag),
+	}
+	return o, nil
+}
+
+// IsUpToDate checks whether current state is up-to-date compared to the given
+// set of parameters.
+func IsUpToDate(in *v1alpha1.AccountSettingsParameters, observed *iamidv1.AccountSettingsResponse, l logging.Logger) (bool, error) {
+	desired := in.DeepCopy()
+	actual, err := GenerateAccountSettingsParameters(observed)
+	if err != nil {
+		return false, err
+	}
+
+	l.Info(cmp.Diff(desired, actual, cmpopts.EquateEmpty()))
+
+	return cmp.Equal(desired, actual, cmpopts.EquateEmpty()), nil
+}
+
+// GenerateAccountSettingsParameters generates account settings parameters from account settings
+func GenerateAccountSettingsParameters(in *iamidv1.AccountSettingsResponse) (*v1alpha1.AccountSettingsParameters, error) {
+	o := &v1alpha1.AccountSettingsParameters{
+		AccountID:                    reference.FromPtrValue(in.AccountID),
+		RestrictCreateServiceID:      in.RestrictCreateServiceID,
+		RestrictCreatePlatformApikey: in.RestrictCreatePlatformApikey,
+		AllowedIPAddresses:           in.AllowedIPAddresses,
+		Mfa:                          in.Mfa,
+		SessionExpirationInSeconds:   in.SessionExpirationInSeconds,
+		SessionInvalidationInSeconds: in.SessionInvalidationInSeconds,
+	}
+	return o, nil
+}
@@ -0,0 +1,222 @@
+package accountsettings
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+
+	iamidv1 "github.com/IBM/platform-services-go-sdk/iamidentityv1"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/iamidentityv1/v1alpha1"
+)
+
+var (
+	accountID           = "aa5a00334eaf9eb9339d2ab48f20d7ff"
+	eTag                = "1-eb832c7ff8c8016a542974b9f880b55e"
+	restricted          = "RESTRICTED"
+	notRestricted       = "NOT_RESTRICTED"
+	allowedIPAddresses  = "192.168.0.0/16,10.0.0.1"
+	mfa                 = "TOTP4ALL"
+	mfaNone             = "NONE"
+	sessionExpiration   = "3600"
+	sessionInvalidation = "900"
+	notSet              = "NOT_SET"
+)
+
+func params(m ...func(*v1alpha1.AccountSettingsParameters)) *v1alpha1.AccountSettingsParameters {
+	p := &v1alpha1.AccountSettingsParameters{
+		AccountID:                    accountID,
+		RestrictCreateServiceID:      &restricted,
+		RestrictCreatePlatformApikey: &restricted,
+		AllowedIPAddresses:           &allowedIPAddresses,
+		Mfa:                          &mfa,
+		SessionExpirationInSeconds:   &sessionExpiration,
+		SessionInvalidationInSeconds: &sessionInvalidation,
+	}
+
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func instance(m ...func(*iamidv1.AccountSettingsResponse)) *iamidv1.AccountSettingsResponse {
+	i := &iamidv1.AccountSettingsResponse{
+		AccountID:                    &accountID,
+		RestrictCreateServiceID:      &restricted,
+		RestrictCreatePlatformApikey: &restricted,
+		AllowedIPAddresses:           &allowedIPAddresses,
+		EntityTag:                    &eTag,
+		Mfa:                          &mfa,
+		SessionExpirationInSeconds:   &sessionExpiration,
+		SessionInvalidationInSeconds: &sessionInvalidation,
+	}
+
+	for _, f := range m {
+		f(i)
+	}
+	return i
+}
+
+func TestGenerateUpdateAccountSettingsOptions(t *testing.T) {
+	type args struct {
+		accountID string
+		etag      string
+		params    v1alpha1.AccountSettingsParameters
+	}
+	type want struct {
+		instance *iamidv1.UpdateAccountSettingsOptions
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"FullConversion": {
+			args: args{accountID: accountID, etag: eTag, params: *params()},
+			want: want{instance: &iamidv1.UpdateAccountSettingsOptions{
+				AccountID:                    &accountID,
+				IfMatch:                      &eTag,
+				RestrictCreateServiceID:      &restricted,
+				RestrictCreatePlatformApikey: &restricted,
+				AllowedIPAddresses:           &allowedIPAddresses,
+				Mfa:                          &mfa,
+				SessionExpirationInSeconds:   &sessionExpiration,
+				SessionInvalidationInSeconds: &sessionInvalidation,
+			}},
+		},
+		"MissingFields": {
+			args: args{accountID: accountID, etag: eTag, params: *params(func(p *v1alpha1.AccountSettingsParameters) {
+				p.AllowedIPAddresses = nil
+				p.SessionInvalidationInSeconds = nil
+			})},
+			want: want{instance: &iamidv1.UpdateAccountSettingsOptions{
+				AccountID:                    &accountID,
+				IfMatch:                      &eTag,
+				RestrictCreateServiceID:      &restricted,
+				RestrictCreatePlatformApikey: &restricted,
+				Mfa:                          &mfa,
+				SessionExpirationInSeconds:   &sessionExpiration,
+			}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &iamidv1.UpdateAccountSettingsOptions{}
+			_ = GenerateUpdateAccountSettingsOptions(tc.args.accountID, tc.args.etag, tc.args.params, r)
+			if diff := cmp.Diff(tc.want.instance, r); diff != "" {
+				t.Errorf("GenerateUpdateAccountSettingsOptions(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSpecs(t *testing.T) {
+	type args struct {
+		instance *iamidv1.AccountSettingsResponse
+		params   *v1alpha1.AccountSettingsParameters
+	}
+	type want struct {
+		params *v1alpha1.AccountSettingsParameters
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"SomeFields": {
+			args: args{
+				params: params(func(p *v1alpha1.AccountSettingsParameters) {
+					p.RestrictCreatePlatformApikey = nil
+					p.AllowedIPAddresses = nil
+					p.SessionExpirationInSeconds = nil
+				}),
+				instance: instance(),
+			},
+			want: want{
+				params: params()},
+		},
+		"AllFilledAlready": {
+			args: args{
+				params: params(),
+				instance: instance(func(i *iamidv1.AccountSettingsResponse) {
+					i.RestrictCreateServiceID = &notRestricted
+					i.Mfa = &mfaNone
+					i.SessionExpirationInSeconds = &notSet
+				}),
+			},
+			want: want{
+				params: params()},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_ = LateInitializeSpec(tc.args.params, tc.args.instance)
+			if diff := cmp.Diff(tc.want.params, tc.args.params); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	o, err := GenerateObservation(instance())
+	if diff := cmp.Diff(nil, err); diff != "" {
+		t.Errorf("GenerateObservation(...): want error != got error:\n%s", diff)
+	}
+	if diff := cmp.Diff(v1alpha1.AccountSettingsObservation{EntityTag: eTag}, o); diff != "" {
+		t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	type args struct {
+		params   *v1alpha1.AccountSettingsParameters
+		instance *iamidv1.AccountSettingsResponse
+	}
+	type want struct {
+		upToDate bool
+		isErr    bool
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"IsUpToDate": {
+			args: args{
+				params:   params(),
+				instance: instance(),
+			},
+			want: want{upToDate: true, isErr: false},
+		},
+		"MfaDrifted": {
+			args: args{
+				params: params(),
+				instance: instance(func(i *iamidv1.AccountSettingsResponse) {
+					i.Mfa = &mfaNone
+				}),
+			},
+			want: want{upToDate: false, isErr: false},
+		},
+		"IPRestrictionsRemoved": {
+			args: args{
+				params: params(),
+				instance: instance(func(i *iamidv1.AccountSettingsResponse) {
+					i.AllowedIPAddresses = nil
+				}),
+			},
+			want: want{upToDate: false, isErr: false},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r, err := IsUpToDate(tc.args.params, tc.args.instance, logging.NewNopLogger())
+			if err != nil && !tc.want.isErr {
+				t.Error("IsUpToDate(...) unexpected error")
+			}
+			if diff := cmp.Diff(tc.want.upToDate, r); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
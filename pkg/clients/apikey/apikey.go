@@ -0,0 +1,114 @@
+package apikey
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	iamidv1 "github.com/IBM/platform-services-go-sdk/iamidentityv1"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/iamidentityv1/v1alpha1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+)
+
+const (
+	// StateActive represents an API key in a running, available, and ready state
+	StateActive = "active"
+
+	// ConnectionKeyAPIKey is the key of the connection details holding the value of the API key
+	ConnectionKeyAPIKey = "apikey"
+)
+
+// LateInitializeSpec fills optional and unassigned fields with the values in *iamidv1.APIKey object.
+func LateInitializeSpec(spec *v1alpha1.APIKeyParameters, in *iamidv1.APIKey) error {
+	if spec.Description == nil {
+		spec.Description = in.Description
+	}
+	if spec.IamID == nil {
+		spec.IamID = in.IamID
+	}
+	if spec.AccountID == nil {
+		spec.AccountID = in.AccountID
+	}
+	if spec.Locked == nil {
+		spec.Locked = in.Locked
+	}
+	return nil
+}
+
+// GenerateCreateAPIKeyOptions produces CreateAPIKeyOptions object from APIKeyParameters object.
+func GenerateCreateAPIKeyOptions(in v1alpha1.APIKeyParameters, o *iamidv1.CreateAPIKeyOptions) error {
+	o.Name = reference.ToPtrValue(in.Name)
+	o.Description = in.Description
+	o.IamID = in.IamID
+	o.AccountID = in.AccountID
+	o.StoreValue = in.StoreValue
+	if ibmc.BoolValue(in.Locked) {
+		o.SetEntityLock("true")
+	}
+	return nil
+}
+
+// GenerateUpdateAPIKeyOptions produces UpdateAPIKeyOptions object from APIKeyParameters object.
+func GenerateUpdateAPIKeyOptions(id, eTag string, in v1alpha1.APIKeyParameters, o *iamidv1.UpdateAPIKeyOptions) error {
+	o.ID = reference.ToPtrValue(id)
+	o.Name = reference.ToPtrValue(in.Name)
+	o.Description = in.Description
+	o.SetIfMatch(eTag)
+	return nil
+}
+
+// GenerateObservation produces APIKeyObservation object from *iamidv1.APIKey object.
+func GenerateObservation(in *iamidv1.APIKey) (v1alpha1.APIKeyObservation, error) {
+	o := v1alpha1.APIKeyObservation{
+		ID:         reference.FromPtrValue(in.ID),
+		EntityTag:  reference.FromPtrValue(in.EntityTag),
+		CRN:        reference.FromPtrValue(in.CRN),
+		Locked:     ibmc.BoolValue(in.Locked),
+		CreatedAt:  ibmc.DateTimeToMetaV1Time(in.CreatedAt),
+		CreatedBy:  reference.FromPtrValue(in.CreatedBy),
+		ModifiedAt: ibmc.DateTimeToMetaV1Time(in.ModifiedAt),
+	}
+	return o, nil
+}
+
+// GetConnectionDetails returns the value of the given API key as connection details, if the API returned it (i.e.
+// on creation, or later if the value is stored)
+func GetConnectionDetails(in *iamidv1.APIKey) managed.ConnectionDetails {
+	if reference.FromPtrValue(in.Apikey) == "" {
+		return nil
+	}
+	return managed.ConnectionDetails{ConnectionKeyAPIKey: []byte(*in.Apikey)}
+}
+
+// IsUpToDate checks whether current state is up-to-date compared to the given
+// set of parameters.
+func IsUpToDate(in *v1alpha1.APIKeyParameters, observed *iamidv1.APIKey, l logging.Logger) (bool, error) {
+	desired := in.DeepCopy()
+	actual, err := GenerateAPIKeyParameters(observed)
+	if err != nil {
+		return false, err
+	}
+
+	l.Info(cmp.Diff(desired, actual, cmpopts.IgnoreTypes(&runtimev1alpha1.Reference{}, &runtimev1alpha1.Selector{})))
+
+	return cmp.Equal(desired, actual, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(v1alpha1.APIKeyParameters{}, "StoreValue"),
+		cmpopts.IgnoreTypes(&runtimev1alpha1.Reference{}, &runtimev1alpha1.Selector{})), nil
+}
+
+// GenerateAPIKeyParameters generates API key parameters from API key
+func GenerateAPIKeyParameters(in *iamidv1.APIKey) (*v1alpha1.APIKeyParameters, error) {
+	o := &v1alpha1.APIKeyParameters{
+		Name:        reference.FromPtrValue(in.Name),
+		Description: in.Description,
+		IamID:       in.IamID,
+		AccountID:   in.AccountID,
+		Locked:      in.Locked,
+	}
+	return o, nil
+}
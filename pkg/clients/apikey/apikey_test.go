@@ -0,0 +1,368 @@
+package apikey
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	iamidv1 "github.com/IBM/platform-services-go-sdk/iamidentityv1"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/iamidentityv1/v1alpha1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+)
+
+var (
+	akName         = "myAPIKey"
+	akNewName      = "myNewAPIKey"
+	akDescription  = "API key for my app"
+	akDescription2 = "another description"
+	accountID      = "aa5a00334eaf9eb9339d2ab48f20d7ff"
+	akID           = "ApiKey-12345678-abcd-1a2b-a1b2-1234567890ab"
+	iamID          = "iam-ServiceId-12345678-abcd-1a2b-a1b2-1234567890ab"
+	createdBy      = "IBMid-123453user"
+	createdAt, _   = strfmt.ParseDateTime("2020-10-31T02:33:06Z")
+	modifiedAt, _  = strfmt.ParseDateTime("2020-10-31T03:33:06Z")
+	eTag           = "1-eb832c7ff8c8016a542974b9f880b55e"
+	crn            = "crn:v1:bluemix:public:iam-identity::a/aa5a00334eaf9eb9339d2ab48f20d7ff::apikey:" + akID
+	akValue        = "a1b2c3d4e5f6"
+	akLocked       = true
+	akUnlocked     = false
+	akStoreValue   = true
+	entityLock     = "true"
+)
+
+func params(m ...func(*v1alpha1.APIKeyParameters)) *v1alpha1.APIKeyParameters {
+	p := &v1alpha1.APIKeyParameters{
+		Name:        akName,
+		Description: &akDescription,
+		IamID:       &iamID,
+		AccountID:   &accountID,
+		Locked:      &akLocked,
+	}
+
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func observation(m ...func(*v1alpha1.APIKeyObservation)) *v1alpha1.APIKeyObservation {
+	o := &v1alpha1.APIKeyObservation{
+		ID:         akID,
+		EntityTag:  eTag,
+		CRN:        crn,
+		Locked:     akLocked,
+		CreatedAt:  ibmc.DateTimeToMetaV1Time(&createdAt),
+		CreatedBy:  createdBy,
+		ModifiedAt: ibmc.DateTimeToMetaV1Time(&modifiedAt),
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+	return o
+}
+
+func instance(m ...func(*iamidv1.APIKey)) *iamidv1.APIKey {
+	i := &iamidv1.APIKey{
+		ID:          &akID,
+		EntityTag:   &eTag,
+		CRN:         &crn,
+		Locked:      &akLocked,
+		CreatedAt:   &createdAt,
+		CreatedBy:   &createdBy,
+		ModifiedAt:  &modifiedAt,
+		Name:        &akName,
+		Description: &akDescription,
+		IamID:       &iamID,
+		AccountID:   &accountID,
+	}
+
+	for _, f := range m {
+		f(i)
+	}
+	return i
+}
+
+func instanceOpts(m ...func(*iamidv1.CreateAPIKeyOptions)) *iamidv1.CreateAPIKeyOptions {
+	i := &iamidv1.CreateAPIKeyOptions{
+		Name:        &akName,
+		Description: &akDescription,
+		IamID:       &iamID,
+		AccountID:   &accountID,
+		EntityLock:  &entityLock,
+	}
+	for _, f := range m {
+		f(i)
+	}
+	return i
+}
+
+func instanceUpdOpts(m ...func(*iamidv1.UpdateAPIKeyOptions)) *iamidv1.UpdateAPIKeyOptions {
+	i := &iamidv1.UpdateAPIKeyOptions{
+		ID:          &akID,
+		IfMatch:     &eTag,
+		Name:        &akNewName,
+		Description: &akDescription,
+	}
+
+	for _, f := range m {
+		f(i)
+	}
+	return i
+}
+
+func TestGenerateCreateAPIKeyOptions(t *testing.T) {
+	type args struct {
+		params v1alpha1.APIKeyParameters
+	}
+	type want struct {
+		instance *iamidv1.CreateAPIKeyOptions
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"FullConversion": {
+			args: args{params: *params(func(p *v1alpha1.APIKeyParameters) {
+				p.StoreValue = &akStoreValue
+			})},
+			want: want{instance: instanceOpts(func(o *iamidv1.CreateAPIKeyOptions) {
+				o.StoreValue = &akStoreValue
+			})},
+		},
+		"Unlocked": {
+			args: args{
+				params: *params(func(p *v1alpha1.APIKeyParameters) {
+					p.Locked = &akUnlocked
+				})},
+			want: want{instance: instanceOpts(func(o *iamidv1.CreateAPIKeyOptions) {
+				o.EntityLock = nil
+			})},
+		},
+		"MissingFields": {
+			args: args{
+				params: *params(func(p *v1alpha1.APIKeyParameters) {
+					p.Description = nil
+					p.AccountID = nil
+					p.Locked = nil
+				})},
+			want: want{instance: instanceOpts(func(o *iamidv1.CreateAPIKeyOptions) {
+				o.Description = nil
+				o.AccountID = nil
+				o.EntityLock = nil
+			})},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &iamidv1.CreateAPIKeyOptions{}
+			GenerateCreateAPIKeyOptions(tc.args.params, r)
+			if diff := cmp.Diff(tc.want.instance, r); diff != "" {
+				t.Errorf("GenerateCreateAPIKeyOptions(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateUpdateAPIKeyOptions(t *testing.T) {
+	type args struct {
+		id     string
+		etag   string
+		params v1alpha1.APIKeyParameters
+	}
+	type want struct {
+		instance *iamidv1.UpdateAPIKeyOptions
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"FullConversion": {
+			args: args{id: akID, etag: eTag, params: *params(func(p *v1alpha1.APIKeyParameters) {
+				p.Name = akNewName
+			})},
+			want: want{instance: instanceUpdOpts()},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &iamidv1.UpdateAPIKeyOptions{}
+			GenerateUpdateAPIKeyOptions(tc.args.id, tc.args.etag, tc.args.params, r)
+			if diff := cmp.Diff(tc.want.instance, r); diff != "" {
+				t.Errorf("GenerateUpdateAPIKeyOptions(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSpecs(t *testing.T) {
+	type args struct {
+		instance *iamidv1.APIKey
+		params   *v1alpha1.APIKeyParameters
+	}
+	type want struct {
+		params *v1alpha1.APIKeyParameters
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"SomeFields": {
+			args: args{
+				params: params(func(p *v1alpha1.APIKeyParameters) {
+					p.Description = nil
+					p.AccountID = nil
+					p.Locked = nil
+				}),
+				instance: instance(),
+			},
+			want: want{
+				params: params()},
+		},
+		"AllFilledAlready": {
+			args: args{
+				params: params(),
+				instance: instance(func(i *iamidv1.APIKey) {
+					i.Description = &akDescription2
+					i.Locked = &akUnlocked
+				}),
+			},
+			want: want{
+				params: params()},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSpec(tc.args.params, tc.args.instance)
+			if diff := cmp.Diff(tc.want.params, tc.args.params); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	type args struct {
+		instance *iamidv1.APIKey
+	}
+	type want struct {
+		obs v1alpha1.APIKeyObservation
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"FullConversion": {
+			args: args{
+				instance: instance(),
+			},
+			want: want{*observation()},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := GenerateObservation(tc.args.instance)
+			if diff := cmp.Diff(nil, err); diff != "" {
+				t.Errorf("GenerateObservation(...): want error != got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, o); diff != "" {
+				t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetConnectionDetails(t *testing.T) {
+	type args struct {
+		instance *iamidv1.APIKey
+	}
+	type want struct {
+		conn managed.ConnectionDetails
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"WithValue": {
+			args: args{
+				instance: instance(func(i *iamidv1.APIKey) {
+					i.Apikey = &akValue
+				}),
+			},
+			want: want{conn: managed.ConnectionDetails{ConnectionKeyAPIKey: []byte(akValue)}},
+		},
+		"WithoutValue": {
+			args: args{
+				instance: instance(),
+			},
+			want: want{conn: nil},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := GetConnectionDetails(tc.args.instance)
+			if diff := cmp.Diff(tc.want.conn, c); diff != "" {
+				t.Errorf("GetConnectionDetails(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	type args struct {
+		params   *v1alpha1.APIKeyParameters
+		instance *iamidv1.APIKey
+	}
+	type want struct {
+		upToDate bool
+		isErr    bool
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"IsUpToDate": {
+			args: args{
+				params: params(func(p *v1alpha1.APIKeyParameters) {
+					p.StoreValue = &akStoreValue
+				}),
+				instance: instance(),
+			},
+			want: want{upToDate: true, isErr: false},
+		},
+		"NeedsUpdate": {
+			args: args{
+				params: params(func(p *v1alpha1.APIKeyParameters) {
+					p.Name = akNewName
+				}),
+				instance: instance(),
+			},
+			want: want{upToDate: false, isErr: false},
+		},
+		"NeedsLock": {
+			args: args{
+				params: params(),
+				instance: instance(func(i *iamidv1.APIKey) {
+					i.Locked = &akUnlocked
+				}),
+			},
+			want: want{upToDate: false, isErr: false},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r, err := IsUpToDate(tc.args.params, tc.args.instance, logging.NewNopLogger())
+			if err != nil && !tc.want.isErr {
+				t.Error("IsUpToDate(...) unexpected error")
+			}
+			if diff := cmp.Diff(tc.want.upToDate, r); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
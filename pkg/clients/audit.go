@@ -0,0 +1,318 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const (
+	// HeaderTransactionID is the header identifying a call to the IBM Cloud APIs, e.g. in Activity Tracker events
+	HeaderTransactionID = "Transaction-Id"
+
+	// OperationCreate is the operation of the calls made to create an external resource
+	OperationCreate = "Create"
+
+	// OperationUpdate is the operation of the calls made to update an external resource
+	OperationUpdate = "Update"
+
+	// OperationDelete is the operation of the calls made to delete an external resource
+	OperationDelete = "Delete"
+
+	// OperationAttachTags is the operation of the calls that attach tags to an external resource
+	OperationAttachTags = "AttachTags"
+
+	// OperationDetachTags is the operation of the calls that detach tags from an external resource
+	OperationDetachTags = "DetachTags"
+
+	// OperationScale is the operation of the calls that scale a database deployment
+	OperationScale = "Scale"
+
+	// ResultSucceeded is the result of the calls that succeeded
+	ResultSucceeded = "Succeeded"
+
+	// ResultFailed is the result of the calls that failed
+	ResultFailed = "Failed"
+
+	reasonAPICall       event.Reason = "APICall"
+	reasonAPICallFailed event.Reason = "APICallFailed"
+
+	msgAPICall = "%s %s: %s %s (%s: %s)"
+)
+
+// An AuditRecord records a mutating call to the IBM Cloud APIs.
+type AuditRecord struct {
+	Time          time.Time `json:"time"`
+	Kind          string    `json:"kind,omitempty"`
+	Name          string    `json:"name,omitempty"`
+	Operation     string    `json:"operation"`
+	Method        string    `json:"method"`
+	URL           string    `json:"url"`
+	StatusCode    int       `json:"statusCode,omitempty"`
+	TransactionID string    `json:"transactionId"`
+	Result        string    `json:"result"`
+	Error         string    `json:"error,omitempty"`
+}
+
+// An AuditTrail records the mutating calls to the IBM Cloud APIs made by the HTTP clients it instruments.
+type AuditTrail struct {
+	mu        sync.Mutex
+	operation string
+	records   []AuditRecord
+}
+
+// NewAuditTrail returns an empty AuditTrail.
+func NewAuditTrail() *AuditTrail {
+	return &AuditTrail{}
+}
+
+// Client returns a copy of the given HTTP client whose mutating calls are recorded in the trail. A nil client
+// stands for http.DefaultClient.
+func (t *AuditTrail) Client(c *http.Client) *http.Client {
+	if c == nil {
+		c = http.DefaultClient
+	}
+	out := *c
+	out.Transport = &auditTransport{trail: t, base: c.Transport}
+	return &out
+}
+
+// start drops the calls recorded so far, and records the next ones as part of the given operation
+func (t *AuditTrail) start(operation string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.operation = operation
+	t.records = nil
+}
+
+// flush returns the calls recorded since start, and drops them
+func (t *AuditTrail) flush() []AuditRecord {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	r := t.records
+	t.records = nil
+	return r
+}
+
+func (t *AuditTrail) add(r AuditRecord) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if r.Operation == "" {
+		r.Operation = t.operation
+	}
+	t.records = append(t.records, r)
+}
+
+type auditTrailKey struct{}
+
+// WithAuditTrail returns a copy of the given context, carrying the given AuditTrail. The clients created by NewClient
+// from the options returned by GetAuthInfo for this context record their calls in the trail.
+func WithAuditTrail(ctx context.Context, t *AuditTrail) context.Context {
+	return context.WithValue(ctx, auditTrailKey{}, t)
+}
+
+// AuditTrailFrom returns the AuditTrail carried by the given context, or nil.
+func AuditTrailFrom(ctx context.Context) *AuditTrail {
+	t, _ := ctx.Value(auditTrailKey{}).(*AuditTrail)
+	return t
+}
+
+// auditTransport is an http.RoundTripper recording the mutating calls in an AuditTrail
+type auditTransport struct {
+	trail *AuditTrail
+	base  http.RoundTripper
+}
+
+func (a *auditTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := a.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if !isMutating(req.Method) {
+		return base.RoundTrip(req)
+	}
+
+	// every mutating call carries a transaction ID, so that it can be matched with the Activity Tracker events,
+	// even when it fails before reaching the API
+	txID := req.Header.Get(HeaderTransactionID)
+	if txID == "" {
+		txID = newTransactionID()
+		req = req.Clone(req.Context())
+		req.Header.Set(HeaderTransactionID, txID)
+	}
+
+	r := AuditRecord{
+		Time:      time.Now().UTC(),
+		Operation: operationOf(req),
+		Method:    req.Method,
+		URL:       req.URL.Scheme + "://" + req.URL.Host + req.URL.Path,
+		Result:    ResultSucceeded,
+	}
+	resp, err := base.RoundTrip(req)
+	switch {
+	case err != nil:
+		r.Result, r.Error = ResultFailed, err.Error()
+	case resp.StatusCode >= http.StatusBadRequest:
+		r.Result, r.Error = ResultFailed, http.StatusText(resp.StatusCode)
+	}
+	if resp != nil {
+		r.StatusCode = resp.StatusCode
+		if id := resp.Header.Get(HeaderTransactionID); id != "" {
+			txID = id
+		}
+	}
+	r.TransactionID = txID
+	a.trail.add(r)
+	return resp, err
+}
+
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// operationOf returns the operation of the tagging and scaling calls, and an empty string for the other calls,
+// whose operation is the one of the trail
+func operationOf(req *http.Request) string {
+	p := req.URL.Path
+	switch {
+	case strings.HasSuffix(p, "/tags/attach"):
+		return OperationAttachTags
+	case strings.HasSuffix(p, "/tags/detach"):
+		return OperationDetachTags
+	case strings.Contains(p, "/deployments/") && strings.Contains(p, "/groups/"):
+		return OperationScale
+	}
+	return ""
+}
+
+func newTransactionID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return fmt.Sprintf("crossplane-%d", time.Now().UnixNano())
+	}
+	return "crossplane-" + hex.EncodeToString(b)
+}
+
+// auditLog is where the audit records are written to, if anywhere
+var auditLog = struct {
+	mu sync.Mutex
+	w  io.Writer
+}{}
+
+// SetAuditLog sets where the audit records are written to, as JSON lines. A nil writer disables the audit log.
+func SetAuditLog(w io.Writer) {
+	auditLog.mu.Lock()
+	defer auditLog.mu.Unlock()
+	auditLog.w = w
+}
+
+func writeAuditLog(r AuditRecord) error {
+	auditLog.mu.Lock()
+	defer auditLog.mu.Unlock()
+	if auditLog.w == nil {
+		return nil
+	}
+	b, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	_, err = auditLog.w.Write(append(b, '\n'))
+	return err
+}
+
+// An AuditConnecter is a managed.ExternalConnecter whose external clients emit an event, and write an audit record
+// (see SetAuditLog), for each mutating call they make to the IBM Cloud APIs while creating, updating or deleting
+// external resources. The wrapped connecter must create its clients from the options returned by GetAuthInfo.
+type AuditConnecter struct {
+	managed.ExternalConnecter
+	record event.Recorder
+}
+
+// NewAuditConnecter returns an AuditConnecter wrapping the given connecter.
+func NewAuditConnecter(c managed.ExternalConnecter, record event.Recorder) *AuditConnecter {
+	return &AuditConnecter{ExternalConnecter: c, record: record}
+}
+
+// Connect returns an external client whose mutating calls are audited.
+func (c *AuditConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	t := NewAuditTrail()
+	e, err := c.ExternalConnecter.Connect(WithAuditTrail(ctx, t), mg)
+	if err != nil {
+		return nil, err
+	}
+	return &auditedExternal{ExternalClient: e, trail: t, record: c.record}, nil
+}
+
+type auditedExternal struct {
+	managed.ExternalClient
+	trail  *AuditTrail
+	record event.Recorder
+}
+
+func (e *auditedExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	e.trail.start(OperationCreate)
+	defer e.audit(mg)
+	return e.ExternalClient.Create(ctx, mg)
+}
+
+func (e *auditedExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	e.trail.start(OperationUpdate)
+	defer e.audit(mg)
+	return e.ExternalClient.Update(ctx, mg)
+}
+
+func (e *auditedExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	e.trail.start(OperationDelete)
+	defer e.audit(mg)
+	return e.ExternalClient.Delete(ctx, mg)
+}
+
+// audit emits an event, and writes an audit record, for each call recorded in the trail
+func (e *auditedExternal) audit(mg resource.Managed) {
+	kind := reflect.Indirect(reflect.ValueOf(mg)).Type().Name()
+	for _, r := range e.trail.flush() {
+		r.Kind, r.Name = kind, mg.GetName()
+		kv := []string{"operation", r.Operation, "result", r.Result, "transaction-id", r.TransactionID}
+		if r.Result == ResultSucceeded {
+			e.record.Event(mg, event.Normal(reasonAPICall, fmt.Sprintf(msgAPICall, r.Operation, r.Result, r.Method, r.URL, HeaderTransactionID, r.TransactionID), kv...))
+		} else {
+			e.record.Event(mg, event.Warning(reasonAPICallFailed, errors.Errorf(msgAPICall+": %s", r.Operation, r.Result, r.Method, r.URL, HeaderTransactionID, r.TransactionID, r.Error), kv...))
+		}
+		// the audit log is best effort, and must not prevent the reconciliation of the managed resource
+		_ = writeAuditLog(r)
+	}
+}
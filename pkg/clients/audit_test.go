@@ -0,0 +1,168 @@
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+)
+
+const txID = "tx-123"
+
+func auditServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(HeaderTransactionID) == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if strings.HasSuffix(r.URL.Path, "/fail") {
+			w.Header().Set(HeaderTransactionID, txID)
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+}
+
+func TestAuditTrailClient(t *testing.T) {
+	server := auditServer()
+	defer server.Close()
+
+	type call struct {
+		method string
+		path   string
+	}
+	cases := map[string]struct {
+		calls []call
+		want  []AuditRecord
+	}{
+		"NotMutating": {
+			calls: []call{{method: http.MethodGet, path: "/v2/resource_instances/abc"}},
+		},
+		"Operation": {
+			calls: []call{{method: http.MethodPatch, path: "/v2/resource_instances/abc"}},
+			want: []AuditRecord{
+				{Operation: OperationUpdate, Method: http.MethodPatch, URL: server.URL + "/v2/resource_instances/abc", StatusCode: http.StatusOK, Result: ResultSucceeded},
+			},
+		},
+		"TagsAndScaling": {
+			calls: []call{
+				{method: http.MethodPost, path: "/v3/tags/attach"},
+				{method: http.MethodPost, path: "/v3/tags/detach"},
+				{method: http.MethodPatch, path: "/deployments/abc/groups/member"},
+			},
+			want: []AuditRecord{
+				{Operation: OperationAttachTags, Method: http.MethodPost, URL: server.URL + "/v3/tags/attach", StatusCode: http.StatusOK, Result: ResultSucceeded},
+				{Operation: OperationDetachTags, Method: http.MethodPost, URL: server.URL + "/v3/tags/detach", StatusCode: http.StatusOK, Result: ResultSucceeded},
+				{Operation: OperationScale, Method: http.MethodPatch, URL: server.URL + "/deployments/abc/groups/member", StatusCode: http.StatusOK, Result: ResultSucceeded},
+			},
+		},
+		"Failed": {
+			calls: []call{{method: http.MethodDelete, path: "/v2/resource_instances/fail"}},
+			want: []AuditRecord{
+				{Operation: OperationUpdate, Method: http.MethodDelete, URL: server.URL + "/v2/resource_instances/fail", StatusCode: http.StatusConflict,
+					TransactionID: txID, Result: ResultFailed, Error: http.StatusText(http.StatusConflict)},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			trail := NewAuditTrail()
+			trail.start(OperationUpdate)
+			c := trail.Client(server.Client())
+			for _, call := range tc.calls {
+				req, _ := http.NewRequest(call.method, server.URL+call.path, nil)
+				resp, err := c.Do(req)
+				if err != nil {
+					t.Fatalf("Do(...): %s", err)
+				}
+				_ = resp.Body.Close()
+			}
+
+			got := trail.flush()
+			for _, r := range got {
+				if r.TransactionID == "" {
+					t.Errorf("Client(...): no transaction ID recorded for %s %s", r.Method, r.URL)
+				}
+			}
+			ignore := cmpopts.IgnoreFields(AuditRecord{}, "Time")
+			if len(tc.want) == 0 || tc.want[0].TransactionID == "" {
+				ignore = cmpopts.IgnoreFields(AuditRecord{}, "Time", "TransactionID")
+			}
+			if diff := cmp.Diff(tc.want, got, ignore, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Client(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAuditConnecter(t *testing.T) {
+	server := auditServer()
+	defer server.Close()
+
+	var log bytes.Buffer
+	SetAuditLog(&log)
+	defer SetAuditLog(nil)
+
+	connecter := managed.ExternalConnectorFn(func(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+		c := AuditTrailFrom(ctx).Client(server.Client())
+		do := func(method, path string) {
+			req, _ := http.NewRequest(method, server.URL+path, nil)
+			if resp, err := c.Do(req); err == nil {
+				_ = resp.Body.Close()
+			}
+		}
+		return &managed.ExternalClientFns{
+			CreateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+				do(http.MethodPost, "/v2/resource_instances")
+				do(http.MethodPost, "/v3/tags/attach")
+				return managed.ExternalCreation{}, nil
+			},
+			DeleteFn: func(_ context.Context, _ resource.Managed) error {
+				do(http.MethodDelete, "/v2/resource_instances/fail")
+				return nil
+			},
+		}, nil
+	})
+
+	rec := &reasonRecorder{}
+	mg := &fake.Managed{}
+	mg.SetName("instance")
+	e, err := NewAuditConnecter(connecter, rec).Connect(context.Background(), mg)
+	if err != nil {
+		t.Fatalf("Connect(...): %s", err)
+	}
+	_, _ = e.Create(context.Background(), mg)
+	_ = e.Delete(context.Background(), mg)
+
+	if diff := cmp.Diff([]event.Reason{reasonAPICall, reasonAPICall, reasonAPICallFailed}, rec.reasons); diff != "" {
+		t.Errorf("Create(...), Delete(...): -want reasons, +got reasons:\n%s", diff)
+	}
+
+	operations := []string{}
+	for _, l := range strings.Split(strings.TrimSpace(log.String()), "\n") {
+		r := AuditRecord{}
+		if err := json.Unmarshal([]byte(l), &r); err != nil {
+			t.Fatalf("audit log: %s", err)
+		}
+		if r.Name != "instance" || r.Kind != "Managed" {
+			t.Errorf("audit log: unexpected resource %s/%s", r.Kind, r.Name)
+		}
+		operations = append(operations, r.Operation+" "+r.Result)
+	}
+	want := []string{OperationCreate + " " + ResultSucceeded, OperationAttachTags + " " + ResultSucceeded, OperationDelete + " " + ResultFailed}
+	if diff := cmp.Diff(want, operations); diff != "" {
+		t.Errorf("audit log: -want, +got:\n%s", diff)
+	}
+}
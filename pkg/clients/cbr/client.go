@@ -0,0 +1,293 @@
+package cbr
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/sdkrequest"
+)
+
+// The version of the platform services SDK in use does not cover context-based restrictions yet, so this file
+// provides a minimal client for the zone and rule endpoints of the Context Based Restrictions API, modeled on the
+// SDK.
+
+// DefaultServiceURL is the default URL to make service requests to.
+const DefaultServiceURL = "https://cbr.cloud.ibm.com"
+
+const (
+	pathZones = `/v1/zones`
+	pathZone  = `/v1/zones/{zone_id}`
+	pathRules = `/v1/rules`
+	pathRule  = `/v1/rules/{rule_id}`
+
+	errMissingOptions = "options and their required fields must be set"
+)
+
+// Zone : An output zone.
+type Zone struct {
+	ID               *string          `json:"id,omitempty"`
+	CRN              *string          `json:"crn,omitempty"`
+	AddressCount     *int64           `json:"address_count,omitempty"`
+	ExcludedCount    *int64           `json:"excluded_count,omitempty"`
+	Name             *string          `json:"name,omitempty"`
+	AccountID        *string          `json:"account_id,omitempty"`
+	Description      *string          `json:"description,omitempty"`
+	Addresses        []Address        `json:"addresses,omitempty"`
+	Excluded         []Address        `json:"excluded,omitempty"`
+	Href             *string          `json:"href,omitempty"`
+	CreatedAt        *strfmt.DateTime `json:"created_at,omitempty"`
+	CreatedByID      *string          `json:"created_by_id,omitempty"`
+	LastModifiedAt   *strfmt.DateTime `json:"last_modified_at,omitempty"`
+	LastModifiedByID *string          `json:"last_modified_by_id,omitempty"`
+}
+
+// Address : A zone address; its value is set for all the types of addresses but 'serviceRef', whose service is set
+// in Ref.
+type Address struct {
+	Type  *string          `json:"type"`
+	Value *string          `json:"value,omitempty"`
+	Ref   *ServiceRefValue `json:"ref,omitempty"`
+}
+
+// ServiceRefValue : A service reference value.
+type ServiceRefValue struct {
+	AccountID       *string `json:"account_id"`
+	ServiceType     *string `json:"service_type,omitempty"`
+	ServiceName     *string `json:"service_name,omitempty"`
+	ServiceInstance *string `json:"service_instance,omitempty"`
+	Location        *string `json:"location,omitempty"`
+}
+
+// Rule : An output rule.
+type Rule struct {
+	ID               *string          `json:"id,omitempty"`
+	CRN              *string          `json:"crn,omitempty"`
+	Description      *string          `json:"description,omitempty"`
+	Contexts         []RuleContext    `json:"contexts,omitempty"`
+	Resources        []Resource       `json:"resources,omitempty"`
+	EnforcementMode  *string          `json:"enforcement_mode,omitempty"`
+	Href             *string          `json:"href,omitempty"`
+	CreatedAt        *strfmt.DateTime `json:"created_at,omitempty"`
+	CreatedByID      *string          `json:"created_by_id,omitempty"`
+	LastModifiedAt   *strfmt.DateTime `json:"last_modified_at,omitempty"`
+	LastModifiedByID *string          `json:"last_modified_by_id,omitempty"`
+}
+
+// RuleContext : A rule context.
+type RuleContext struct {
+	Attributes []RuleContextAttribute `json:"attributes"`
+}
+
+// RuleContextAttribute : A rule context attribute.
+type RuleContextAttribute struct {
+	Name  *string `json:"name"`
+	Value *string `json:"value"`
+}
+
+// Resource : A rule resource.
+type Resource struct {
+	Attributes []ResourceAttribute    `json:"attributes"`
+	Tags       []ResourceTagAttribute `json:"tags,omitempty"`
+}
+
+// ResourceAttribute : A rule resource attribute.
+type ResourceAttribute struct {
+	Name     *string `json:"name"`
+	Value    *string `json:"value"`
+	Operator *string `json:"operator,omitempty"`
+}
+
+// ResourceTagAttribute : A rule resource tag attribute.
+type ResourceTagAttribute struct {
+	Name     *string `json:"name"`
+	Value    *string `json:"value"`
+	Operator *string `json:"operator,omitempty"`
+}
+
+// CreateZoneOptions : The CreateZone options.
+type CreateZoneOptions struct {
+	Name        *string   `json:"name"`
+	AccountID   *string   `json:"account_id"`
+	Description *string   `json:"description,omitempty"`
+	Addresses   []Address `json:"addresses"`
+	Excluded    []Address `json:"excluded,omitempty"`
+}
+
+// GetZoneOptions : The GetZone options.
+type GetZoneOptions struct {
+	ZoneID *string
+}
+
+// ReplaceZoneOptions : The ReplaceZone options.
+type ReplaceZoneOptions struct {
+	ZoneID      *string   `json:"-"`
+	IfMatch     *string   `json:"-"`
+	Name        *string   `json:"name"`
+	AccountID   *string   `json:"account_id"`
+	Description *string   `json:"description,omitempty"`
+	Addresses   []Address `json:"addresses"`
+	Excluded    []Address `json:"excluded,omitempty"`
+}
+
+// DeleteZoneOptions : The DeleteZone options.
+type DeleteZoneOptions struct {
+	ZoneID *string
+}
+
+// CreateRuleOptions : The CreateRule options.
+type CreateRuleOptions struct {
+	Description     *string       `json:"description,omitempty"`
+	Contexts        []RuleContext `json:"contexts"`
+	Resources       []Resource    `json:"resources"`
+	EnforcementMode *string       `json:"enforcement_mode,omitempty"`
+}
+
+// GetRuleOptions : The GetRule options.
+type GetRuleOptions struct {
+	RuleID *string
+}
+
+// ReplaceRuleOptions : The ReplaceRule options.
+type ReplaceRuleOptions struct {
+	RuleID          *string       `json:"-"`
+	IfMatch         *string       `json:"-"`
+	Description     *string       `json:"description,omitempty"`
+	Contexts        []RuleContext `json:"contexts"`
+	Resources       []Resource    `json:"resources"`
+	EnforcementMode *string       `json:"enforcement_mode,omitempty"`
+}
+
+// DeleteRuleOptions : The DeleteRule options.
+type DeleteRuleOptions struct {
+	RuleID *string
+}
+
+// ContextBasedRestrictionsV1Options : Service options
+type ContextBasedRestrictionsV1Options struct {
+	URL           string
+	Authenticator core.Authenticator
+}
+
+// ContextBasedRestrictionsV1 is a client of the zone and rule endpoints of the Context Based Restrictions API
+type ContextBasedRestrictionsV1 struct {
+	Service *core.BaseService
+}
+
+// NewContextBasedRestrictionsV1 constructs an instance of ContextBasedRestrictionsV1 with passed in options.
+func NewContextBasedRestrictionsV1(options *ContextBasedRestrictionsV1Options) (*ContextBasedRestrictionsV1, error) {
+	baseService, err := core.NewBaseService(&core.ServiceOptions{
+		URL:           DefaultServiceURL,
+		Authenticator: options.Authenticator,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if options.URL != "" {
+		if err := baseService.SetServiceURL(options.URL); err != nil {
+			return nil, err
+		}
+	}
+	return &ContextBasedRestrictionsV1{Service: baseService}, nil
+}
+
+// CreateZone creates a zone.
+func (c *ContextBasedRestrictionsV1) CreateZone(ctx context.Context, o *CreateZoneOptions) (*Zone, *core.DetailedResponse, error) {
+	if o == nil || o.Name == nil || o.AccountID == nil {
+		return nil, nil, errors.New(errMissingOptions)
+	}
+	result := &Zone{}
+	response, err := sdkrequest.Do(ctx, c.Service, http.MethodPost, pathZones, nil, nil, o, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+// GetZone retrieves a zone.
+func (c *ContextBasedRestrictionsV1) GetZone(ctx context.Context, o *GetZoneOptions) (*Zone, *core.DetailedResponse, error) {
+	if o == nil || o.ZoneID == nil {
+		return nil, nil, errors.New(errMissingOptions)
+	}
+	result := &Zone{}
+	response, err := sdkrequest.Do(ctx, c.Service, http.MethodGet, pathZone,
+		map[string]string{"zone_id": *o.ZoneID}, nil, nil, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+// ReplaceZone replaces a zone.
+func (c *ContextBasedRestrictionsV1) ReplaceZone(ctx context.Context, o *ReplaceZoneOptions) (*Zone, *core.DetailedResponse, error) {
+	if o == nil || o.ZoneID == nil || o.IfMatch == nil || o.Name == nil || o.AccountID == nil {
+		return nil, nil, errors.New(errMissingOptions)
+	}
+	result := &Zone{}
+	response, err := sdkrequest.Do(ctx, c.Service, http.MethodPut, pathZone, map[string]string{"zone_id": *o.ZoneID},
+		map[string]string{"If-Match": *o.IfMatch}, o, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+// DeleteZone deletes a zone.
+func (c *ContextBasedRestrictionsV1) DeleteZone(ctx context.Context, o *DeleteZoneOptions) (*core.DetailedResponse, error) {
+	if o == nil || o.ZoneID == nil {
+		return nil, errors.New(errMissingOptions)
+	}
+	return sdkrequest.Do(ctx, c.Service, http.MethodDelete, pathZone, map[string]string{"zone_id": *o.ZoneID}, nil, nil, nil)
+}
+
+// CreateRule creates a rule.
+func (c *ContextBasedRestrictionsV1) CreateRule(ctx context.Context, o *CreateRuleOptions) (*Rule, *core.DetailedResponse, error) {
+	if o == nil {
+		return nil, nil, errors.New(errMissingOptions)
+	}
+	result := &Rule{}
+	response, err := sdkrequest.Do(ctx, c.Service, http.MethodPost, pathRules, nil, nil, o, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+// GetRule retrieves a rule.
+func (c *ContextBasedRestrictionsV1) GetRule(ctx context.Context, o *GetRuleOptions) (*Rule, *core.DetailedResponse, error) {
+	if o == nil || o.RuleID == nil {
+		return nil, nil, errors.New(errMissingOptions)
+	}
+	result := &Rule{}
+	response, err := sdkrequest.Do(ctx, c.Service, http.MethodGet, pathRule,
+		map[string]string{"rule_id": *o.RuleID}, nil, nil, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+// ReplaceRule replaces a rule.
+func (c *ContextBasedRestrictionsV1) ReplaceRule(ctx context.Context, o *ReplaceRuleOptions) (*Rule, *core.DetailedResponse, error) {
+	if o == nil || o.RuleID == nil || o.IfMatch == nil {
+		return nil, nil, errors.New(errMissingOptions)
+	}
+	result := &Rule{}
+	response, err := sdkrequest.Do(ctx, c.Service, http.MethodPut, pathRule, map[string]string{"rule_id": *o.RuleID},
+		map[string]string{"If-Match": *o.IfMatch}, o, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+// DeleteRule deletes a rule.
+func (c *ContextBasedRestrictionsV1) DeleteRule(ctx context.Context, o *DeleteRuleOptions) (*core.DetailedResponse, error) {
+	if o == nil || o.RuleID == nil {
+		return nil, errors.New(errMissingOptions)
+	}
+	return sdkrequest.Do(ctx, c.Service, http.MethodDelete, pathRule, map[string]string{"rule_id": *o.RuleID}, nil, nil, nil)
+}
@@ -0,0 +1,204 @@
+package cbr
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/google/go-cmp/cmp"
+)
+
+type request struct {
+	method  string
+	path    string
+	ifMatch string
+	body    map[string]interface{}
+}
+
+func setupClient(t *testing.T, status int, response interface{}, got *request) (*ContextBasedRestrictionsV1, *httptest.Server) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got.method = r.Method
+		got.path = r.URL.Path
+		got.ifMatch = r.Header.Get("If-Match")
+		b, _ := io.ReadAll(r.Body)
+		_ = r.Body.Close()
+		if len(b) > 0 {
+			if err := json.Unmarshal(b, &got.body); err != nil {
+				t.Errorf("r: cannot unmarshal request body: %s", err)
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		if response != nil {
+			_ = json.NewEncoder(w).Encode(response)
+		}
+	}))
+
+	c, err := NewContextBasedRestrictionsV1(&ContextBasedRestrictionsV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	if err != nil {
+		t.Fatalf("cannot create Context Based Restrictions client: %s", err)
+	}
+	return c, server
+}
+
+func TestClientRequests(t *testing.T) {
+	id := "65810ac762004f22ac19f8f8edf70a34"
+	eTag := "1-eb832c7ff8c8016a542974b9f880b55e"
+	name := "my-zone"
+	accountID := "12ab34cd56ef78ab90cd12ef34ab56cd"
+	ipAddress := "ipAddress"
+	ip := "169.23.56.234"
+	serviceRef := "serviceRef"
+	service := "cloud-object-storage"
+	attrAccountID := "accountId"
+	attrNetworkZoneID := "networkZoneId"
+	attrServiceName := "serviceName"
+	enabled := "enabled"
+
+	cases := map[string]struct {
+		call func(c *ContextBasedRestrictionsV1) error
+		want request
+	}{
+		"CreateZone": {
+			call: func(c *ContextBasedRestrictionsV1) error {
+				_, _, err := c.CreateZone(context.Background(), &CreateZoneOptions{Name: &name, AccountID: &accountID,
+					Addresses: []Address{{Type: &ipAddress, Value: &ip},
+						{Type: &serviceRef, Ref: &ServiceRefValue{AccountID: &accountID, ServiceName: &service}}}})
+				return err
+			},
+			want: request{method: http.MethodPost, path: "/v1/zones",
+				body: map[string]interface{}{"name": name, "account_id": accountID,
+					"addresses": []interface{}{
+						map[string]interface{}{"type": ipAddress, "value": ip},
+						map[string]interface{}{"type": serviceRef,
+							"ref": map[string]interface{}{"account_id": accountID, "service_name": service}}}}},
+		},
+		"GetZone": {
+			call: func(c *ContextBasedRestrictionsV1) error {
+				_, _, err := c.GetZone(context.Background(), &GetZoneOptions{ZoneID: &id})
+				return err
+			},
+			want: request{method: http.MethodGet, path: "/v1/zones/" + id},
+		},
+		"ReplaceZone": {
+			call: func(c *ContextBasedRestrictionsV1) error {
+				_, _, err := c.ReplaceZone(context.Background(), &ReplaceZoneOptions{ZoneID: &id, IfMatch: &eTag, Name: &name,
+					AccountID: &accountID, Addresses: []Address{{Type: &ipAddress, Value: &ip}}})
+				return err
+			},
+			want: request{method: http.MethodPut, path: "/v1/zones/" + id, ifMatch: eTag,
+				body: map[string]interface{}{"name": name, "account_id": accountID,
+					"addresses": []interface{}{map[string]interface{}{"type": ipAddress, "value": ip}}}},
+		},
+		"DeleteZone": {
+			call: func(c *ContextBasedRestrictionsV1) error {
+				_, err := c.DeleteZone(context.Background(), &DeleteZoneOptions{ZoneID: &id})
+				return err
+			},
+			want: request{method: http.MethodDelete, path: "/v1/zones/" + id},
+		},
+		"CreateRule": {
+			call: func(c *ContextBasedRestrictionsV1) error {
+				_, _, err := c.CreateRule(context.Background(), &CreateRuleOptions{
+					Contexts: []RuleContext{{Attributes: []RuleContextAttribute{{Name: &attrNetworkZoneID, Value: &id}}}},
+					Resources: []Resource{{Attributes: []ResourceAttribute{{Name: &attrAccountID, Value: &accountID},
+						{Name: &attrServiceName, Value: &service}}}},
+					EnforcementMode: &enabled})
+				return err
+			},
+			want: request{method: http.MethodPost, path: "/v1/rules",
+				body: map[string]interface{}{
+					"contexts": []interface{}{map[string]interface{}{"attributes": []interface{}{
+						map[string]interface{}{"name": attrNetworkZoneID, "value": id}}}},
+					"resources": []interface{}{map[string]interface{}{"attributes": []interface{}{
+						map[string]interface{}{"name": attrAccountID, "value": accountID},
+						map[string]interface{}{"name": attrServiceName, "value": service}}}},
+					"enforcement_mode": enabled}},
+		},
+		"GetRule": {
+			call: func(c *ContextBasedRestrictionsV1) error {
+				_, _, err := c.GetRule(context.Background(), &GetRuleOptions{RuleID: &id})
+				return err
+			},
+			want: request{method: http.MethodGet, path: "/v1/rules/" + id},
+		},
+		"ReplaceRule": {
+			call: func(c *ContextBasedRestrictionsV1) error {
+				_, _, err := c.ReplaceRule(context.Background(), &ReplaceRuleOptions{RuleID: &id, IfMatch: &eTag,
+					Contexts: []RuleContext{}, Resources: []Resource{}, EnforcementMode: &enabled})
+				return err
+			},
+			want: request{method: http.MethodPut, path: "/v1/rules/" + id, ifMatch: eTag,
+				body: map[string]interface{}{"contexts": []interface{}{}, "resources": []interface{}{},
+					"enforcement_mode": enabled}},
+		},
+		"DeleteRule": {
+			call: func(c *ContextBasedRestrictionsV1) error {
+				_, err := c.DeleteRule(context.Background(), &DeleteRuleOptions{RuleID: &id})
+				return err
+			},
+			want: request{method: http.MethodDelete, path: "/v1/rules/" + id},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := request{}
+			c, server := setupClient(t, http.StatusOK, map[string]string{"id": "someID"}, &got)
+			defer server.Close()
+
+			if err := tc.call(c); err != nil {
+				t.Errorf("%s(...): unexpected error: %s", name, err)
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(request{})); diff != "" {
+				t.Errorf("%s(...): -want request, +got request:\n%s", name, diff)
+			}
+		})
+	}
+}
+
+func TestClientResponses(t *testing.T) {
+	id := "65810ac762004f22ac19f8f8edf70a34"
+	name := "my-zone"
+
+	t.Run("Successful", func(t *testing.T) {
+		c, server := setupClient(t, http.StatusOK, &Zone{ID: &id, Name: &name}, &request{})
+		defer server.Close()
+
+		got, _, err := c.GetZone(context.Background(), &GetZoneOptions{ZoneID: &id})
+		if err != nil {
+			t.Errorf("GetZone(...): unexpected error: %s", err)
+		}
+		if diff := cmp.Diff(&Zone{ID: &id, Name: &name}, got); diff != "" {
+			t.Errorf("GetZone(...): -want, +got:\n%s", diff)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		c, server := setupClient(t, http.StatusNotFound, nil, &request{})
+		defer server.Close()
+
+		got, resp, err := c.GetRule(context.Background(), &GetRuleOptions{RuleID: &id})
+		if err == nil || resp == nil || resp.StatusCode != http.StatusNotFound {
+			t.Errorf("GetRule(...): want not found error, got: %v", err)
+		}
+		if got != nil {
+			t.Errorf("GetRule(...): want no result, got: %v", got)
+		}
+	})
+
+	t.Run("MissingOptions", func(t *testing.T) {
+		c, server := setupClient(t, http.StatusOK, nil, &request{})
+		defer server.Close()
+
+		if _, _, err := c.ReplaceZone(context.Background(), &ReplaceZoneOptions{ZoneID: &id}); err == nil {
+			t.Error("ReplaceZone(...): want error for missing If-Match, name and account ID")
+		}
+	})
+}
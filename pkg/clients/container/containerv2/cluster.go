@@ -17,10 +17,15 @@ limitations under the License.
 package containerv2
 
 import (
+	"strings"
+
 	ibmContainerV2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	gcat "github.com/IBM/platform-services-go-sdk/globalcatalogv1"
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane-contrib/provider-ibm-cloud/apis/container/containerv2/v1alpha1"
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
 	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
 )
 
@@ -131,3 +136,50 @@ func GenerateClusterCreateRequest(in *v1alpha1.ClusterCreateRequest, out *ibmCon
 
 	return nil
 }
+
+// ApplyProviderConfigDefaults sets the tags of a Cluster to the default tags of its ProviderConfig, when not specified.
+// The default resource group is applied upon creation, as the cluster API targets resource groups by ID.
+func ApplyProviderConfigDefaults(pc *v1beta1.ProviderConfigSpec, mg resource.Managed) bool {
+	cr, ok := mg.(*v1alpha1.Cluster)
+	if !ok {
+		return false
+	}
+	if tags := ibmc.DefaultTags(pc, cr.Spec.ForProvider.Tags); tags != nil {
+		cr.Spec.ForProvider.Tags = tags
+		return true
+	}
+	return false
+}
+
+const (
+	// PricingServiceName is the global catalog service whose pricing covers the workers of clusters
+	PricingServiceName = "containers-kubernetes"
+
+	// PricingPlanName is the plan of PricingServiceName whose pricing covers the workers of clusters
+	PricingPlanName = "standard"
+)
+
+// MonthlyUsage returns the usage of the workers of a cluster in cost estimates: the metric of the flavor of the
+// workers, for every worker in every zone, for the whole month.
+func MonthlyUsage(in *v1alpha1.ClusterCreateRequest) ibmc.MonthlyUsage {
+	flavor := strings.ToLower(in.WorkerPools.Flavor)
+	workers := float64(in.WorkerPools.WorkerCount * len(in.WorkerPools.Zones))
+	return func(m gcat.Metrics) (float64, bool) {
+		if flavor == "" || strings.ToLower(reference.FromPtrValue(m.MetricID)) != flavor {
+			return 0, false
+		}
+		if ibmc.IsHourlyMetric(m) {
+			return workers * ibmc.HoursPerMonth, true
+		}
+		return workers, true
+	}
+}
+
+// EstimateMonthlyCost returns the estimated monthly cost of the workers of a cluster.
+func EstimateMonthlyCost(client ibmc.ClientSession, in *v1alpha1.ClusterCreateRequest) (float64, error) {
+	planID, err := ibmc.GetResourcePlanID(client, PricingServiceName, PricingPlanName)
+	if err != nil {
+		return 0, err
+	}
+	return ibmc.EstimatePlanMonthlyCost(client, reference.FromPtrValue(planID), MonthlyUsage(in))
+}
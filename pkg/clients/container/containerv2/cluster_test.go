@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	ibmContainerV2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	gcat "github.com/IBM/platform-services-go-sdk/globalcatalogv1"
 
 	"github.com/crossplane-contrib/provider-ibm-cloud/apis/container/containerv2/v1alpha1"
 	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
@@ -318,3 +319,41 @@ func TestGenerateClusterCreateRequest(t *testing.T) {
 		}
 	})
 }
+
+func TestMonthlyUsage(t *testing.T) {
+	in := &v1alpha1.ClusterCreateRequest{WorkerPools: v1alpha1.WorkerPoolConfig{Flavor: "bx2.4x16", WorkerCount: 2, Zones: []v1alpha1.Zone{{}, {}}}}
+	cases := map[string]struct {
+		id     string
+		unit   string
+		want   float64
+		wantOk bool
+	}{
+		"Flavor": {
+			id:     "BX2.4X16",
+			unit:   "INSTANCE_MONTHS",
+			want:   4,
+			wantOk: true,
+		},
+		"HourlyFlavor": {
+			id:     "bx2.4x16",
+			unit:   "INSTANCE_HOURS",
+			want:   4 * ibmc.HoursPerMonth,
+			wantOk: true,
+		},
+		"AnotherFlavorWithTheSamePrefix": {
+			id:   "bx2.4x16.encrypted",
+			unit: "INSTANCE_HOURS",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, ok := MonthlyUsage(in)(gcat.Metrics{MetricID: &tc.id, ChargeUnitName: &tc.unit})
+			if diff := cmp.Diff(tc.wantOk, ok); diff != "" {
+				t.Errorf("MonthlyUsage(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("MonthlyUsage(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package cos
 
 import (
+	"strings"
+
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -59,3 +61,12 @@ func GenerateS3BucketInput(in *v1alpha1.BucketPararams, out *s3.CreateBucketInpu
 
 	return nil
 }
+
+// LocationRegion returns the location of the given location constraint without its storage class, e.g. `us-south`
+// for `us-south-smart`
+func LocationRegion(locationConstraint string) string {
+	if i := strings.LastIndex(locationConstraint, "-"); i > 0 {
+		return locationConstraint[:i]
+	}
+	return locationConstraint
+}
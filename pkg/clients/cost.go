@@ -0,0 +1,179 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	gcat "github.com/IBM/platform-services-go-sdk/globalcatalogv1"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
+)
+
+const (
+	errGetPricing           = "cannot get the pricing of the plan"
+	errInvalidMonthlyBudget = "invalid monthly budget in the provider config"
+	errOverMonthlyBudget    = "estimated monthly cost of %s exceeds the monthly budget of %s of the provider config"
+
+	// HoursPerMonth is the number of hours in an average month, used to estimate the monthly cost of hourly
+	// pricing metrics
+	HoursPerMonth = 730
+
+	defaultPricingCountry  = "USA"
+	defaultPricingCurrency = "USD"
+)
+
+// A MonthlyUsage returns the estimated monthly usage of a pricing metric, in the unit the metric is charged by.
+// Metrics for which it returns false are not part of the estimate (e.g. metrics charged by consumption).
+type MonthlyUsage func(m gcat.Metrics) (float64, bool)
+
+// CostEstimationEnabled returns true if the ProviderConfig asks for cost estimates.
+func CostEstimationEnabled(pc *v1beta1.ProviderConfigSpec) bool {
+	return pc != nil && pc.CostEstimation != nil
+}
+
+// PricingLocale returns the country and currency of the prices used in cost estimates.
+func PricingLocale(pc *v1beta1.ProviderConfigSpec) (string, string) {
+	country, currency := defaultPricingCountry, defaultPricingCurrency
+	if CostEstimationEnabled(pc) {
+		if pc.CostEstimation.Country != nil {
+			country = *pc.CostEstimation.Country
+		}
+		if pc.CostEstimation.Currency != nil {
+			currency = *pc.CostEstimation.Currency
+		}
+	}
+	return country, currency
+}
+
+// GetPlanPricing returns the pricing of a plan of the global catalog, from the lookup cache of the client
+// if it is there.
+func GetPlanPricing(client ClientSession, planID string) (*gcat.PricingGet, error) {
+	cache := client.LookupCache()
+	if cache != nil {
+		if v, ok := cache.Get(lookupKindPricing, planID); ok {
+			return v.(*gcat.PricingGet), nil
+		}
+	}
+
+	pricing, _, err := client.GlobalCatalogV1().GetPricing(&gcat.GetPricingOptions{ID: reference.ToPtrValue(planID)})
+	if err != nil {
+		return nil, errors.Wrap(err, errGetPricing)
+	}
+	if cache != nil {
+		cache.Set(lookupKindPricing, planID, pricing)
+	}
+	return pricing, nil
+}
+
+// EstimatePlanMonthlyCost returns the monthly cost of the given usage of a plan, in the currency of the ProviderConfig
+// of the client.
+func EstimatePlanMonthlyCost(client ClientSession, planID string, usage MonthlyUsage) (float64, error) {
+	pricing, err := GetPlanPricing(client, planID)
+	if err != nil {
+		return 0, err
+	}
+	country, currency := PricingLocale(client.ProviderConfig())
+	return EstimateMonthlyCost(pricing, country, currency, usage), nil
+}
+
+// EstimateMonthlyCost returns the monthly cost of the given usage of a plan, in the given country and currency.
+// Only the first pricing tier of each metric is taken into account, so the estimate is an upper bound for
+// plans with volume discounts.
+func EstimateMonthlyCost(pricing *gcat.PricingGet, country, currency string, usage MonthlyUsage) float64 {
+	if pricing == nil {
+		return 0
+	}
+	cost := 0.0
+	for _, m := range pricing.Metrics {
+		q, ok := usage(m)
+		if !ok {
+			continue
+		}
+		price, ok := firstTierPrice(m, country, currency)
+		if !ok {
+			continue
+		}
+		unit, err := strconv.ParseFloat(reference.FromPtrValue(m.ChargeUnitQuantity), 64)
+		if err != nil || unit <= 0 {
+			unit = 1
+		}
+		cost += price * q / unit
+	}
+	return cost
+}
+
+func firstTierPrice(m gcat.Metrics, country, currency string) (float64, bool) {
+	for _, a := range m.Amounts {
+		if reference.FromPtrValue(a.Country) != country || reference.FromPtrValue(a.Currency) != currency {
+			continue
+		}
+		var first *gcat.Price
+		for i := range a.Prices {
+			p := &a.Prices[i]
+			if p.Price == nil {
+				continue
+			}
+			if first == nil || quantityTier(p) < quantityTier(first) {
+				first = p
+			}
+		}
+		if first != nil {
+			return *first.Price, true
+		}
+	}
+	return 0, false
+}
+
+func quantityTier(p *gcat.Price) int64 {
+	if p.QuantityTier == nil {
+		return 0
+	}
+	return *p.QuantityTier
+}
+
+// IsHourlyMetric returns true if the given pricing metric is charged by the hour.
+func IsHourlyMetric(m gcat.Metrics) bool {
+	return strings.Contains(strings.ToUpper(reference.FromPtrValue(m.ChargeUnitName)), "HOUR")
+}
+
+// FormatCost formats a cost estimate for the status of a resource, in the currency of the ProviderConfig
+// (e.g. `12.50 USD`).
+func FormatCost(pc *v1beta1.ProviderConfigSpec, cost float64) string {
+	_, currency := PricingLocale(pc)
+	return fmt.Sprintf("%.2f %s", cost, currency)
+}
+
+// CheckMonthlyBudget returns an error if the given monthly cost exceeds the monthly budget of the ProviderConfig.
+func CheckMonthlyBudget(pc *v1beta1.ProviderConfigSpec, cost float64) error {
+	if !CostEstimationEnabled(pc) || pc.CostEstimation.MonthlyBudget == nil {
+		return nil
+	}
+	budget, err := strconv.ParseFloat(*pc.CostEstimation.MonthlyBudget, 64)
+	if err != nil {
+		return errors.Wrap(err, errInvalidMonthlyBudget)
+	}
+	if cost > budget {
+		return errors.Errorf(errOverMonthlyBudget, FormatCost(pc, cost), FormatCost(pc, budget))
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package clients
+
+import (
+	"testing"
+
+	gcat "github.com/IBM/platform-services-go-sdk/globalcatalogv1"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
+)
+
+func price(tier int64, p float64) gcat.Price {
+	return gcat.Price{QuantityTier: &tier, Price: &p}
+}
+
+func metric(id, unitName, unitQuantity string, amounts ...gcat.Amount) gcat.Metrics {
+	return gcat.Metrics{
+		MetricID:           reference.ToPtrValue(id),
+		ChargeUnitName:     reference.ToPtrValue(unitName),
+		ChargeUnitQuantity: reference.ToPtrValue(unitQuantity),
+		Amounts:            amounts,
+	}
+}
+
+func amount(country, currency string, prices ...gcat.Price) gcat.Amount {
+	return gcat.Amount{Country: reference.ToPtrValue(country), Currency: reference.ToPtrValue(currency), Prices: prices}
+}
+
+func TestEstimateMonthlyCost(t *testing.T) {
+	pricing := &gcat.PricingGet{
+		Metrics: []gcat.Metrics{
+			metric("part-instance", "INSTANCE_HOURS", "1",
+				amount("USA", "USD", price(1000, 0.5), price(1, 1)),
+				amount("DEU", "EUR", price(1, 0.9))),
+			metric("part-storage", "GIGABYTE_MONTHS", "100",
+				amount("USA", "USD", price(1, 2))),
+			metric("part-api-calls", "API_CALLS", "1000",
+				amount("USA", "USD", price(1, 10))),
+		},
+	}
+	usage := func(m gcat.Metrics) (float64, bool) {
+		switch reference.FromPtrValue(m.MetricID) {
+		case "part-instance":
+			return HoursPerMonth, true
+		case "part-storage":
+			return 50, true
+		}
+		return 0, false
+	}
+	cases := map[string]struct {
+		pricing  *gcat.PricingGet
+		country  string
+		currency string
+		want     float64
+	}{
+		"NoPricing": {
+			country:  "USA",
+			currency: "USD",
+		},
+		"FirstTierOfEstimatedMetrics": {
+			pricing:  pricing,
+			country:  "USA",
+			currency: "USD",
+			want:     HoursPerMonth*1 + 50*2/100.0,
+		},
+		"OtherCountry": {
+			pricing:  pricing,
+			country:  "DEU",
+			currency: "EUR",
+			want:     HoursPerMonth * 0.9,
+		},
+		"NoPrices": {
+			pricing:  pricing,
+			country:  "JPN",
+			currency: "JPY",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := EstimateMonthlyCost(tc.pricing, tc.country, tc.currency, usage)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("EstimateMonthlyCost(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCheckMonthlyBudget(t *testing.T) {
+	budget := func(b string) *v1beta1.ProviderConfigSpec {
+		return &v1beta1.ProviderConfigSpec{CostEstimation: &v1beta1.CostEstimation{MonthlyBudget: &b}}
+	}
+	cases := map[string]struct {
+		pc      *v1beta1.ProviderConfigSpec
+		cost    float64
+		wantErr bool
+	}{
+		"NoProviderConfig": {
+			cost: 1000,
+		},
+		"NoBudget": {
+			pc:   &v1beta1.ProviderConfigSpec{CostEstimation: &v1beta1.CostEstimation{}},
+			cost: 1000,
+		},
+		"WithinBudget": {
+			pc:   budget("250.00"),
+			cost: 250,
+		},
+		"OverBudget": {
+			pc:      budget("250.00"),
+			cost:    250.01,
+			wantErr: true,
+		},
+		"InvalidBudget": {
+			pc:      budget("a lot"),
+			wantErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := CheckMonthlyBudget(tc.pc, tc.cost)
+			if diff := cmp.Diff(tc.wantErr, err != nil); diff != "" {
+				t.Errorf("CheckMonthlyBudget(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFormatCost(t *testing.T) {
+	eur := &v1beta1.ProviderConfigSpec{CostEstimation: &v1beta1.CostEstimation{Currency: reference.ToPtrValue("EUR")}}
+	if diff := cmp.Diff("12.50 USD", FormatCost(nil, 12.5)); diff != "" {
+		t.Errorf("FormatCost(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("0.33 EUR", FormatCost(eur, 1/3.0)); diff != "" {
+		t.Errorf("FormatCost(...): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronField is the set of allowed values of a field of a cron schedule, as a bit mask
+type cronField uint64
+
+type cronBounds struct {
+	name     string
+	min, max uint
+}
+
+var cronFieldBounds = []cronBounds{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	{name: "day of week", min: 0, max: 6},
+}
+
+// CronSchedule is a schedule in the standard 5-field cron format
+// (minute hour day-of-month month day-of-week), evaluated in UTC.
+//
+// Each field accepts "*", single values, ranges ("1-5"), lists ("1,15") and
+// steps ("*/15", "0-30/10"). As in cron, when both day-of-month and day-of-week
+// are restricted a day matches if either of them does.
+type CronSchedule struct {
+	minute, hour, dom, month, dow cronField
+
+	domStar, dowStar bool
+}
+
+// ParseCronSchedule parses a 5-field cron expression
+func ParseCronSchedule(expr string) (*CronSchedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != len(cronFieldBounds) {
+		return nil, fmt.Errorf("cron schedule %q: expected %d fields, found %d", expr, len(cronFieldBounds), len(fields))
+	}
+
+	parsed := make([]cronField, len(fields))
+	for i, f := range fields {
+		var err error
+		if parsed[i], err = parseCronField(f, cronFieldBounds[i]); err != nil {
+			return nil, fmt.Errorf("cron schedule %q: %s", expr, err)
+		}
+	}
+
+	return &CronSchedule{
+		minute:  parsed[0],
+		hour:    parsed[1],
+		dom:     parsed[2],
+		month:   parsed[3],
+		dow:     parsed[4],
+		domStar: strings.HasPrefix(fields[2], "*"),
+		dowStar: strings.HasPrefix(fields[4], "*"),
+	}, nil
+}
+
+func parseCronField(f string, b cronBounds) (cronField, error) {
+	var result cronField
+	for _, part := range strings.Split(f, ",") {
+		rng, step := part, uint(1)
+		if i := strings.Index(part, "/"); i >= 0 {
+			s, err := strconv.ParseUint(part[i+1:], 10, 8)
+			if err != nil || s == 0 {
+				return 0, fmt.Errorf("invalid step in %s field %q", b.name, part)
+			}
+			rng, step = part[:i], uint(s)
+		}
+
+		lo, hi := b.min, b.max
+		switch {
+		case rng == "*":
+		case strings.Contains(rng, "-"):
+			bounds := strings.SplitN(rng, "-", 2)
+			l, err1 := strconv.ParseUint(bounds[0], 10, 8)
+			h, err2 := strconv.ParseUint(bounds[1], 10, 8)
+			if err1 != nil || err2 != nil {
+				return 0, fmt.Errorf("invalid range in %s field %q", b.name, part)
+			}
+			lo, hi = uint(l), uint(h)
+		default:
+			v, err := strconv.ParseUint(rng, 10, 8)
+			if err != nil {
+				return 0, fmt.Errorf("invalid value in %s field %q", b.name, part)
+			}
+			lo = uint(v)
+			if !strings.Contains(part, "/") {
+				hi = lo
+			}
+		}
+		if lo < b.min || hi > b.max || lo > hi {
+			return 0, fmt.Errorf("%s field %q out of range [%d-%d]", b.name, part, b.min, b.max)
+		}
+
+		for v := lo; v <= hi; v += step {
+			result |= 1 << v
+		}
+	}
+	return result, nil
+}
+
+func (f cronField) has(v int) bool {
+	return f&(1<<uint(v)) != 0
+}
+
+func (s *CronSchedule) dayMatches(t time.Time) bool {
+	dom, dow := s.dom.has(t.Day()), s.dow.has(int(t.Weekday()))
+	if s.domStar || s.dowStar {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+// Next returns the first time matching the schedule strictly after t, or the
+// zero time if there is none within the next five years
+func (s *CronSchedule) Next(t time.Time) time.Time {
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+
+	for t.Before(limit) {
+		switch {
+		case !s.month.has(int(t.Month())):
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		case !s.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+		case !s.hour.has(t.Hour()):
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, time.UTC)
+		case !s.minute.has(t.Minute()):
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
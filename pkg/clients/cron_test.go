@@ -0,0 +1,84 @@
+package clients
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCronSchedule(t *testing.T) {
+	from := time.Date(2021, time.March, 10, 14, 37, 12, 0, time.UTC) // a Wednesday
+
+	cases := map[string]struct {
+		expr  string
+		isErr bool
+		next  time.Time
+	}{
+		"EveryMinute": {
+			expr: "* * * * *",
+			next: time.Date(2021, time.March, 10, 14, 38, 0, 0, time.UTC),
+		},
+		"Step": {
+			expr: "*/15 * * * *",
+			next: time.Date(2021, time.March, 10, 14, 45, 0, 0, time.UTC),
+		},
+		"NextDay": {
+			expr: "0 3 * * *",
+			next: time.Date(2021, time.March, 11, 3, 0, 0, 0, time.UTC),
+		},
+		"Quarterly": {
+			expr: "0 3 1 */3 *",
+			next: time.Date(2021, time.April, 1, 3, 0, 0, 0, time.UTC),
+		},
+		"List": {
+			expr: "0 0 1,15 * *",
+			next: time.Date(2021, time.March, 15, 0, 0, 0, 0, time.UTC),
+		},
+		"DayOfWeek": {
+			expr: "30 2 * * 0",
+			next: time.Date(2021, time.March, 14, 2, 30, 0, 0, time.UTC),
+		},
+		"DayOfMonthOrDayOfWeek": {
+			expr: "0 0 20 * 5",
+			next: time.Date(2021, time.March, 12, 0, 0, 0, 0, time.UTC),
+		},
+		"YearRollOver": {
+			expr: "0 0 1 1 *",
+			next: time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"NeverMatches": {
+			expr: "0 0 31 2 *",
+		},
+		"TooFewFields": {
+			expr:  "0 0 * *",
+			isErr: true,
+		},
+		"OutOfRange": {
+			expr:  "0 24 * * *",
+			isErr: true,
+		},
+		"BadStep": {
+			expr:  "*/0 * * * *",
+			isErr: true,
+		},
+		"BadValue": {
+			expr:  "a * * * *",
+			isErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s, err := ParseCronSchedule(tc.expr)
+			if diff := cmp.Diff(tc.isErr, err != nil); diff != "" {
+				t.Fatalf("ParseCronSchedule(...): -want error, +got error:\n%s", diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.next, s.Next(from)); diff != "" {
+				t.Errorf("Next(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package customrole
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	gcat "github.com/IBM/platform-services-go-sdk/globalcatalogv1"
+	iampmv1 "github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/iampolicymanagementv1/v1alpha1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+)
+
+const (
+	// TypeActionsValid custom roles only have actions that are supported by their service.
+	TypeActionsValid runtimev1alpha1.ConditionType = "ActionsValid"
+
+	// ReasonActionsSupported means that all the actions of the role are supported by its service.
+	ReasonActionsSupported runtimev1alpha1.ConditionReason = "ActionsSupported"
+
+	// ReasonActionsNotSupported means that some actions of the role are not supported by its service.
+	ReasonActionsNotSupported runtimev1alpha1.ConditionReason = "ActionsNotSupported"
+
+	lookupKindServiceRoles = "service-roles"
+
+	errListServiceRoles    = "cannot list the roles of service %q"
+	errListCatalogEntries  = "cannot look up service %q in the global catalog"
+	errServiceNotInCatalog = "service %q is not in the global catalog"
+	errNoServiceActions    = "service %q has no roles with actions"
+	errUnsupportedActions  = "actions not supported by service %q: %s"
+)
+
+// ActionsValid returns a condition that indicates all the actions of the role are supported by its service.
+func ActionsValid() runtimev1alpha1.Condition {
+	return runtimev1alpha1.Condition{
+		Type:               TypeActionsValid,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonActionsSupported,
+	}
+}
+
+// ActionsInvalid returns a condition that indicates some actions of the role are not supported by its service.
+func ActionsInvalid(err error) runtimev1alpha1.Condition {
+	return runtimev1alpha1.Condition{
+		Type:               TypeActionsValid,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonActionsNotSupported,
+		Message:            err.Error(),
+	}
+}
+
+// CheckActions validates the actions of a custom role against the actions of the service and system roles of its
+// service, and reflects the outcome in the ActionsValid condition of the role. It also sets in the status of the role
+// the system roles whose actions are a superset of its actions. A non-nil error means the role must be neither
+// created nor updated; the condition and status are left as they are if the roles of the service cannot be listed.
+func CheckActions(client ibmc.ClientSession, cr *v1alpha1.CustomRole) error {
+	p := cr.Spec.ForProvider
+	roles, err := getServiceRoles(client, p.AccountID, p.ServiceName)
+	if err != nil {
+		return err
+	}
+	if !hasActions(roles) {
+		// not knowing any action of the service is most likely due to a wrong service name
+		found, err := isServiceInCatalog(client, p.ServiceName)
+		if err != nil {
+			return err
+		}
+		err = errors.Errorf(errNoServiceActions, p.ServiceName)
+		if !found {
+			err = errors.Errorf(errServiceNotInCatalog, p.ServiceName)
+		}
+		cr.SetConditions(ActionsInvalid(err))
+		return err
+	}
+
+	cr.Status.AtProvider.SupersetSystemRoles = SupersetSystemRoles(p.Actions, roles)
+	if unsupported := UnsupportedActions(p.Actions, roles); len(unsupported) > 0 {
+		err := errors.Errorf(errUnsupportedActions, p.ServiceName, strings.Join(unsupported, ", "))
+		cr.SetConditions(ActionsInvalid(err))
+		return err
+	}
+	cr.SetConditions(ActionsValid())
+	return nil
+}
+
+// UnsupportedActions returns the given actions that are not actions of any of the service or system roles of a
+// service
+func UnsupportedActions(actions []string, roles *iampmv1.RoleList) []string {
+	supported := map[string]bool{}
+	for _, r := range append(append([]iampmv1.Role{}, roles.ServiceRoles...), roles.SystemRoles...) {
+		for _, a := range r.Actions {
+			supported[a] = true
+		}
+	}
+	o := []string{}
+	for _, a := range actions {
+		if !supported[a] {
+			o = append(o, a)
+		}
+	}
+	return o
+}
+
+// SupersetSystemRoles returns the CRNs of the system roles of a service whose actions include all the given actions
+func SupersetSystemRoles(actions []string, roles *iampmv1.RoleList) []string {
+	o := []string{}
+	for _, r := range roles.SystemRoles {
+		if len(r.Actions) == 0 {
+			continue
+		}
+		roleActions := map[string]bool{}
+		for _, a := range r.Actions {
+			roleActions[a] = true
+		}
+		superset := true
+		for _, a := range actions {
+			if !roleActions[a] {
+				superset = false
+				break
+			}
+		}
+		if superset {
+			o = append(o, reference.FromPtrValue(r.CRN))
+		}
+	}
+	sort.Strings(o)
+	return o
+}
+
+func hasActions(roles *iampmv1.RoleList) bool {
+	for _, r := range append(append([]iampmv1.Role{}, roles.ServiceRoles...), roles.SystemRoles...) {
+		if len(r.Actions) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// getServiceRoles returns the roles of a service in an account, from the lookup cache of the client if they are there
+func getServiceRoles(client ibmc.ClientSession, accountID, serviceName string) (*iampmv1.RoleList, error) {
+	// the roles include the custom roles of the account, so they are cached per account
+	key := accountID + "/" + serviceName
+	cache := client.LookupCache()
+	if cache != nil {
+		if v, ok := cache.Get(lookupKindServiceRoles, key); ok {
+			return v.(*iampmv1.RoleList), nil
+		}
+	}
+
+	roles, _, err := client.IamPolicyManagementV1().ListRoles(&iampmv1.ListRolesOptions{
+		AccountID:   reference.ToPtrValue(accountID),
+		ServiceName: reference.ToPtrValue(serviceName),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, errListServiceRoles, serviceName)
+	}
+	if cache != nil {
+		cache.Set(lookupKindServiceRoles, key, roles)
+	}
+	return roles, nil
+}
+
+func isServiceInCatalog(client ibmc.ClientSession, serviceName string) (bool, error) {
+	entries, _, err := client.GlobalCatalogV1().ListCatalogEntries(&gcat.ListCatalogEntriesOptions{
+		Q: reference.ToPtrValue(serviceName),
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, errListCatalogEntries, serviceName)
+	}
+	for _, e := range entries.Resources {
+		if reference.FromPtrValue(e.Name) == serviceName {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,215 @@
+package customrole
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	gcat "github.com/IBM/platform-services-go-sdk/globalcatalogv1"
+	iampmv1 "github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/iampolicymanagementv1/v1alpha1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+)
+
+var (
+	viewerCRN  = "crn:v1:bluemix:public:iam::::role:Viewer"
+	editorCRN  = "crn:v1:bluemix:public:iam::::role:Editor"
+	managerCRN = "crn:v1:bluemix:public:iam::::serviceRole:Manager"
+)
+
+func roleList() *iampmv1.RoleList {
+	return &iampmv1.RoleList{
+		ServiceRoles: []iampmv1.Role{{CRN: &managerCRN, Actions: []string{action2}}},
+		SystemRoles: []iampmv1.Role{
+			{CRN: &viewerCRN, Actions: []string{action1}},
+			{CRN: &editorCRN, Actions: []string{action1, action2}},
+		},
+	}
+}
+
+func TestUnsupportedActions(t *testing.T) {
+	cases := map[string]struct {
+		actions []string
+		want    []string
+	}{
+		"AllSupported": {
+			actions: []string{action1, action2},
+			want:    []string{},
+		},
+		"SomeUnsupported": {
+			actions: []string{action1, action3},
+			want:    []string{action3},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, UnsupportedActions(tc.actions, roleList())); diff != "" {
+				t.Errorf("UnsupportedActions(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSupersetSystemRoles(t *testing.T) {
+	cases := map[string]struct {
+		actions []string
+		want    []string
+	}{
+		"AllSystemRoles": {
+			actions: []string{action1},
+			want:    []string{editorCRN, viewerCRN},
+		},
+		"SomeSystemRoles": {
+			actions: []string{action1, action2},
+			want:    []string{editorCRN},
+		},
+		"NoSystemRole": {
+			actions: []string{action1, action3},
+			want:    []string{},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, SupersetSystemRoles(tc.actions, roleList())); diff != "" {
+				t.Errorf("SupersetSystemRoles(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCheckActions(t *testing.T) {
+	type want struct {
+		cond   *runtimev1alpha1.Condition
+		status v1alpha1.CustomRoleObservation
+		err    error
+	}
+	unsupported := fmt.Errorf(errUnsupportedActions, serviceName, action3)
+	notInCatalog := fmt.Errorf(errServiceNotInCatalog, serviceName)
+	noActions := fmt.Errorf(errNoServiceActions, serviceName)
+	cases := map[string]struct {
+		actions    []string
+		rolesCode  int
+		roles      *iampmv1.RoleList
+		catalogued []string
+		want       want
+	}{
+		"Valid": {
+			actions:   []string{action1, action2},
+			rolesCode: http.StatusOK,
+			roles:     roleList(),
+			want: want{
+				cond:   &runtimev1alpha1.Condition{Type: TypeActionsValid, Status: "True", Reason: ReasonActionsSupported},
+				status: v1alpha1.CustomRoleObservation{SupersetSystemRoles: []string{editorCRN}},
+			},
+		},
+		"UnsupportedActions": {
+			actions:   []string{action1, action3},
+			rolesCode: http.StatusOK,
+			roles:     roleList(),
+			want: want{
+				cond: &runtimev1alpha1.Condition{Type: TypeActionsValid, Status: "False", Reason: ReasonActionsNotSupported,
+					Message: unsupported.Error()},
+				status: v1alpha1.CustomRoleObservation{SupersetSystemRoles: []string{}},
+				err:    unsupported,
+			},
+		},
+		"ServiceNotInCatalog": {
+			actions:    []string{action1},
+			rolesCode:  http.StatusOK,
+			roles:      &iampmv1.RoleList{},
+			catalogued: []string{serviceName + "-other"},
+			want: want{
+				cond: &runtimev1alpha1.Condition{Type: TypeActionsValid, Status: "False", Reason: ReasonActionsNotSupported,
+					Message: notInCatalog.Error()},
+				err: notInCatalog,
+			},
+		},
+		"NoServiceActions": {
+			actions:    []string{action1},
+			rolesCode:  http.StatusOK,
+			roles:      &iampmv1.RoleList{},
+			catalogued: []string{serviceName},
+			want: want{
+				cond: &runtimev1alpha1.Condition{Type: TypeActionsValid, Status: "False", Reason: ReasonActionsNotSupported,
+					Message: noActions.Error()},
+				err: noActions,
+			},
+		},
+		"ListRolesFailed": {
+			actions:   []string{action1},
+			rolesCode: http.StatusInternalServerError,
+			want: want{
+				err: fmt.Errorf(errListServiceRoles+": %s", serviceName, http.StatusText(http.StatusInternalServerError)),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.HandleFunc("/v2/roles", func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tc.rolesCode)
+				_ = json.NewEncoder(w).Encode(tc.roles)
+			})
+			mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				entries := &gcat.EntrySearchResult{Resources: []gcat.CatalogEntry{}}
+				for i := range tc.catalogued {
+					entries.Resources = append(entries.Resources, gcat.CatalogEntry{Name: &tc.catalogued[i]})
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(entries)
+			})
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			mClient, _ := ibmc.GetTestClient(server.URL)
+			cr := &v1alpha1.CustomRole{Spec: v1alpha1.CustomRoleSpec{ForProvider: *params(func(p *v1alpha1.CustomRoleParameters) {
+				p.Actions = tc.actions
+			})}}
+			err := CheckActions(mClient, cr)
+			if tc.want.err == nil && err != nil || tc.want.err != nil && (err == nil || tc.want.err.Error() != err.Error()) {
+				t.Errorf("CheckActions(...): want error: %v\ngot: %v\n", tc.want.err, err)
+			}
+			if tc.want.cond == nil {
+				if c := cr.GetCondition(TypeActionsValid); c.Reason != "" {
+					t.Errorf("CheckActions(...): want no %s condition, got: %v", TypeActionsValid, c)
+				}
+			} else if diff := cmp.Diff(*tc.want.cond, cr.GetCondition(TypeActionsValid)); diff != "" {
+				t.Errorf("CheckActions(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, cr.Status.AtProvider); diff != "" {
+				t.Errorf("CheckActions(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetServiceRolesCachedPerAccount(t *testing.T) {
+	calls := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		calls[r.URL.Query().Get("account_id")]++
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(roleList())
+	}))
+	defer server.Close()
+
+	mClient, _ := ibmc.GetTestClient(server.URL)
+	for _, account := range []string{"account-a", "account-a", "account-b"} {
+		if _, err := getServiceRoles(mClient, account, serviceName); err != nil {
+			t.Errorf("getServiceRoles(...): unexpected error: %s", err)
+		}
+	}
+	if diff := cmp.Diff(map[string]int{"account-a": 1, "account-b": 1}, calls); diff != "" {
+		t.Errorf("getServiceRoles(...): -want calls, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"strconv"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// AnnotationDeletionProtection is the annotation that, when set to `true`, protects a managed resource (and the
+	// IBM Cloud resource it manages) from deletion. The annotation must be removed before the resource can be deleted.
+	AnnotationDeletionProtection = "ibmcloud.crossplane.io/deletion-protection"
+
+	errDeletionProtected = "%s %q is protected from deletion, remove the " + AnnotationDeletionProtection + " annotation to delete it"
+)
+
+// IsDeletionProtected returns true if the given object is protected from deletion.
+func IsDeletionProtected(o metav1.Object) bool {
+	v, ok := o.GetAnnotations()[AnnotationDeletionProtection]
+	if !ok {
+		return false
+	}
+	protected, err := strconv.ParseBool(v)
+	return err == nil && protected
+}
+
+// CheckDeletionProtection returns an error if the given object of the given kind is protected from deletion.
+func CheckDeletionProtection(kind string, o metav1.Object) error {
+	if IsDeletionProtected(o) {
+		return errors.Errorf(errDeletionProtected, kind, o.GetName())
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const (
+	// TypeDeletionBlocked managed resources wait for the managed resources that depend on them to be deleted
+	// before being deleted themselves.
+	TypeDeletionBlocked runtimev1alpha1.ConditionType = "DeletionBlocked"
+
+	// ReasonDependentsExist means that managed resources that depend on the resource still exist.
+	ReasonDependentsExist runtimev1alpha1.ConditionReason = "DependentsExist"
+
+	errListDependents  = "cannot list the managed resources that depend on the resource"
+	errDependentsExist = "waiting for the deletion of the managed resources that depend on the resource: %s"
+)
+
+// A Dependent is a kind of managed resource that may depend on other managed resources, through its references.
+type Dependent struct {
+	// Kind of the dependent managed resources
+	Kind string
+
+	// List returns an empty list of the dependent managed resources
+	List func() resource.ManagedList
+
+	// DependsOn returns true if the given dependent managed resource references the managed resource with the
+	// given name
+	DependsOn func(mg resource.Managed, name string) bool
+}
+
+// IsReferenceTo returns true if the given reference is to the managed resource with the given name.
+func IsReferenceTo(ref *runtimev1alpha1.Reference, name string) bool {
+	return ref != nil && ref.Name == name
+}
+
+// DeletionBlocked returns a condition that indicates the deletion of the resource waits for the deletion of the
+// managed resources that depend on it.
+func DeletionBlocked(err error) runtimev1alpha1.Condition {
+	return runtimev1alpha1.Condition{
+		Type:               TypeDeletionBlocked,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDependentsExist,
+		Message:            err.Error(),
+	}
+}
+
+// FindDependents returns the managed resources (as `Kind/name`) that depend on the managed resource with the given
+// name.
+func FindDependents(ctx context.Context, kube client.Client, name string, dependents ...Dependent) ([]string, error) {
+	found := []string{}
+	for _, d := range dependents {
+		l := d.List()
+		if err := kube.List(ctx, l); err != nil {
+			return nil, errors.Wrap(err, errListDependents)
+		}
+		for _, mg := range l.GetItems() {
+			if d.DependsOn(mg, name) {
+				found = append(found, d.Kind+"/"+mg.GetName())
+			}
+		}
+	}
+	return found, nil
+}
+
+// CheckDependents returns an error if managed resources that depend on the given one still exist, and reflects
+// them in its DeletionBlocked condition. A non-nil error means the resource must not be deleted yet.
+func CheckDependents(ctx context.Context, kube client.Client, mg resource.Managed, dependents ...Dependent) error {
+	found, err := FindDependents(ctx, kube, mg.GetName(), dependents...)
+	if err != nil {
+		return err
+	}
+	if len(found) == 0 {
+		return nil
+	}
+	err = errors.Errorf(errDependentsExist, strings.Join(found, ", "))
+	mg.SetConditions(DeletionBlocked(err))
+	return err
+}
@@ -0,0 +1,98 @@
+package clients
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/resourcecontrollerv2/v1alpha1"
+)
+
+var keyDependent = Dependent{
+	Kind: v1alpha1.ResourceKeyKind,
+	List: func() resource.ManagedList { return &v1alpha1.ResourceKeyList{} },
+	DependsOn: func(mg resource.Managed, name string) bool {
+		return IsReferenceTo(mg.(*v1alpha1.ResourceKey).Spec.ForProvider.SourceRef, name)
+	},
+}
+
+func keyReferencing(name, instance string) v1alpha1.ResourceKey {
+	k := v1alpha1.ResourceKey{}
+	k.SetName(name)
+	if instance != "" {
+		k.Spec.ForProvider.SourceRef = &runtimev1alpha1.Reference{Name: instance}
+	}
+	return k
+}
+
+func TestCheckDependents(t *testing.T) {
+	errBoom := errors.New("boom")
+	type want struct {
+		err       error
+		condition *runtimev1alpha1.Condition
+	}
+	cases := map[string]struct {
+		kube *test.MockClient
+		want want
+	}{
+		"NoDependents": {
+			kube: &test.MockClient{MockList: test.NewMockListFn(nil, func(obj runtime.Object) error {
+				obj.(*v1alpha1.ResourceKeyList).Items = []v1alpha1.ResourceKey{
+					keyReferencing("other", "other-instance"),
+					keyReferencing("unreferenced", ""),
+				}
+				return nil
+			})},
+		},
+		"DependentsExist": {
+			kube: &test.MockClient{MockList: test.NewMockListFn(nil, func(obj runtime.Object) error {
+				obj.(*v1alpha1.ResourceKeyList).Items = []v1alpha1.ResourceKey{
+					keyReferencing("key-1", "instance"),
+					keyReferencing("other", "other-instance"),
+					keyReferencing("key-2", "instance"),
+				}
+				return nil
+			})},
+			want: want{
+				err: errors.Errorf(errDependentsExist, "ResourceKey/key-1, ResourceKey/key-2"),
+				condition: func() *runtimev1alpha1.Condition {
+					c := DeletionBlocked(errors.Errorf(errDependentsExist, "ResourceKey/key-1, ResourceKey/key-2"))
+					return &c
+				}(),
+			},
+		},
+		"ListFailed": {
+			kube: &test.MockClient{MockList: test.NewMockListFn(errBoom)},
+			want: want{
+				err: errors.Wrap(errBoom, errListDependents),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &v1alpha1.ResourceInstance{}
+			mg.SetName("instance")
+
+			err := CheckDependents(context.Background(), tc.kube, mg, keyDependent)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("CheckDependents(...): -want error, +got error:\n%s", diff)
+			}
+			if tc.want.condition == nil {
+				if len(mg.Status.Conditions) != 0 {
+					t.Errorf("CheckDependents(...): unexpected conditions %v", mg.Status.Conditions)
+				}
+				return
+			}
+			if diff := cmp.Diff(*tc.want.condition, mg.GetCondition(TypeDeletionBlocked)); diff != "" {
+				t.Errorf("CheckDependents(...): -want condition, +got condition:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,239 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const (
+	// AnnotationExpiresAt is the annotation holding the time (in RFC 3339 format, e.g. `2021-06-01T18:00:00Z`)
+	// after which a managed resource is deleted
+	AnnotationExpiresAt = "ibmcloud.crossplane.io/expires-at"
+
+	// AnnotationTTL is the annotation holding the time to live of a managed resource, as a duration since its
+	// creation (e.g. `72h`), after which it is deleted. AnnotationExpiresAt takes precedence over it.
+	AnnotationTTL = "ibmcloud.crossplane.io/ttl"
+
+	// AnnotationExpiryWarning is the annotation holding how long before its expiry (e.g. `24h`) warning events are
+	// emitted for a managed resource. Defaults to DefaultExpiryWarning.
+	AnnotationExpiryWarning = "ibmcloud.crossplane.io/expiry-warning"
+
+	// AnnotationExpiryWarned is the annotation holding the expiry (in RFC 3339 format) a managed resource has been
+	// warned about, so that the warning event is emitted once rather than at every reconciliation.
+	AnnotationExpiryWarned = "ibmcloud.crossplane.io/expiry-warned"
+
+	// DefaultExpiryWarning is how long before their expiry warning events are emitted for managed resources
+	DefaultExpiryWarning = time.Hour
+
+	reasonExpiring          event.Reason = "ExpiringSoon"
+	reasonExpired           event.Reason = "Expired"
+	reasonInvalidExpiration event.Reason = "InvalidExpiration"
+
+	errInvalidExpiresAt     = "invalid " + AnnotationExpiresAt + " annotation"
+	errInvalidTTL           = "invalid " + AnnotationTTL + " annotation"
+	errInvalidExpiryWarning = "invalid " + AnnotationExpiryWarning + " annotation"
+	errExpiring             = "the managed resource expires at %s and will then be deleted"
+	errDeleteExpired        = "cannot delete the expired managed resource"
+	errUpdateExpiryWarned   = "cannot update the managed resource with its " + AnnotationExpiryWarned + " annotation"
+)
+
+// GetExpiration returns the time after which the given managed resource should be deleted, according to its
+// AnnotationExpiresAt or AnnotationTTL annotation, and nil if it does not expire.
+func GetExpiration(mg resource.Managed) (*time.Time, error) {
+	a := mg.GetAnnotations()
+	if v, ok := a[AnnotationExpiresAt]; ok {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, errors.Wrap(err, errInvalidExpiresAt)
+		}
+		return &t, nil
+	}
+	if v, ok := a[AnnotationTTL]; ok {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, errors.Wrap(err, errInvalidTTL)
+		}
+		t := mg.GetCreationTimestamp().Add(d)
+		return &t, nil
+	}
+	return nil, nil
+}
+
+func getExpiryWarning(mg resource.Managed) (time.Duration, error) {
+	v, ok := mg.GetAnnotations()[AnnotationExpiryWarning]
+	if !ok {
+		return DefaultExpiryWarning, nil
+	}
+	d, err := time.ParseDuration(v)
+	return d, errors.Wrap(err, errInvalidExpiryWarning)
+}
+
+// getActiveExpiration returns the expiration of the given managed resource, and nil if it does not expire because
+// it is already being deleted, is orphaned on deletion or is protected from deletion.
+func getActiveExpiration(mg resource.Managed) (*time.Time, error) {
+	if meta.WasDeleted(mg) || mg.GetDeletionPolicy() == runtimev1alpha1.DeletionOrphan || IsDeletionProtected(mg) {
+		return nil, nil
+	}
+	return GetExpiration(mg)
+}
+
+// Expiration is a managed.Initializer that emits a warning event, once, during the period before the expiry of the
+// managed resources that expire (see AnnotationExpiresAt, AnnotationTTL and AnnotationExpiryWarning). Managed resources
+// whose deletion policy is Orphan, or that are protected from deletion (see AnnotationDeletionProtection), never
+// expire. Expired managed resources are deleted by the reconciler returned by NewExpirationReconciler, with which
+// controllers are wrapped.
+type Expiration struct {
+	kube   client.Client
+	record event.Recorder
+	now    func() time.Time
+}
+
+// NewExpiration returns an Expiration initializer.
+func NewExpiration(kube client.Client, record event.Recorder) *Expiration {
+	return &Expiration{kube: kube, record: record, now: time.Now}
+}
+
+// Initialize emits a warning event if the given managed resource is about to expire, and records in its
+// AnnotationExpiryWarned annotation that it has been warned.
+func (e *Expiration) Initialize(ctx context.Context, mg resource.Managed) error {
+	// an invalid annotation is reported, but does not prevent the resource from being reconciled
+	expiresAt, err := getActiveExpiration(mg)
+	if err != nil {
+		e.record.Event(mg, event.Warning(reasonInvalidExpiration, err))
+		return nil
+	}
+	now := e.now()
+	if expiresAt == nil || !now.Before(*expiresAt) {
+		return nil
+	}
+
+	warning, err := getExpiryWarning(mg)
+	if err != nil {
+		e.record.Event(mg, event.Warning(reasonInvalidExpiration, err))
+		warning = DefaultExpiryWarning
+	}
+	at := expiresAt.Format(time.RFC3339)
+	if !now.Add(warning).After(*expiresAt) || mg.GetAnnotations()[AnnotationExpiryWarned] == at {
+		return nil
+	}
+	meta.AddAnnotations(mg, map[string]string{AnnotationExpiryWarned: at})
+	if err := e.kube.Update(ctx, mg); err != nil {
+		return errors.Wrap(err, errUpdateExpiryWarned)
+	}
+	e.record.Event(mg, event.Warning(reasonExpiring, errors.Errorf(errExpiring, at)))
+	return nil
+}
+
+// nextExpirationCheck returns how long after the given time the given managed resource should be reconciled again,
+// for its expiry warning period to start or for it to expire, and zero if it does not expire in the future.
+func nextExpirationCheck(mg resource.Managed, now time.Time) time.Duration {
+	expiresAt, err := getActiveExpiration(mg)
+	if err != nil || expiresAt == nil || !now.Before(*expiresAt) {
+		return 0
+	}
+	warning, err := getExpiryWarning(mg)
+	if err != nil {
+		warning = DefaultExpiryWarning
+	}
+	if warnAt := expiresAt.Add(-warning); now.Before(warnAt) {
+		return warnAt.Sub(now)
+	}
+	return expiresAt.Sub(now)
+}
+
+// An expirationReconciler deletes the expired managed resources before the reconciler it wraps gets to observe, create
+// or update their external resources, and reconciles the managed resources that expire again when their expiry
+// warning period starts and when they expire, if that is earlier than the requeue requested by the reconciler it
+// wraps.
+type expirationReconciler struct {
+	reconcile.Reconciler
+	kube       client.Client
+	record     event.Recorder
+	newManaged func() resource.Managed
+	now        func() time.Time
+}
+
+// NewExpirationReconciler wraps the given reconciler of managed resources of the given kind, so that the expired
+// managed resources are deleted, and the warning events of Expiration happen on time rather than at the next poll.
+func NewExpirationReconciler(mgr ctrl.Manager, of resource.ManagedKind, r reconcile.Reconciler) reconcile.Reconciler {
+	name := managed.ControllerName(schema.GroupVersionKind(of).GroupKind().String())
+	return &expirationReconciler{
+		Reconciler: r,
+		kube:       mgr.GetClient(),
+		record:     event.NewAPIRecorder(mgr.GetEventRecorderFor(name)),
+		newManaged: func() resource.Managed {
+			return resource.MustCreateObject(schema.GroupVersionKind(of), mgr.GetScheme()).(resource.Managed)
+		},
+		now: time.Now,
+	}
+}
+
+// Reconcile deletes the given managed resource if it has expired, and otherwise reconciles it and requeues it for
+// its next expiration check.
+func (r *expirationReconciler) Reconcile(req reconcile.Request) (reconcile.Result, error) {
+	// the deletion triggers another reconciliation, in which the wrapped reconciler deletes the external resource
+	if deleted, err := r.deleteExpired(req); deleted || err != nil {
+		return reconcile.Result{}, err
+	}
+
+	result, err := r.Reconciler.Reconcile(req)
+	if err != nil || result.Requeue {
+		return result, err
+	}
+
+	mg := r.newManaged()
+	if err := r.kube.Get(context.Background(), req.NamespacedName, mg); err != nil {
+		return result, nil
+	}
+	if d := nextExpirationCheck(mg, r.now()); d > 0 && (result.RequeueAfter == 0 || d < result.RequeueAfter) {
+		result.RequeueAfter = d
+	}
+	return result, nil
+}
+
+// deleteExpired deletes the given managed resource if it has expired, and returns whether it did. The managed
+// resources that cannot be read, or whose expiration is invalid, are left to the wrapped reconciler.
+func (r *expirationReconciler) deleteExpired(req reconcile.Request) (bool, error) {
+	ctx := context.Background()
+	mg := r.newManaged()
+	if err := r.kube.Get(ctx, req.NamespacedName, mg); err != nil {
+		return false, nil
+	}
+	expiresAt, err := getActiveExpiration(mg)
+	if err != nil || expiresAt == nil || r.now().Before(*expiresAt) {
+		return false, nil
+	}
+	if err := r.kube.Delete(ctx, mg); resource.IgnoreNotFound(err) != nil {
+		return false, errors.Wrap(err, errDeleteExpired)
+	}
+	r.record.Event(mg, event.Normal(reasonExpired, "Deleted the expired managed resource", "expires-at", expiresAt.Format(time.RFC3339)))
+	return true, nil
+}
@@ -0,0 +1,301 @@
+package clients
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+type reasonRecorder struct {
+	reasons []event.Reason
+}
+
+func (r *reasonRecorder) Event(_ runtime.Object, e event.Event) {
+	r.reasons = append(r.reasons, e.Reason)
+}
+
+func (r *reasonRecorder) WithAnnotations(_ ...string) event.Recorder { return r }
+
+func expiringManaged(created time.Time, policy runtimev1alpha1.DeletionPolicy, annotations map[string]string) *fake.Managed {
+	mg := &fake.Managed{Orphanable: fake.Orphanable{Policy: policy}}
+	mg.SetCreationTimestamp(metav1.NewTime(created))
+	mg.SetAnnotations(annotations)
+	return mg
+}
+
+func TestExpirationInitialize(t *testing.T) {
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	created := now.Add(-48 * time.Hour)
+	deleting := expiringManaged(created, runtimev1alpha1.DeletionDelete, map[string]string{AnnotationTTL: "1h"})
+	deleting.SetDeletionTimestamp(&metav1.Time{Time: now})
+
+	type want struct {
+		warned  string
+		reasons []event.Reason
+		err     bool
+	}
+	cases := map[string]struct {
+		mg        *fake.Managed
+		updateErr error
+		want      want
+	}{
+		"NoExpiration": {
+			mg: expiringManaged(created, runtimev1alpha1.DeletionDelete, nil),
+		},
+		"NotExpiringYet": {
+			mg: expiringManaged(created, runtimev1alpha1.DeletionDelete, map[string]string{AnnotationTTL: "72h"}),
+		},
+		"ExpiringSoon": {
+			mg:   expiringManaged(created, runtimev1alpha1.DeletionDelete, map[string]string{AnnotationExpiresAt: "2021-06-01T12:30:00Z"}),
+			want: want{warned: "2021-06-01T12:30:00Z", reasons: []event.Reason{reasonExpiring}},
+		},
+		"AlreadyWarned": {
+			mg: expiringManaged(created, runtimev1alpha1.DeletionDelete, map[string]string{
+				AnnotationExpiresAt:    "2021-06-01T12:30:00Z",
+				AnnotationExpiryWarned: "2021-06-01T12:30:00Z",
+			}),
+		},
+		"WarnedAboutAnotherExpiry": {
+			mg: expiringManaged(created, runtimev1alpha1.DeletionDelete, map[string]string{
+				AnnotationExpiresAt:    "2021-06-01T12:30:00Z",
+				AnnotationExpiryWarned: "2021-06-01T12:10:00Z",
+			}),
+			want: want{warned: "2021-06-01T12:30:00Z", reasons: []event.Reason{reasonExpiring}},
+		},
+		"ExpiringWithinCustomWarning": {
+			mg: expiringManaged(created, runtimev1alpha1.DeletionDelete, map[string]string{
+				AnnotationTTL:           "60h",
+				AnnotationExpiryWarning: "24h",
+			}),
+			want: want{warned: "2021-06-02T00:00:00Z", reasons: []event.Reason{reasonExpiring}},
+		},
+		"Expired": {
+			mg: expiringManaged(created, runtimev1alpha1.DeletionDelete, map[string]string{AnnotationTTL: "48h"}),
+		},
+		"ExpiresAtTakesPrecedence": {
+			mg: expiringManaged(created, runtimev1alpha1.DeletionDelete, map[string]string{
+				AnnotationTTL:       "1h",
+				AnnotationExpiresAt: "2021-07-01T00:00:00Z",
+			}),
+		},
+		"Orphan": {
+			mg: expiringManaged(created, runtimev1alpha1.DeletionOrphan, map[string]string{AnnotationExpiresAt: "2021-06-01T12:30:00Z"}),
+		},
+		"DeletionProtected": {
+			mg: expiringManaged(created, runtimev1alpha1.DeletionDelete, map[string]string{
+				AnnotationExpiresAt:          "2021-06-01T12:30:00Z",
+				AnnotationDeletionProtection: "true",
+			}),
+		},
+		"AlreadyDeleting": {
+			mg: deleting,
+		},
+		"InvalidAnnotation": {
+			mg:   expiringManaged(created, runtimev1alpha1.DeletionDelete, map[string]string{AnnotationExpiresAt: "tomorrow"}),
+			want: want{reasons: []event.Reason{reasonInvalidExpiration}},
+		},
+		"UpdateFailed": {
+			mg:        expiringManaged(created, runtimev1alpha1.DeletionDelete, map[string]string{AnnotationExpiresAt: "2021-06-01T12:30:00Z"}),
+			updateErr: errors.New("boom"),
+			want:      want{err: true},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			warned := ""
+			kube := &test.MockClient{
+				MockUpdate: func(_ context.Context, obj runtime.Object, _ ...client.UpdateOption) error {
+					if tc.updateErr == nil {
+						warned = obj.(*fake.Managed).GetAnnotations()[AnnotationExpiryWarned]
+					}
+					return tc.updateErr
+				},
+			}
+			rec := &reasonRecorder{}
+			e := NewExpiration(kube, rec)
+			e.now = func() time.Time { return now }
+
+			err := e.Initialize(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("Initialize(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.warned, warned); diff != "" {
+				t.Errorf("Initialize(...): -want warned, +got warned:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.reasons, rec.reasons); diff != "" {
+				t.Errorf("Initialize(...): -want events, +got events:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNextExpirationCheck(t *testing.T) {
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	created := now.Add(-48 * time.Hour)
+	cases := map[string]struct {
+		mg   *fake.Managed
+		want time.Duration
+	}{
+		"NoExpiration": {
+			mg: expiringManaged(created, runtimev1alpha1.DeletionDelete, nil),
+		},
+		"BeforeWarning": {
+			mg:   expiringManaged(created, runtimev1alpha1.DeletionDelete, map[string]string{AnnotationTTL: "72h"}),
+			want: 23 * time.Hour,
+		},
+		"WithinWarning": {
+			mg:   expiringManaged(created, runtimev1alpha1.DeletionDelete, map[string]string{AnnotationExpiresAt: "2021-06-01T12:30:00Z"}),
+			want: 30 * time.Minute,
+		},
+		"WithinCustomWarning": {
+			mg: expiringManaged(created, runtimev1alpha1.DeletionDelete, map[string]string{
+				AnnotationTTL:           "60h",
+				AnnotationExpiryWarning: "24h",
+			}),
+			want: 12 * time.Hour,
+		},
+		"Expired": {
+			mg: expiringManaged(created, runtimev1alpha1.DeletionDelete, map[string]string{AnnotationTTL: "48h"}),
+		},
+		"Orphan": {
+			mg: expiringManaged(created, runtimev1alpha1.DeletionOrphan, map[string]string{AnnotationTTL: "72h"}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, nextExpirationCheck(tc.mg, now)); diff != "" {
+				t.Errorf("nextExpirationCheck(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestExpirationReconcilerRequeue(t *testing.T) {
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	mg := expiringManaged(now.Add(-48*time.Hour), runtimev1alpha1.DeletionDelete, map[string]string{AnnotationTTL: "72h"})
+	cases := map[string]struct {
+		result reconcile.Result
+		want   reconcile.Result
+	}{
+		"EarlierThanPoll": {
+			result: reconcile.Result{RequeueAfter: 48 * time.Hour},
+			want:   reconcile.Result{RequeueAfter: 23 * time.Hour},
+		},
+		"LaterThanPoll": {
+			result: reconcile.Result{RequeueAfter: time.Minute},
+			want:   reconcile.Result{RequeueAfter: time.Minute},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &expirationReconciler{
+				Reconciler: reconcile.Func(func(reconcile.Request) (reconcile.Result, error) { return tc.result, nil }),
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(nil, func(obj runtime.Object) error {
+					*obj.(*fake.Managed) = *mg
+					return nil
+				})},
+				newManaged: func() resource.Managed { return &fake.Managed{} },
+				now:        func() time.Time { return now },
+			}
+			got, err := r.Reconcile(reconcile.Request{})
+			if err != nil {
+				t.Errorf("Reconcile(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Reconcile(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestExpirationReconcilerDeleteExpired(t *testing.T) {
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	created := now.Add(-48 * time.Hour)
+	type want struct {
+		deleted    bool
+		reconciled bool
+		reasons    []event.Reason
+		err        bool
+	}
+	cases := map[string]struct {
+		mg        *fake.Managed
+		deleteErr error
+		want      want
+	}{
+		"NotExpired": {
+			mg:   expiringManaged(created, runtimev1alpha1.DeletionDelete, map[string]string{AnnotationTTL: "72h"}),
+			want: want{reconciled: true},
+		},
+		"Expired": {
+			mg:   expiringManaged(created, runtimev1alpha1.DeletionDelete, map[string]string{AnnotationTTL: "48h"}),
+			want: want{deleted: true, reasons: []event.Reason{reasonExpired}},
+		},
+		"ExpiredButProtected": {
+			mg: expiringManaged(created, runtimev1alpha1.DeletionDelete, map[string]string{
+				AnnotationTTL:                "1h",
+				AnnotationDeletionProtection: "true",
+			}),
+			want: want{reconciled: true},
+		},
+		"InvalidAnnotation": {
+			mg:   expiringManaged(created, runtimev1alpha1.DeletionDelete, map[string]string{AnnotationTTL: "soon"}),
+			want: want{reconciled: true},
+		},
+		"DeleteFailed": {
+			mg:        expiringManaged(created, runtimev1alpha1.DeletionDelete, map[string]string{AnnotationTTL: "1h"}),
+			deleteErr: errors.New("boom"),
+			want:      want{deleted: true, err: true},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			deleted, reconciled := false, false
+			rec := &reasonRecorder{}
+			r := &expirationReconciler{
+				Reconciler: reconcile.Func(func(reconcile.Request) (reconcile.Result, error) {
+					reconciled = true
+					return reconcile.Result{}, nil
+				}),
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj runtime.Object) error {
+						*obj.(*fake.Managed) = *tc.mg
+						return nil
+					}),
+					MockDelete: func(_ context.Context, _ runtime.Object, _ ...client.DeleteOption) error {
+						deleted = true
+						return tc.deleteErr
+					},
+				},
+				record:     rec,
+				newManaged: func() resource.Managed { return &fake.Managed{} },
+				now:        func() time.Time { return now },
+			}
+			_, err := r.Reconcile(reconcile.Request{})
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("Reconcile(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("Reconcile(...): -want deleted, +got deleted:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.reconciled, reconciled); diff != "" {
+				t.Errorf("Reconcile(...): -want reconciled, +got reconciled:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.reasons, rec.reasons); diff != "" {
+				t.Errorf("Reconcile(...): -want events, +got events:\n%s", diff)
+			}
+		})
+	}
+}
@@ -2,7 +2,10 @@ package groupmembership
 
 import (
 	"encoding/json"
+	"fmt"
+	"net/http"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/go-openapi/strfmt"
@@ -14,6 +17,7 @@ import (
 
 	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 
 	corev4 "github.com/IBM/go-sdk-core/v4/core"
@@ -32,6 +36,18 @@ const (
 	MemberTypeUser = "user"
 	// MemberTypeService represents a service member
 	MemberTypeService = "service"
+	// MemberTypeProfile represents a trusted profile member
+	MemberTypeProfile = "profile"
+	// OperationAdd is the operation of a member added to an access group
+	OperationAdd = "add"
+	// OperationRemove is the operation of a member removed from an access group
+	OperationRemove = "remove"
+	// AnnotationManagedMembers holds the IAM IDs of the members that a GroupMembership which is not exclusive added
+	// to its access group, as a JSON list. Unlike the status, it is saved right after the members are added, so that
+	// they are removed on deletion even if the status is lost.
+	AnnotationManagedMembers = "ibmcloud.crossplane.io/managed-members"
+
+	errParseManagedMembers = "cannot parse the " + AnnotationManagedMembers + " annotation"
 )
 
 // LateInitializeSpec fills optional and unassigned fields with the values in *iamagv2.GroupMembership object.
@@ -193,8 +209,146 @@ func MembersDiff(desired v1alpha1.GroupMembershipParameters, actual v1alpha1.Gro
 }
 
 // UpdateAccessGroupMembers update members access group
-func UpdateAccessGroupMembers(client ibmc.ClientSession, groupMembership v1alpha1.GroupMembership) error {
+func UpdateAccessGroupMembers(client ibmc.ClientSession, groupMembership *v1alpha1.GroupMembership) error {
 	toAdd, toRemove := MembersDiff(groupMembership.Spec.ForProvider, groupMembership.Status.AtProvider)
+	return updateMembers(client, groupMembership, toAdd, toRemove)
+}
+
+// IsExclusive returns whether the members of a GroupMembership are the complete set of members of its access group
+func IsExclusive(in v1alpha1.GroupMembershipParameters) bool {
+	return in.Exclusive == nil || *in.Exclusive
+}
+
+// ManagedMembersDiff computes the members to add to an access group and the members to remove from it, for a
+// GroupMembership that is not exclusive: only the members it added, that are no longer desired, are removed
+func ManagedMembersDiff(desired v1alpha1.GroupMembershipParameters, actual v1alpha1.GroupMembershipObservation) ([]iamagv2.AddGroupMembersRequestMembersItem, []string) {
+	toAdd := []iamagv2.AddGroupMembersRequestMembersItem{}
+	toRemove := []string{}
+	dMap := map[string]bool{}
+	aMap := map[string]bool{}
+	for _, d := range desired.Members {
+		dMap[d.IamID] = true
+	}
+	for _, a := range actual.Members {
+		aMap[a.IamID] = true
+	}
+
+	for _, d := range desired.Members {
+		if !aMap[d.IamID] {
+			toAdd = append(toAdd, iamagv2.AddGroupMembersRequestMembersItem{
+				IamID: reference.ToPtrValue(d.IamID),
+				Type:  reference.ToPtrValue(d.Type),
+			})
+		}
+	}
+
+	for _, m := range actual.ManagedMembers {
+		if aMap[m] && !dMap[m] {
+			toRemove = append(toRemove, m)
+		}
+	}
+	return toAdd, toRemove
+}
+
+// IsManagedMembersUpToDate checks whether the members of an access group are up-to-date for a GroupMembership that
+// is not exclusive
+func IsManagedMembersUpToDate(desired *v1alpha1.GroupMembershipParameters, actual v1alpha1.GroupMembershipObservation) bool {
+	toAdd, toRemove := ManagedMembersDiff(*desired, actual)
+	return len(toAdd) == 0 && len(toRemove) == 0
+}
+
+// HasManagedMembers returns whether any of the members added by a GroupMembership that is not exclusive is still a
+// member of its access group
+func HasManagedMembers(in *iamagv2.GroupMembersList, managed []string) bool {
+	inGroup := map[string]bool{}
+	for _, m := range in.Members {
+		inGroup[reference.FromPtrValue(m.IamID)] = true
+	}
+	for _, m := range managed {
+		if inGroup[m] {
+			return true
+		}
+	}
+	return false
+}
+
+// GetManagedMembers returns the members that a GroupMembership which is not exclusive added to its access group,
+// from its AnnotationManagedMembers annotation, or from its status if it has no such annotation yet.
+func GetManagedMembers(groupMembership *v1alpha1.GroupMembership) ([]string, error) {
+	v, ok := groupMembership.GetAnnotations()[AnnotationManagedMembers]
+	if !ok {
+		return groupMembership.Status.AtProvider.ManagedMembers, nil
+	}
+	var members []string
+	if err := json.Unmarshal([]byte(v), &members); err != nil {
+		return nil, errors.Wrap(err, errParseManagedMembers)
+	}
+	if len(members) == 0 {
+		return nil, nil
+	}
+	return members, nil
+}
+
+// SetManagedMembers records the members that a GroupMembership which is not exclusive added to its access group,
+// both in its AnnotationManagedMembers annotation and in its status.
+func SetManagedMembers(groupMembership *v1alpha1.GroupMembership, members []string) {
+	sort.Strings(members)
+	groupMembership.Status.AtProvider.ManagedMembers = members
+	b := []byte("[]")
+	if len(members) > 0 {
+		b, _ = json.Marshal(members) // nolint:errcheck
+	}
+	meta.AddAnnotations(groupMembership, map[string]string{AnnotationManagedMembers: string(b)})
+}
+
+// UpdateManagedAccessGroupMembers adds the missing members to the access group of a GroupMembership that is not
+// exclusive, removes the members it added that are no longer desired, and tracks the members it added (see
+// SetManagedMembers)
+func UpdateManagedAccessGroupMembers(client ibmc.ClientSession, groupMembership *v1alpha1.GroupMembership) error {
+	current, err := GetManagedMembers(groupMembership)
+	if err != nil {
+		return err
+	}
+	groupMembership.Status.AtProvider.ManagedMembers = current
+	toAdd, toRemove := ManagedMembersDiff(groupMembership.Spec.ForProvider, groupMembership.Status.AtProvider)
+
+	// members removed from the group by other means are no longer managed (they are added again if still desired)
+	inGroup := map[string]bool{}
+	for _, m := range groupMembership.Status.AtProvider.Members {
+		inGroup[m.IamID] = true
+	}
+	managed := map[string]bool{}
+	for _, m := range current {
+		if inGroup[m] {
+			managed[m] = true
+		}
+	}
+
+	err = updateMembers(client, groupMembership, toAdd, toRemove)
+	for _, r := range groupMembership.Status.AtProvider.MemberResults {
+		switch {
+		case r.Operation == OperationAdd && r.Error == "":
+			managed[r.IamID] = true
+		case r.Operation == OperationRemove && (r.Error == "" || r.StatusCode == http.StatusNotFound):
+			delete(managed, r.IamID)
+		}
+	}
+	var members []string
+	for m := range managed {
+		members = append(members, m)
+	}
+	SetManagedMembers(groupMembership, members)
+	return err
+}
+
+// updateMembers adds and removes members of the access group of a GroupMembership in batches, and reports the
+// results per member in its status
+func updateMembers(client ibmc.ClientSession, groupMembership *v1alpha1.GroupMembership, toAdd []iamagv2.AddGroupMembersRequestMembersItem, toRemove []string) error {
+	if len(toAdd) == 0 && len(toRemove) == 0 {
+		return nil
+	}
+	results := []v1alpha1.MemberResult{}
+	errs := []string{}
 
 	if len(toAdd) > 0 {
 		opts := &iamagv2.AddMembersToAccessGroupOptions{
@@ -202,9 +356,14 @@ func UpdateAccessGroupMembers(client ibmc.ClientSession, groupMembership v1alpha
 			Members:       toAdd,
 		}
 		_, resp, err := client.IamAccessGroupsV2().AddMembersToAccessGroup(opts)
+		iamIDs := []string{}
+		for _, m := range toAdd {
+			iamIDs = append(iamIDs, reference.FromPtrValue(m.IamID))
+		}
 		err = ExtractErrorMessage(resp, err)
+		results = append(results, GenerateMemberResults(OperationAdd, iamIDs, resp, err)...)
 		if err != nil {
-			return err
+			errs = append(errs, err.Error())
 		}
 	}
 
@@ -216,14 +375,72 @@ func UpdateAccessGroupMembers(client ibmc.ClientSession, groupMembership v1alpha
 		}
 		_, resp, err := client.IamAccessGroupsV2().RemoveMembersFromAccessGroup(opts)
 		err = ExtractErrorMessage(resp, err)
+		results = append(results, GenerateMemberResults(OperationRemove, toRemove, resp, err)...)
 		if err != nil {
-			return err
+			errs = append(errs, err.Error())
 		}
 	}
 
+	groupMembership.Status.AtProvider.MemberResults = results
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
 	return nil
 }
 
+// memberResponse is the result of the addition or removal of a member, in the response of a batch addition or removal
+type memberResponse struct {
+	IamID      *string `json:"iam_id,omitempty"`
+	StatusCode *int64  `json:"status_code,omitempty"`
+	Errors     []struct {
+		Code    *string `json:"code,omitempty"`
+		Message *string `json:"message,omitempty"`
+	} `json:"errors,omitempty"`
+}
+
+// GenerateMemberResults produces the result of the given operation for each of the given members, from the response
+// of a batch addition or removal; the members missing from the response share the result of the whole batch
+func GenerateMemberResults(operation string, iamIDs []string, resp *corev4.DetailedResponse, err error) []v1alpha1.MemberResult {
+	byIamID := map[string]memberResponse{}
+	if resp != nil && resp.Result != nil {
+		body := struct {
+			Members []memberResponse `json:"members,omitempty"`
+		}{}
+		if rj, e := json.Marshal(resp.Result); e == nil && json.Unmarshal(rj, &body) == nil {
+			for _, m := range body.Members {
+				byIamID[reference.FromPtrValue(m.IamID)] = m
+			}
+		}
+	}
+
+	o := []v1alpha1.MemberResult{}
+	for _, id := range iamIDs {
+		r := v1alpha1.MemberResult{IamID: id, Operation: operation}
+		if resp != nil {
+			r.StatusCode = int64(resp.StatusCode)
+		}
+		m, ok := byIamID[id]
+		switch {
+		case ok:
+			if m.StatusCode != nil {
+				r.StatusCode = *m.StatusCode
+			}
+			msgs := []string{}
+			for _, e := range m.Errors {
+				msgs = append(msgs, fmt.Sprintf("%s: %s", reference.FromPtrValue(e.Code), reference.FromPtrValue(e.Message)))
+			}
+			if len(msgs) == 0 && r.StatusCode >= http.StatusMultipleChoices {
+				msgs = append(msgs, http.StatusText(int(r.StatusCode)))
+			}
+			r.Error = strings.Join(msgs, "; ")
+		case err != nil:
+			r.Error = err.Error()
+		}
+		o = append(o, r)
+	}
+	return o
+}
+
 // ExtractErrorMessage extracts the content of an error message from the detailed response (if any)
 // and appends it to the error returned by the SDK
 func ExtractErrorMessage(resp *corev4.DetailedResponse, err error) error { // nolint:gocyclo
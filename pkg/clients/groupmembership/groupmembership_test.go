@@ -38,6 +38,7 @@ var (
 	memberDescr2  = "member description 2"
 	memberHRef2   = "https://iam.cloud.ibm.com/v2/accessgroups/" + accessGroupID + "members/" + memberIamID2
 	memberIamID3  = "IBMid-user3"
+	memberIamID4  = "IBMid-user4"
 )
 
 func params(m ...func(*v1alpha1.GroupMembershipParameters)) *v1alpha1.GroupMembershipParameters {
@@ -297,6 +298,32 @@ func TestIsUpToDate(t *testing.T) {
 	}
 }
 
+func TestHasManagedMembers(t *testing.T) {
+	cases := map[string]struct {
+		managed []string
+		want    bool
+	}{
+		"ManagedMemberInGroup": {
+			managed: []string{memberIamID3, memberIamID1},
+			want:    true,
+		},
+		"ManagedMembersRemoved": {
+			managed: []string{memberIamID3, memberIamID4},
+			want:    false,
+		},
+		"NoManagedMembers": {
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, HasManagedMembers(instanceList(), tc.managed)); diff != "" {
+				t.Errorf("HasManagedMembers(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 var membersCache map[string]iamagv2.ListGroupMembersResponseMember
 
 // handler to mock client SDK call to iam API
@@ -432,7 +459,7 @@ func TestUpdateAccessGroupMembers(t *testing.T) {
 			}
 
 			mClient, _ := ibmc.GetTestClient(server.URL)
-			err := UpdateAccessGroupMembers(mClient, tc.args.gm)
+			err := UpdateAccessGroupMembers(mClient, &tc.args.gm)
 			if tc.want.err != nil && tc.want.err.Error() != err.Error() {
 				t.Errorf("UpdateAccessGroupMembers(...): want: %s\ngot: %s\n", tc.want.err, err)
 			}
@@ -453,3 +480,96 @@ func TestUpdateAccessGroupMembers(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateManagedAccessGroupMembers(t *testing.T) {
+	gm := v1alpha1.GroupMembership{
+		Spec: v1alpha1.GroupMembershipSpec{
+			ForProvider: *params(func(gmp *v1alpha1.GroupMembershipParameters) {
+				gmp.Exclusive = ibmc.BoolPtr(false)
+				gmp.Members = append(gmp.Members,
+					v1alpha1.AddGroupMembersRequestMembersItem{IamID: memberIamID3, Type: MemberTypeUser},
+					v1alpha1.AddGroupMembersRequestMembersItem{IamID: memberIamID4, Type: MemberTypeUser})
+			}),
+		},
+		Status: v1alpha1.GroupMembershipStatus{
+			AtProvider: *observation(),
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		if diff := cmp.Diff(http.MethodPut, r.Method); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusMultiStatus)
+		_, _ = w.Write([]byte(`{"members":[` +
+			`{"iam_id":"` + memberIamID3 + `","type":"user","status_code":200},` +
+			`{"iam_id":"` + memberIamID4 + `","status_code":404,"errors":[{"code":"not_found","message":"User not found"}]}]}`))
+	}))
+	defer server.Close()
+
+	mClient, _ := ibmc.GetTestClient(server.URL)
+	err := UpdateManagedAccessGroupMembers(mClient, &gm)
+	wantErr := `[{"code":"not_found","message":"User not found"}]`
+	if err == nil || err.Error() != wantErr {
+		t.Errorf("UpdateManagedAccessGroupMembers(...): want: %s\ngot: %v\n", wantErr, err)
+	}
+	if diff := cmp.Diff([]string{memberIamID3}, gm.Status.AtProvider.ManagedMembers); diff != "" {
+		t.Errorf("UpdateManagedAccessGroupMembers(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(`["`+memberIamID3+`"]`, gm.GetAnnotations()[AnnotationManagedMembers]); diff != "" {
+		t.Errorf("UpdateManagedAccessGroupMembers(...): -want, +got:\n%s", diff)
+	}
+	wantResults := []v1alpha1.MemberResult{
+		{IamID: memberIamID3, Operation: OperationAdd, StatusCode: http.StatusOK},
+		{IamID: memberIamID4, Operation: OperationAdd, StatusCode: http.StatusNotFound, Error: "not_found: User not found"},
+	}
+	if diff := cmp.Diff(wantResults, gm.Status.AtProvider.MemberResults); diff != "" {
+		t.Errorf("UpdateManagedAccessGroupMembers(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGetManagedMembers(t *testing.T) {
+	type want struct {
+		members []string
+		err     bool
+	}
+	cases := map[string]struct {
+		annotations map[string]string
+		status      []string
+		want        want
+	}{
+		"FromAnnotation": {
+			annotations: map[string]string{AnnotationManagedMembers: `["` + memberIamID1 + `"]`},
+			status:      []string{memberIamID2},
+			want:        want{members: []string{memberIamID1}},
+		},
+		"NoneInAnnotation": {
+			annotations: map[string]string{AnnotationManagedMembers: `[]`},
+			status:      []string{memberIamID2},
+		},
+		"FromStatusWithoutAnnotation": {
+			status: []string{memberIamID2},
+			want:   want{members: []string{memberIamID2}},
+		},
+		"InvalidAnnotation": {
+			annotations: map[string]string{AnnotationManagedMembers: `{`},
+			want:        want{err: true},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			gm := &v1alpha1.GroupMembership{}
+			gm.SetAnnotations(tc.annotations)
+			gm.Status.AtProvider.ManagedMembers = tc.status
+			got, err := GetManagedMembers(gm)
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("GetManagedMembers(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.members, got); diff != "" {
+				t.Errorf("GetManagedMembers(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -40,6 +40,7 @@ import (
 
 	bluemix "github.com/IBM-Cloud/bluemix-go"
 	ibmContainerV2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	bluemixHTTP "github.com/IBM-Cloud/bluemix-go/http"
 	bluemixSession "github.com/IBM-Cloud/bluemix-go/session"
 	cv1 "github.com/IBM/cloudant-go-sdk/cloudantv1"
 	arv1 "github.com/IBM/eventstreams-go-sdk/pkg/adminrestv1"
@@ -55,12 +56,15 @@ import (
 	gcat "github.com/IBM/platform-services-go-sdk/globalcatalogv1"
 	gtagv1 "github.com/IBM/platform-services-go-sdk/globaltaggingv1"
 	iamagv2 "github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	iamidv1 "github.com/IBM/platform-services-go-sdk/iamidentityv1"
 	iampmv1 "github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
 	rcv2 "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 	rmgrv2 "github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+	umv1 "github.com/IBM/platform-services-go-sdk/usermanagementv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 
 	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
+	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/cbr"
 )
 
 const (
@@ -131,6 +135,12 @@ type ClientOptions struct {
 	// Note that it should always be of the format 'Bearer <...>'
 	RefreshToken  string // not used every time....
 	Authenticator core.Authenticator
+
+	// ProviderConfig is the spec of the ProviderConfig used by the managed resource, if any
+	ProviderConfig *v1beta1.ProviderConfigSpec
+
+	// AuditTrail records the mutating calls of the clients, if set
+	AuditTrail *AuditTrail
 }
 
 // GetAuthInfo returns the necessary authentication information that is necessary
@@ -162,8 +172,10 @@ func GetAuthInfo(ctx context.Context, c client.Client, mg resource.Managed) (opt
 	}
 
 	result := ClientOptions{Authenticator: authenticator,
-		BearerToken:  *bearerTok,
-		RefreshToken: string(s.Data[RefreshTokenKey]), // Refresh key required - no point in setting it optionally
+		BearerToken:    *bearerTok,
+		RefreshToken:   string(s.Data[RefreshTokenKey]), // Refresh key required - no point in setting it optionally
+		ProviderConfig: pc.Spec.DeepCopy(),
+		AuditTrail:     AuditTrailFrom(ctx),
 	}
 
 	return result, nil
@@ -201,6 +213,13 @@ func NewClient(opts ClientOptions) (ClientSession, error) { // nolint:gocyclo
 	var err error
 	cs := clientSessionImpl{}
 
+	cs.accountID = GetAccountIDFromToken(opts.BearerToken)
+	cs.lookupCache = getLookupCache(cs.accountID, opts.URL)
+	cs.providerConfig = opts.ProviderConfig
+	if cs.providerConfig == nil {
+		cs.providerConfig = &v1beta1.ProviderConfigSpec{}
+	}
+
 	rcv2Opts := &rcv2.ResourceControllerV2Options{
 		ServiceName:   opts.ServiceName,
 		Authenticator: opts.Authenticator,
@@ -274,6 +293,34 @@ func NewClient(opts ClientOptions) (ClientSession, error) { // nolint:gocyclo
 		return nil, errors.Wrap(err, errInitClient)
 	}
 
+	iamidOpts := &iamidv1.IamIdentityV1Options{
+		ServiceName:   opts.ServiceName,
+		Authenticator: opts.Authenticator,
+		URL:           opts.URL,
+	}
+	cs.iamIdentityV1, err = iamidv1.NewIamIdentityV1(iamidOpts)
+	if err != nil {
+		return nil, errors.Wrap(err, errInitClient)
+	}
+
+	umOpts := &umv1.UserManagementV1Options{
+		ServiceName:   opts.ServiceName,
+		Authenticator: opts.Authenticator,
+		URL:           opts.URL,
+	}
+	cs.userManagementV1, err = umv1.NewUserManagementV1(umOpts)
+	if err != nil {
+		return nil, errors.Wrap(err, errInitClient)
+	}
+
+	cs.contextBasedRestrictionsV1, err = cbr.NewContextBasedRestrictionsV1(&cbr.ContextBasedRestrictionsV1Options{
+		Authenticator: opts.Authenticator,
+		URL:           opts.URL,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, errInitClient)
+	}
+
 	arv1Opts := &arv1.AdminrestV1Options{
 		ServiceName:   opts.ServiceName,
 		Authenticator: opts.Authenticator,
@@ -317,6 +364,10 @@ func NewClient(opts ClientOptions) (ClientSession, error) { // nolint:gocyclo
 				s3AuthTokenFunc, serviceEndPoint, opts.URL)).
 			WithS3ForcePathStyle(true)
 
+		if opts.AuditTrail != nil {
+			s3Conf = s3Conf.WithHTTPClient(opts.AuditTrail.Client(nil))
+		}
+
 		s3Session := session.Must(session.NewSession())
 		cs.s3client = s3.New(s3Session, s3Conf)
 	}
@@ -333,7 +384,7 @@ func NewClient(opts ClientOptions) (ClientSession, error) { // nolint:gocyclo
 
 	cs.bucketConfigClient = ibmBucketConfigClientConf
 
-	cs.clustersClientV2, err = generateClustersClientV2(opts.URL, opts.BearerToken, opts.RefreshToken)
+	cs.clustersClientV2, err = generateClustersClientV2(opts.URL, opts.BearerToken, opts.RefreshToken, opts.AuditTrail)
 	if err != nil {
 		return nil, errors.Wrap(err, errInitClient)
 	}
@@ -346,6 +397,22 @@ func NewClient(opts ClientOptions) (ClientSession, error) { // nolint:gocyclo
 		log.Fatal("Error creating VPC Client")
 	}
 
+	if t := opts.AuditTrail; t != nil {
+		cs.resourceControllerV2.Service.SetHTTPClient(t.Client(cs.resourceControllerV2.Service.Client))
+		cs.resourceManagerV2.Service.SetHTTPClient(t.Client(cs.resourceManagerV2.Service.Client))
+		cs.globalTaggingV1.Service.SetHTTPClient(t.Client(cs.globalTaggingV1.Service.Client))
+		cs.ibmCloudDatabasesV5.Service.SetHTTPClient(t.Client(cs.ibmCloudDatabasesV5.Service.Client))
+		cs.iamPolicyManagementV1.Service.SetHTTPClient(t.Client(cs.iamPolicyManagementV1.Service.Client))
+		cs.iamAccessGroupsV2.Service.SetHTTPClient(t.Client(cs.iamAccessGroupsV2.Service.Client))
+		cs.iamIdentityV1.Service.SetHTTPClient(t.Client(cs.iamIdentityV1.Service.Client))
+		cs.userManagementV1.Service.SetHTTPClient(t.Client(cs.userManagementV1.Service.Client))
+		cs.contextBasedRestrictionsV1.Service.SetHTTPClient(t.Client(cs.contextBasedRestrictionsV1.Service.Client))
+		cs.adminrestV1.Service.SetHTTPClient(t.Client(cs.adminrestV1.Service.Client))
+		cs.cloudantV1.Service.SetHTTPClient(t.Client(cs.cloudantV1.Service.Client))
+		cs.bucketConfigClient.Service.SetHTTPClient(t.Client(cs.bucketConfigClient.Service.Client))
+		cs.vpcClient.Service.SetHTTPClient(t.Client(cs.vpcClient.Service.Client))
+	}
+
 	return &cs, err
 }
 
@@ -354,11 +421,12 @@ func NewClient(opts ClientOptions) (ClientSession, error) { // nolint:gocyclo
 //	     url - the server url
 //			bearerToken - the IAM access token
 //	     refreshToken - sent from the server
+//	     trail - records the mutating calls of the client, if not nil
 //
 // Returns
 //
 //	a client which has established a connection with the server
-func generateClustersClientV2(url string, bearerToken string, refreshToken string) (ibmContainerV2.Clusters, error) {
+func generateClustersClientV2(url string, bearerToken string, refreshToken string, trail *AuditTrail) (ibmContainerV2.Clusters, error) {
 	blueMixConf := new(bluemix.Config)
 	if url != "" {
 		blueMixConf.Endpoint = &url
@@ -371,6 +439,9 @@ func generateClustersClientV2(url string, bearerToken string, refreshToken strin
 	if err != nil {
 		return nil, err
 	}
+	if trail != nil {
+		sess.Config.HTTPClient = trail.Client(bluemixHTTP.NewHTTPClient(sess.Config))
+	}
 
 	clusterClient, err := ibmContainerV2.New(sess)
 	if err != nil {
@@ -389,34 +460,58 @@ type ClientSession interface {
 	IbmCloudDatabasesV5() *icdv5.IbmCloudDatabasesV5
 	IamPolicyManagementV1() *iampmv1.IamPolicyManagementV1
 	IamAccessGroupsV2() *iamagv2.IamAccessGroupsV2
+	IamIdentityV1() *iamidv1.IamIdentityV1
+	UserManagementV1() *umv1.UserManagementV1
+	ContextBasedRestrictionsV1() *cbr.ContextBasedRestrictionsV1
 	AdminrestV1() *arv1.AdminrestV1
 	CloudantV1() *cv1.CloudantV1
 	S3Client() *s3.S3
 	BucketConfigClient() *ibmBucketConfig.ResourceConfigurationV1
 	ClusterClientV2() ibmContainerV2.Clusters
 	VPCClient() *vpcv1.VpcV1
+	AccountID() string
+	LookupCache() *LookupCache
+	ProviderConfig() *v1beta1.ProviderConfigSpec
 }
 
 type clientSessionImpl struct {
-	resourceControllerV2  *rcv2.ResourceControllerV2
-	globalCatalogV1       *gcat.GlobalCatalogV1
-	resourceManagerV2     *rmgrv2.ResourceManagerV2
-	globalTaggingV1       *gtagv1.GlobalTaggingV1
-	ibmCloudDatabasesV5   *icdv5.IbmCloudDatabasesV5
-	iamPolicyManagementV1 *iampmv1.IamPolicyManagementV1
-	iamAccessGroupsV2     *iamagv2.IamAccessGroupsV2
-	adminrestV1           *arv1.AdminrestV1
-	cloudantV1            *cv1.CloudantV1
-	s3client              *s3.S3
-	bucketConfigClient    *ibmBucketConfig.ResourceConfigurationV1
-	clustersClientV2      ibmContainerV2.Clusters
-	vpcClient             *vpcv1.VpcV1
+	resourceControllerV2       *rcv2.ResourceControllerV2
+	globalCatalogV1            *gcat.GlobalCatalogV1
+	resourceManagerV2          *rmgrv2.ResourceManagerV2
+	globalTaggingV1            *gtagv1.GlobalTaggingV1
+	ibmCloudDatabasesV5        *icdv5.IbmCloudDatabasesV5
+	iamPolicyManagementV1      *iampmv1.IamPolicyManagementV1
+	iamAccessGroupsV2          *iamagv2.IamAccessGroupsV2
+	iamIdentityV1              *iamidv1.IamIdentityV1
+	userManagementV1           *umv1.UserManagementV1
+	contextBasedRestrictionsV1 *cbr.ContextBasedRestrictionsV1
+	adminrestV1                *arv1.AdminrestV1
+	cloudantV1                 *cv1.CloudantV1
+	s3client                   *s3.S3
+	bucketConfigClient         *ibmBucketConfig.ResourceConfigurationV1
+	clustersClientV2           ibmContainerV2.Clusters
+	vpcClient                  *vpcv1.VpcV1
+	accountID                  string
+	lookupCache                *LookupCache
+	providerConfig             *v1beta1.ProviderConfigSpec
 }
 
 func (c *clientSessionImpl) VPCClient() *vpcv1.VpcV1 {
 	return c.vpcClient
 }
 
+func (c *clientSessionImpl) AccountID() string {
+	return c.accountID
+}
+
+func (c *clientSessionImpl) LookupCache() *LookupCache {
+	return c.lookupCache
+}
+
+func (c *clientSessionImpl) ProviderConfig() *v1beta1.ProviderConfigSpec {
+	return c.providerConfig
+}
+
 func (c *clientSessionImpl) ClusterClientV2() ibmContainerV2.Clusters {
 	return c.clustersClientV2
 }
@@ -449,6 +544,18 @@ func (c *clientSessionImpl) IamAccessGroupsV2() *iamagv2.IamAccessGroupsV2 {
 	return c.iamAccessGroupsV2
 }
 
+func (c *clientSessionImpl) IamIdentityV1() *iamidv1.IamIdentityV1 {
+	return c.iamIdentityV1
+}
+
+func (c *clientSessionImpl) UserManagementV1() *umv1.UserManagementV1 {
+	return c.userManagementV1
+}
+
+func (c *clientSessionImpl) ContextBasedRestrictionsV1() *cbr.ContextBasedRestrictionsV1 {
+	return c.contextBasedRestrictionsV1
+}
+
 func (c *clientSessionImpl) AdminrestV1() *arv1.AdminrestV1 {
 	return c.adminrestV1
 }
@@ -37,37 +37,85 @@ const (
 	errRGIDNotFound          = "could not find resource group id"
 	errRGNameNotFound        = "could not find resource group name"
 	errGetTags               = "could not get tags"
+	errCreateTags            = "could not create tags"
+	errAttachTags            = "could not attach tags"
+	errDetachTags            = "could not detach tags"
+)
+
+const (
+	// TagTypeUser is the type of user tags
+	TagTypeUser = gtagv1.AttachTagOptionsTagTypeUserConst
+	// TagTypeAccess is the type of access tags, used to control access with IAM policies
+	TagTypeAccess = gtagv1.AttachTagOptionsTagTypeAccessConst
 )
 
 // GetResourcePlanID gets a resource plan ID from a service name and resource plan name for a given service
 func GetResourcePlanID(client ClientSession, serviceName, planName string) (*string, error) {
-	planEntry, err := getPlanEntries(client, serviceName)
+	id, err := findPlan(client, serviceName, func(p gcat.CatalogEntry) (*string, bool) {
+		return p.ID, reference.FromPtrValue(p.Name) == planName
+	})
 	if err != nil {
-		return nil, errors.Wrap(err, errListPlanCatEntries)
+		return nil, err
 	}
-
-	for _, p := range planEntry.Resources {
-		if *p.Name == planName {
-			return p.ID, nil
-		}
+	if id == nil {
+		return nil, errors.New(errPlanIDNotFound)
 	}
-
-	return nil, errors.New(errPlanIDNotFound)
+	return id, nil
 }
 
 // GetResourcePlanName gets a resource plan ID from a service name and resource plan name for a given service
 func GetResourcePlanName(client ClientSession, serviceName, planID string) (*string, error) {
-	planEntry, err := getPlanEntries(client, serviceName)
+	name, err := findPlan(client, serviceName, func(p gcat.CatalogEntry) (*string, bool) {
+		return p.Name, reference.FromPtrValue(p.ID) == planID
+	})
 	if err != nil {
-		return nil, errors.Wrap(err, errListPlanCatEntries)
+		return nil, err
 	}
+	if name == nil {
+		return nil, errors.New(errPlanNameNotFound)
+	}
+	return name, nil
+}
 
-	for _, p := range planEntry.Resources {
-		if *p.ID == planID {
-			return p.Name, nil
+// findPlan looks for a plan of the given service in the (cached) plan entries. If the plan is not
+// in the cached entries, they are fetched again once, in case the catalog has changed since.
+func findPlan(client ClientSession, serviceName string, match func(gcat.CatalogEntry) (*string, bool)) (*string, error) {
+	for _, refresh := range []bool{false, true} {
+		planEntry, cached, err := getCachedPlanEntries(client, serviceName, refresh)
+		if err != nil {
+			return nil, errors.Wrap(err, errListPlanCatEntries)
+		}
+
+		for _, p := range planEntry.Resources {
+			if v, ok := match(p); ok {
+				return v, nil
+			}
+		}
+		if !cached {
+			break
 		}
 	}
-	return nil, errors.New(errPlanNameNotFound)
+	return nil, nil
+}
+
+// getCachedPlanEntries returns the plan entries of a service, from the lookup cache of the client if they
+// are there (and refresh is false). The second value returned is true if the entries came from the cache.
+func getCachedPlanEntries(client ClientSession, serviceName string, refresh bool) (*gcat.EntrySearchResult, bool, error) {
+	cache := client.LookupCache()
+	if cache != nil && !refresh {
+		if v, ok := cache.Get(lookupKindPlans, serviceName); ok {
+			return v.(*gcat.EntrySearchResult), true, nil
+		}
+	}
+
+	planEntry, err := getPlanEntries(client, serviceName)
+	if err != nil {
+		return nil, false, err
+	}
+	if cache != nil {
+		cache.Set(lookupKindPlans, serviceName, planEntry)
+	}
+	return planEntry, false, nil
 }
 
 func getPlanEntries(client ClientSession, serviceName string) (*gcat.EntrySearchResult, error) {
@@ -105,55 +153,103 @@ func getPlanEntries(client ClientSession, serviceName string) (*gcat.EntrySearch
 
 // GetResourceGroupID gets a resource group ID from a resource group name or default
 func GetResourceGroupID(client ClientSession, rgName *string) (*string, error) {
-	opts := &rmgrv2.ListResourceGroupsOptions{}
+	for _, refresh := range []bool{false, true} {
+		// If the rgName is nil, then we want to return the ID of the default resource group
+		groups, cached, err := getCachedResourceGroups(client, rgName == nil, refresh)
+		if err != nil {
+			return nil, errors.Wrap(err, errListRG)
+		}
 
-	// If the rgName is nil, then we want to return the ID of the default resource group
-	if rgName == nil {
-		opts.Default = BoolPtr(true)
+		if rgName == nil {
+			if len(groups) > 0 {
+				return groups[0].ID, nil
+			}
+		} else {
+			for _, rg := range groups {
+				if *rg.Name == *rgName {
+					return rg.ID, nil
+				}
+			}
+		}
+		if !cached {
+			break
+		}
 	}
 
-	entries, _, err := client.ResourceManagerV2().ListResourceGroups(opts)
-	if err != nil {
-		return nil, errors.Wrap(err, errListRG)
-	}
+	return nil, errors.New(errRGIDNotFound)
+}
 
-	if rgName == nil {
-		if len(entries.Resources) > 0 {
-			return entries.Resources[0].ID, nil
+// GetResourceGroupName gets a resource group name from a resource group ID
+func GetResourceGroupName(client ClientSession, rgID string) (string, error) {
+	for _, refresh := range []bool{false, true} {
+		groups, cached, err := getCachedResourceGroups(client, false, refresh)
+		if err != nil {
+			return "", errors.Wrap(err, errListRG)
 		}
-	} else {
-		for _, rg := range entries.Resources {
-			if *rg.Name == *rgName {
-				return rg.ID, nil
+
+		for _, rg := range groups {
+			if *rg.ID == rgID {
+				return reference.FromPtrValue(rg.Name), nil
 			}
 		}
+		if !cached {
+			break
+		}
 	}
 
-	return nil, errors.New(errRGIDNotFound)
+	return "", errors.New(errRGNameNotFound)
 }
 
-// GetResourceGroupName gets a resource group name from a resource group ID
-func GetResourceGroupName(client ClientSession, rgID string) (string, error) {
+// getCachedResourceGroups returns the resource groups of the account (or only the default one), from the lookup cache
+// of the client if they are there (and refresh is false). The second value returned is true if they came from the cache.
+func getCachedResourceGroups(client ClientSession, onlyDefault bool, refresh bool) ([]rmgrv2.ResourceGroup, bool, error) {
+	key := "all"
 	opts := &rmgrv2.ListResourceGroupsOptions{}
+	if onlyDefault {
+		key = "default"
+		opts.Default = BoolPtr(true)
+	}
+
+	cache := client.LookupCache()
+	if cache != nil && !refresh {
+		if v, ok := cache.Get(lookupKindResourceGroups, key); ok {
+			return v.([]rmgrv2.ResourceGroup), true, nil
+		}
+	}
 
 	entries, _, err := client.ResourceManagerV2().ListResourceGroups(opts)
 	if err != nil {
-		return "", errors.Wrap(err, errListRG)
+		return nil, false, err
 	}
-
-	for _, rg := range entries.Resources {
-		if *rg.ID == rgID {
-			return reference.FromPtrValue(rg.Name), nil
-		}
+	if cache != nil {
+		cache.Set(lookupKindResourceGroups, key, entries.Resources)
 	}
+	return entries.Resources, false, nil
+}
 
-	return "", errors.New(errRGNameNotFound)
+// InvalidateResourceGroupsCache removes the cached resource groups of the account of the client, so that
+// changes (i.e. a new resource group) are visible to the next lookup.
+func InvalidateResourceGroupsCache(client ClientSession) {
+	if cache := client.LookupCache(); cache != nil {
+		cache.Invalidate(lookupKindResourceGroups)
+	}
 }
 
 // GetResourceInstanceTags gets tags for a resource instance
 func GetResourceInstanceTags(client ClientSession, crn string) ([]string, error) {
+	return GetTags(client, crn, TagTypeUser)
+}
+
+// UpdateResourceInstanceTags update tags for the instance as needed
+func UpdateResourceInstanceTags(client ClientSession, crn string, tags []string) error {
+	return UpdateTags(client, crn, TagTypeUser, tags)
+}
+
+// GetTags gets the tags of the given type attached to the resource identified by crn
+func GetTags(client ClientSession, crn string, tagType string) ([]string, error) {
 	listTagsOpts := &gtagv1.ListTagsOptions{
 		AttachedTo: &crn,
+		TagType:    &tagType,
 	}
 	entries, _, err := client.GlobalTaggingV1().ListTags(listTagsOpts)
 	if err != nil {
@@ -172,22 +268,33 @@ func GetResourceInstanceTags(client ClientSession, crn string) ([]string, error)
 	return tags, nil
 }
 
-// UpdateResourceInstanceTags update tags for the instance as needed
-func UpdateResourceInstanceTags(client ClientSession, crn string, tags []string) error {
-	actualTags, err := GetResourceInstanceTags(client, crn)
+// UpdateTags attaches and detaches tags of the given type so that the tags attached to the
+// resource identified by crn match the desired ones. Access tags are created before being attached.
+func UpdateTags(client ClientSession, crn string, tagType string, tags []string) error {
+	actualTags, err := GetTags(client, crn, tagType)
 	if err != nil {
 		return err
 	}
 	toAttach, toDetach := TagsDiff(tags, actualTags)
 
 	if len(toAttach) > 0 {
+		if tagType == TagTypeAccess {
+			createTagOpts := &gtagv1.CreateTagOptions{
+				TagNames: toAttach,
+				TagType:  &tagType,
+			}
+			if _, _, err = client.GlobalTaggingV1().CreateTag(createTagOpts); err != nil {
+				return errors.Wrap(err, errCreateTags)
+			}
+		}
 		attachTagsOpts := &gtagv1.AttachTagOptions{
 			TagNames:  toAttach,
 			Resources: []gtagv1.Resource{{ResourceID: &crn}},
+			TagType:   &tagType,
 		}
 		_, _, err = client.GlobalTaggingV1().AttachTag(attachTagsOpts)
 		if err != nil {
-			return err
+			return errors.Wrap(err, errAttachTags)
 		}
 	}
 
@@ -195,16 +302,52 @@ func UpdateResourceInstanceTags(client ClientSession, crn string, tags []string)
 		detachTagsOpts := &gtagv1.DetachTagOptions{
 			TagNames:  toDetach,
 			Resources: []gtagv1.Resource{{ResourceID: &crn}},
+			TagType:   &tagType,
 		}
 		_, _, err = client.GlobalTaggingV1().DetachTag(detachTagsOpts)
 		if err != nil {
-			return err
+			return errors.Wrap(err, errDetachTags)
 		}
 	}
 
 	return nil
 }
 
+// TagsUpToDate returns true if the user and access tags attached to the resource identified by crn
+// match the desired ones. A nil list of desired tags means that tags of that type are not managed.
+func TagsUpToDate(client ClientSession, crn string, tags, accessTags []string) (bool, error) {
+	for tagType, desired := range map[string][]string{TagTypeUser: tags, TagTypeAccess: accessTags} {
+		if desired == nil {
+			continue
+		}
+		actual, err := GetTags(client, crn, tagType)
+		if err != nil {
+			return false, err
+		}
+		toAttach, toDetach := TagsDiff(desired, actual)
+		if len(toAttach) > 0 || len(toDetach) > 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// UpdateManagedTags reconciles the user and access tags attached to the resource identified by crn.
+// A nil list of desired tags means that tags of that type are not managed.
+func UpdateManagedTags(client ClientSession, crn string, tags, accessTags []string) error {
+	if tags != nil {
+		if err := UpdateTags(client, crn, TagTypeUser, tags); err != nil {
+			return err
+		}
+	}
+	if accessTags != nil {
+		if err := UpdateTags(client, crn, TagTypeAccess, accessTags); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // GetServiceName gets ServiceName from Crn
 func GetServiceName(in *rcv2.ResourceInstance) string {
 	if in.CRN == nil {
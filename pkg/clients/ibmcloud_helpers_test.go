@@ -460,3 +460,165 @@ func TestUpdateResourceInstanceTags(t *testing.T) {
 		})
 	}
 }
+
+// typedTagsStore mocks the global tags API, keeping attached tags by tag type. Like the actual API,
+// access tags must be created before they can be attached.
+type typedTagsStore struct {
+	attached   map[string]map[string]bool
+	accessTags map[string]bool
+}
+
+func newTypedTagsStore(user, access []string) *typedTagsStore {
+	s := &typedTagsStore{
+		attached:   map[string]map[string]bool{TagTypeUser: {}, TagTypeAccess: {}},
+		accessTags: map[string]bool{},
+	}
+	for _, t := range user {
+		s.attached[TagTypeUser][t] = true
+	}
+	for _, t := range access {
+		s.attached[TagTypeAccess][t] = true
+		s.accessTags[t] = true
+	}
+	return s
+}
+
+func (s *typedTagsStore) handler(w http.ResponseWriter, r *http.Request) {
+	tagType := TagTypeUser
+	if tt := r.URL.Query().Get("tag_type"); tt != "" {
+		tagType = tt
+	}
+	if r.Method == http.MethodPost {
+		body, _ := ioutil.ReadAll(r.Body)
+		m := map[string]interface{}{}
+		_ = json.Unmarshal(body, &m)
+		w.Header().Set("Content-Type", "application/json")
+		for _, t := range m["tag_names"].([]interface{}) {
+			name := t.(string)
+			switch {
+			case strings.Contains(r.URL.Path, "attach"):
+				if tagType == TagTypeAccess && !s.accessTags[name] {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				s.attached[tagType][name] = true
+			case strings.Contains(r.URL.Path, "detach"):
+				delete(s.attached[tagType], name)
+			default:
+				s.accessTags[name] = true
+			}
+		}
+		_, _ = w.Write([]byte("{}"))
+		return
+	}
+	_ = r.Body.Close()
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(gtagv1.TagList{Items: map2tags(s.attached[tagType])})
+}
+
+func TestTagsUpToDate(t *testing.T) {
+	type args struct {
+		tags       []string
+		accessTags []string
+	}
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"NotManaged": {
+			args: args{},
+			want: true,
+		},
+		"UpToDate": {
+			args: args{tags: []string{"dev"}, accessTags: []string{"env:dev"}},
+			want: true,
+		},
+		"UserTagsDiffer": {
+			args: args{tags: []string{"dev", "test"}},
+			want: false,
+		},
+		"AccessTagsDiffer": {
+			args: args{accessTags: []string{}},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			store := newTypedTagsStore([]string{"dev"}, []string{"env:dev"})
+			mux := http.NewServeMux()
+			mux.HandleFunc("/v3/tags/", store.handler)
+			mux.HandleFunc("/v3/tags", store.handler)
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			mClient, _ := GetTestClient(server.URL)
+
+			upToDate, err := TagsUpToDate(mClient, testCrn, tc.args.tags, tc.args.accessTags)
+			if err != nil {
+				t.Errorf("TagsUpToDate(...): unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tc.want, upToDate); diff != "" {
+				t.Errorf("TagsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateManagedTags(t *testing.T) {
+	type args struct {
+		tags       []string
+		accessTags []string
+	}
+	type want struct {
+		tags       map[string]bool
+		accessTags map[string]bool
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotManaged": {
+			args: args{},
+			want: want{
+				tags:       map[string]bool{"dev": true},
+				accessTags: map[string]bool{"env:dev": true},
+			},
+		},
+		"AttachAccessTags": {
+			args: args{accessTags: []string{"env:dev", "team:a"}},
+			want: want{
+				tags:       map[string]bool{"dev": true},
+				accessTags: map[string]bool{"env:dev": true, "team:a": true},
+			},
+		},
+		"ReplaceBoth": {
+			args: args{tags: []string{"prod"}, accessTags: []string{"env:prod"}},
+			want: want{
+				tags:       map[string]bool{"prod": true},
+				accessTags: map[string]bool{"env:prod": true},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			store := newTypedTagsStore([]string{"dev"}, []string{"env:dev"})
+			mux := http.NewServeMux()
+			mux.HandleFunc("/v3/tags/", store.handler)
+			mux.HandleFunc("/v3/tags", store.handler)
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			mClient, _ := GetTestClient(server.URL)
+
+			if err := UpdateManagedTags(mClient, testCrn, tc.args.tags, tc.args.accessTags); err != nil {
+				t.Errorf("UpdateManagedTags(...): unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tc.want.tags, store.attached[TagTypeUser]); diff != "" {
+				t.Errorf("UpdateManagedTags(...) user tags: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.accessTags, store.attached[TagTypeAccess]); diff != "" {
+				t.Errorf("UpdateManagedTags(...) access tags: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
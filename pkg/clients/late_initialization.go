@@ -0,0 +1,119 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
+)
+
+const (
+	// AnnotationLateInitialization is the annotation holding the late-initialization policy of a managed resource:
+	// `false` turns late-initialization off, a comma separated list of paths within spec.forProvider (e.g.
+	// `resourceGroupId,tags`) restricts it to those fields, and `true` (the default) late-initializes all the fields.
+	// The fields that are not late-initialized are still observed, in status.atProvider.
+	AnnotationLateInitialization = "ibmcloud.crossplane.io/late-initialization"
+
+	errRestrictLateInit = "cannot apply the " + AnnotationLateInitialization + " annotation"
+)
+
+// LateInitializedFields returns the paths of the fields that the late-initialization policy of the given object (see
+// AnnotationLateInitialization) allows to late-initialize. A nil slice stands for all the fields, an empty one for none.
+func LateInitializedFields(o metav1.Object) []string {
+	v, ok := o.GetAnnotations()[AnnotationLateInitialization]
+	if !ok || strings.TrimSpace(v) == "" {
+		return nil
+	}
+	if b, err := strconv.ParseBool(v); err == nil {
+		if b {
+			return nil
+		}
+		return []string{}
+	}
+	fields := []string{}
+	for _, f := range strings.Split(v, ",") {
+		if f = strings.TrimSpace(f); f != "" {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// RestrictLateInitialization reverts the fields of the given late-initialized parameters that the late-initialization
+// policy of the given object does not allow to late-initialize. current holds the parameters before late-initialization,
+// and both must be pointers to structs of the same type.
+func RestrictLateInitialization(o metav1.Object, current, params interface{}) error {
+	fields := LateInitializedFields(o)
+	if fields == nil || reflect.DeepEqual(current, params) {
+		return nil
+	}
+
+	out := reflect.ValueOf(params).Elem()
+	restricted, err := withFields(current, params, fields)
+	if err != nil {
+		return errors.Wrap(err, errRestrictLateInit)
+	}
+	if restricted == nil {
+		out.Set(reflect.ValueOf(current).Elem())
+		return nil
+	}
+	out.Set(reflect.Zero(out.Type()))
+	return errors.Wrap(runtime.DefaultUnstructuredConverter.FromUnstructured(restricted, params), errRestrictLateInit)
+}
+
+// withFields returns the current parameters, with the given fields copied from the late-initialized ones, and nil if
+// none of these fields was late-initialized
+func withFields(current, params interface{}, fields []string) (map[string]interface{}, error) {
+	c, err := runtime.DefaultUnstructuredConverter.ToUnstructured(current)
+	if err != nil {
+		return nil, err
+	}
+	p, err := runtime.DefaultUnstructuredConverter.ToUnstructured(params)
+	if err != nil {
+		return nil, err
+	}
+
+	from, to := fieldpath.Pave(p), fieldpath.Pave(c)
+	changed := false
+	for _, f := range fields {
+		v, err := from.GetValue(f)
+		if fieldpath.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if cv, err := to.GetValue(f); err == nil && reflect.DeepEqual(cv, v) {
+			continue
+		}
+		if err := to.SetValue(f, v); err != nil {
+			return nil, err
+		}
+		changed = true
+	}
+	if !changed {
+		return nil, nil
+	}
+	return c, nil
+}
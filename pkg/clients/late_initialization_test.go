@@ -0,0 +1,79 @@
+package clients
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+)
+
+type lateInitPlan struct {
+	Name string `json:"name,omitempty"`
+}
+
+type lateInitParams struct {
+	Name            string        `json:"name"`
+	ResourceGroupID *string       `json:"resourceGroupId,omitempty"`
+	Tags            []string      `json:"tags,omitempty"`
+	Plan            *lateInitPlan `json:"plan,omitempty"`
+}
+
+func lateInitManaged(policy *string) *fake.Managed {
+	mg := &fake.Managed{}
+	if policy != nil {
+		mg.SetAnnotations(map[string]string{AnnotationLateInitialization: *policy})
+	}
+	return mg
+}
+
+func TestRestrictLateInitialization(t *testing.T) {
+	current := lateInitParams{Name: "instance", Tags: []string{"dev"}}
+	lateInitialized := lateInitParams{
+		Name:            "instance",
+		ResourceGroupID: reference.ToPtrValue("rg-id"),
+		Tags:            []string{"dev"},
+		Plan:            &lateInitPlan{Name: "standard"},
+	}
+
+	cases := map[string]struct {
+		policy *string
+		want   lateInitParams
+	}{
+		"NoPolicy": {
+			want: lateInitialized,
+		},
+		"Enabled": {
+			policy: reference.ToPtrValue("true"),
+			want:   lateInitialized,
+		},
+		"Disabled": {
+			policy: reference.ToPtrValue("false"),
+			want:   current,
+		},
+		"Fields": {
+			policy: reference.ToPtrValue("resourceGroupId, tags"),
+			want:   lateInitParams{Name: "instance", ResourceGroupID: reference.ToPtrValue("rg-id"), Tags: []string{"dev"}},
+		},
+		"NestedField": {
+			policy: reference.ToPtrValue("plan.name"),
+			want:   lateInitParams{Name: "instance", Tags: []string{"dev"}, Plan: &lateInitPlan{Name: "standard"}},
+		},
+		"NotLateInitializedField": {
+			policy: reference.ToPtrValue("location"),
+			want:   current,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c, params := current, lateInitialized
+			if err := RestrictLateInitialization(lateInitManaged(tc.policy), &c, &params); err != nil {
+				t.Fatalf("RestrictLateInitialization(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.want, params); diff != "" {
+				t.Errorf("RestrictLateInitialization(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
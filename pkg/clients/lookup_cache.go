@@ -0,0 +1,179 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultLookupCacheTTL is the default time-to-live of the entries in the lookup cache
+	DefaultLookupCacheTTL = 15 * time.Minute
+
+	lookupKindPlans          = "plans"
+	lookupKindPricing        = "pricing"
+	lookupKindResourceGroups = "resource-groups"
+)
+
+// LookupCache caches the results of the Global Catalog and Resource Manager list calls
+// used to translate between names and IDs (plans and resource groups), as well as the
+// pricing of plans, so that they are not repeated on every reconcile. A cache is scoped to a single account.
+type LookupCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	now     func() time.Time
+	entries map[string]lookupCacheEntry
+}
+
+type lookupCacheEntry struct {
+	value   interface{}
+	expires time.Time
+}
+
+// NewLookupCache returns a lookup cache whose entries expire after the given ttl. A ttl of
+// zero (or less) disables caching.
+func NewLookupCache(ttl time.Duration) *LookupCache {
+	return &LookupCache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: map[string]lookupCacheEntry{},
+	}
+}
+
+// Get returns the cached value for the given kind and key, if it is there and has not expired
+func (c *LookupCache) Get(kind, key string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[kind+"/"+key]
+	if !ok {
+		return nil, false
+	}
+	if c.now().After(e.expires) {
+		delete(c.entries, kind+"/"+key)
+		return nil, false
+	}
+	return e.value, true
+}
+
+// Set stores a value for the given kind and key
+func (c *LookupCache) Set(kind, key string, value interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.ttl <= 0 {
+		return
+	}
+	c.entries[kind+"/"+key] = lookupCacheEntry{value: value, expires: c.now().Add(c.ttl)}
+}
+
+// Invalidate removes all the entries of the given kind
+func (c *LookupCache) Invalidate(kind string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for k := range c.entries {
+		if strings.HasPrefix(k, kind+"/") {
+			delete(c.entries, k)
+		}
+	}
+}
+
+// InvalidateAll removes all the entries of the cache
+func (c *LookupCache) InvalidateAll() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = map[string]lookupCacheEntry{}
+}
+
+// lookupCaches holds one cache per account (and API endpoint), shared by all the client sessions
+// created for that account, so that the cached lookups survive across reconciles.
+var lookupCaches = struct {
+	sync.Mutex
+	ttl    time.Duration
+	caches map[string]*LookupCache
+}{
+	ttl:    DefaultLookupCacheTTL,
+	caches: map[string]*LookupCache{},
+}
+
+// SetLookupCacheTTL sets the time-to-live of the lookup caches. Existing caches are dropped. A ttl
+// of zero disables caching.
+func SetLookupCacheTTL(ttl time.Duration) {
+	lookupCaches.Lock()
+	defer lookupCaches.Unlock()
+
+	lookupCaches.ttl = ttl
+	lookupCaches.caches = map[string]*LookupCache{}
+}
+
+// InvalidateLookupCache removes all the cached lookups for the given account
+func InvalidateLookupCache(accountID string) {
+	lookupCaches.Lock()
+	defer lookupCaches.Unlock()
+
+	for scope, c := range lookupCaches.caches {
+		if strings.HasPrefix(scope, accountID+"@") {
+			c.InvalidateAll()
+		}
+	}
+}
+
+// getLookupCache returns the (shared) lookup cache of the given account and endpoint. Sessions whose account is
+// unknown get a cache of their own, as their lookups cannot be told apart from those of other accounts.
+func getLookupCache(accountID, url string) *LookupCache {
+	lookupCaches.Lock()
+	defer lookupCaches.Unlock()
+
+	if accountID == "" {
+		return NewLookupCache(lookupCaches.ttl)
+	}
+	scope := accountID + "@" + url
+	c, ok := lookupCaches.caches[scope]
+	if !ok {
+		c = NewLookupCache(lookupCaches.ttl)
+		lookupCaches.caches[scope] = c
+	}
+	return c
+}
+
+// GetAccountIDFromToken returns the account ID (the 'account.bss' claim) of an IAM access token, or
+// an empty string if it cannot be found. The signature of the token is not verified.
+func GetAccountIDFromToken(tok string) string {
+	parts := strings.Split(strings.TrimPrefix(tok, "Bearer "), ".")
+	if len(parts) != 3 {
+		return ""
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return ""
+	}
+	claims := struct {
+		Account struct {
+			BSS string `json:"bss"`
+		} `json:"account"`
+	}{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return ""
+	}
+	return claims.Account.BSS
+}
@@ -0,0 +1,161 @@
+package clients
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/core"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLookupCache(t *testing.T) {
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := NewLookupCache(time.Minute)
+	c.now = func() time.Time { return now }
+
+	c.Set(lookupKindPlans, serviceName, "plans")
+	c.Set(lookupKindResourceGroups, "all", "groups")
+
+	if v, ok := c.Get(lookupKindPlans, serviceName); !ok || v != "plans" {
+		t.Errorf("Get(...): want: plans, got: %v", v)
+	}
+
+	c.Invalidate(lookupKindResourceGroups)
+	if _, ok := c.Get(lookupKindResourceGroups, "all"); ok {
+		t.Errorf("Get(...): want no entry after Invalidate(...)")
+	}
+
+	now = now.Add(2 * time.Minute)
+	if _, ok := c.Get(lookupKindPlans, serviceName); ok {
+		t.Errorf("Get(...): want no entry after the ttl")
+	}
+
+	disabled := NewLookupCache(0)
+	disabled.Set(lookupKindPlans, serviceName, "plans")
+	if _, ok := disabled.Get(lookupKindPlans, serviceName); ok {
+		t.Errorf("Get(...): want no entry when the ttl is zero")
+	}
+}
+
+func TestGetAccountIDFromToken(t *testing.T) {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(`{"account":{"bss":"0b5a00334eaf9eb9339d2ab48f20d7f5"}}`))
+	cases := map[string]struct {
+		tok  string
+		want string
+	}{
+		"JWT": {
+			tok:  "header." + payload + ".signature",
+			want: "0b5a00334eaf9eb9339d2ab48f20d7f5",
+		},
+		"WithBearerPrefix": {
+			tok:  "Bearer header." + payload + ".signature",
+			want: "0b5a00334eaf9eb9339d2ab48f20d7f5",
+		},
+		"NotAJWT": {
+			tok:  FakeBearerToken,
+			want: "",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GetAccountIDFromToken(tc.tok)); diff != "" {
+				t.Errorf("GetAccountIDFromToken(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+// accountToken returns a bearer token with the given account ID in its claims
+func accountToken(accountID string) string {
+	return "Bearer header." + base64.RawURLEncoding.EncodeToString([]byte(`{"account":{"bss":"`+accountID+`"}}`)) + ".signature"
+}
+
+// getTestClientWithToken returns a client to be used in unit tests, authenticated with the given bearer token
+func getTestClientWithToken(serverURL, tok string) (ClientSession, error) {
+	return NewClient(ClientOptions{
+		URL:           serverURL,
+		Authenticator: &core.BearerTokenAuthenticator{BearerToken: tok},
+		BearerToken:   tok,
+		RefreshToken:  "does format matter?",
+	})
+}
+
+func TestCachedLookups(t *testing.T) {
+	calls := map[string]int{}
+	count := func(name string, h http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			calls[name]++
+			h(w, r)
+		}
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", count("services", svcatHandler))
+	mux.HandleFunc("/"+serviceName+"/", count("plans", pcatHandler))
+	mux.HandleFunc("/resource_groups/", count("groups", rgHandler))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	tok := accountToken("0b5a00334eaf9eb9339d2ab48f20d7f5")
+	for i := 0; i < 3; i++ {
+		mClient, _ := getTestClientWithToken(server.URL, tok)
+		if _, err := GetResourcePlanID(mClient, serviceName, resourcePlanName); err != nil {
+			t.Errorf("GetResourcePlanID(...): unexpected error: %s", err)
+		}
+		if _, err := GetResourcePlanName(mClient, serviceName, resourcePlanID); err != nil {
+			t.Errorf("GetResourcePlanName(...): unexpected error: %s", err)
+		}
+		if _, err := GetResourceGroupName(mClient, resourceGroupID); err != nil {
+			t.Errorf("GetResourceGroupName(...): unexpected error: %s", err)
+		}
+	}
+
+	want := map[string]int{"services": 1, "plans": 1, "groups": 1}
+	if diff := cmp.Diff(want, calls); diff != "" {
+		t.Errorf("cached lookups: -want, +got:\n%s", diff)
+	}
+
+	// A name that is not in the cached entries fetches them again
+	mClient, _ := getTestClientWithToken(server.URL, tok)
+	if _, err := GetResourceGroupID(mClient, &invalidRGName); err == nil {
+		t.Errorf("GetResourceGroupID(...): want error")
+	}
+	if calls["groups"] != 2 {
+		t.Errorf("GetResourceGroupID(...): want 2 calls to list the resource groups, got %d", calls["groups"])
+	}
+
+	InvalidateResourceGroupsCache(mClient)
+	if _, err := GetResourceGroupName(mClient, resourceGroupID); err != nil {
+		t.Errorf("GetResourceGroupName(...): unexpected error: %s", err)
+	}
+	if calls["groups"] != 3 {
+		t.Errorf("GetResourceGroupName(...): want 3 calls to list the resource groups, got %d", calls["groups"])
+	}
+}
+
+func TestLookupCacheWithoutAccount(t *testing.T) {
+	calls := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/resource_groups/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		rgHandler(w, r)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	// neither token has an account claim, so the sessions must not share their lookups
+	for _, tok := range []string{FakeBearerToken, "Bearer another-mock-token"} {
+		mClient, _ := getTestClientWithToken(server.URL, tok)
+		for i := 0; i < 2; i++ {
+			if _, err := GetResourceGroupName(mClient, resourceGroupID); err != nil {
+				t.Errorf("GetResourceGroupName(...): unexpected error: %s", err)
+			}
+		}
+	}
+	if calls != 2 {
+		t.Errorf("GetResourceGroupName(...): want 2 calls to list the resource groups, got %d", calls)
+	}
+}
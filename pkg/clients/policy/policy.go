@@ -6,6 +6,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -16,6 +17,7 @@ import (
 	iampmv1 "github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
 
 	"github.com/crossplane-contrib/provider-ibm-cloud/apis/iampolicymanagementv1/v1alpha1"
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
 )
 
 const (
@@ -23,6 +25,14 @@ const (
 	StateActive = "active"
 	// StateInactive represents an inactive policy
 	StateInactive = "inactive"
+
+	attrAccountID        = "accountId"
+	attrServiceName      = "serviceName"
+	attrServiceInstance  = "serviceInstance"
+	operatorStringEquals = "stringEquals"
+
+	errAttributeNoName  = "attribute %d of %s %d has no name"
+	errAttributeNoValue = "attribute %d of %s %d has no value nor reference to set it"
 )
 
 // LateInitializeSpec fills optional and unassigned fields with the values in *iampmv1.Policy object.
@@ -30,11 +40,20 @@ func LateInitializeSpec(spec *v1alpha1.PolicyParameters, in *iampmv1.Policy) err
 	if spec.Description == nil {
 		spec.Description = in.Description
 	}
-	if spec.Resources == nil {
+	if spec.Resources == nil && spec.Target == nil {
 		spec.Resources = GenerateCRResources(in.Resources)
 	}
+	if spec.Target != nil && spec.Target.AccountID == nil {
+		spec.Target.AccountID = resourceAttributeValue(in.Resources, attrAccountID)
+	}
 	for i, r := range spec.Resources {
+		if i >= len(in.Resources) {
+			break
+		}
 		for j, attr := range r.Attributes {
+			if j >= len(in.Resources[i].Attributes) {
+				break
+			}
 			if attr.Name == nil {
 				spec.Resources[i].Attributes[j].Name = in.Resources[i].Attributes[j].Name
 			}
@@ -50,20 +69,29 @@ func LateInitializeSpec(spec *v1alpha1.PolicyParameters, in *iampmv1.Policy) err
 		spec.Roles = GenerateCRRoles(in.Roles)
 	}
 	for i, r := range spec.Roles {
-		if r.RoleID == "" {
+		if r.RoleID == "" && i < len(in.Roles) {
 			spec.Roles[i].RoleID = reference.FromPtrValue(in.Roles[i].RoleID)
 		}
 	}
-	if spec.Subjects == nil {
+	if spec.Subjects == nil && spec.Source == nil {
 		spec.Subjects = GenerateCRSubjects(in.Subjects)
 	}
+	if spec.Source != nil && spec.Source.AccountID == nil {
+		spec.Source.AccountID = subjectAttributeValue(in.Subjects, attrAccountID)
+	}
 	for i, s := range spec.Subjects {
+		if i >= len(in.Subjects) {
+			break
+		}
 		for j, attr := range s.Attributes {
+			if j >= len(in.Subjects[i].Attributes) {
+				break
+			}
 			if attr.Name == nil {
-				spec.Resources[i].Attributes[j].Name = in.Resources[i].Attributes[j].Name
+				spec.Subjects[i].Attributes[j].Name = in.Subjects[i].Attributes[j].Name
 			}
 			if attr.Value == nil {
-				spec.Resources[i].Attributes[j].Value = in.Resources[i].Attributes[j].Value
+				spec.Subjects[i].Attributes[j].Value = in.Subjects[i].Attributes[j].Value
 			}
 		}
 	}
@@ -75,26 +103,60 @@ func LateInitializeSpec(spec *v1alpha1.PolicyParameters, in *iampmv1.Policy) err
 
 // GenerateCreatePolicyOptions produces PolicyOptions object from PolicyParameters object.
 func GenerateCreatePolicyOptions(in v1alpha1.PolicyParameters, o *iampmv1.CreatePolicyOptions) error {
+	if err := ValidateAttributes(in); err != nil {
+		return err
+	}
 	o.Description = in.Description
-	o.Resources = GenerateSDKResources(in.Resources)
+	o.Resources = GenerateSDKResources(in.Resources, in.Target)
 	o.Roles = GenerateSDKRoles(in.Roles)
-	o.Subjects = GenerateSDKSubjects(in.Subjects)
+	o.Subjects = GenerateSDKSubjects(in.Subjects, in.Source)
 	o.Type = reference.ToPtrValue(in.Type)
 	return nil
 }
 
 // GenerateUpdatePolicyOptions produces UpdatePolicyOptions object from Policy object.
 func GenerateUpdatePolicyOptions(id, eTag string, in v1alpha1.PolicyParameters, o *iampmv1.UpdatePolicyOptions) error {
+	if err := ValidateAttributes(in); err != nil {
+		return err
+	}
 	o.Description = in.Description
-	o.Resources = GenerateSDKResources(in.Resources)
+	o.Resources = GenerateSDKResources(in.Resources, in.Target)
 	o.Roles = GenerateSDKRoles(in.Roles)
-	o.Subjects = GenerateSDKSubjects(in.Subjects)
+	o.Subjects = GenerateSDKSubjects(in.Subjects, in.Source)
 	o.Type = reference.ToPtrValue(in.Type)
 	o.PolicyID = &id
 	o.SetIfMatch(eTag)
 	return nil
 }
 
+// ValidateAttributes checks that every subject and resource attribute of a policy has a name, and a value set
+// directly or by one of its references
+func ValidateAttributes(in v1alpha1.PolicyParameters) error {
+	for i, s := range in.Subjects {
+		for j, attr := range s.Attributes {
+			if attr.Name == nil {
+				return errors.Errorf(errAttributeNoName, j, "subject", i)
+			}
+			if attr.Value == nil && attr.ValueGenericRef == nil && attr.ServiceIDRef == nil && attr.ServiceIDSelector == nil &&
+				attr.TrustedProfileRef == nil && attr.TrustedProfileSelector == nil &&
+				attr.AccessGroupIDRef == nil && attr.AccessGroupIDSelector == nil {
+				return errors.Errorf(errAttributeNoValue, j, "subject", i)
+			}
+		}
+	}
+	for i, r := range in.Resources {
+		for j, attr := range r.Attributes {
+			if attr.Name == nil {
+				return errors.Errorf(errAttributeNoName, j, "resource", i)
+			}
+			if attr.Value == nil && attr.ValueGenericRef == nil && attr.ServiceInstanceRef == nil && attr.ServiceInstanceSelector == nil {
+				return errors.Errorf(errAttributeNoValue, j, "resource", i)
+			}
+		}
+	}
+	return nil
+}
+
 // GenerateObservation produces PolicyObservation object from *iampmv1.Policy object.
 func GenerateObservation(in *iampmv1.Policy) (v1alpha1.PolicyObservation, error) {
 	o := v1alpha1.PolicyObservation{
@@ -122,16 +184,37 @@ func GenerateMetaV1Time(t *strfmt.DateTime) *metav1.Time {
 // set of parameters.
 func IsUpToDate(in *v1alpha1.PolicyParameters, observed *iampmv1.Policy, l logging.Logger) (bool, error) {
 	desired := in.DeepCopy()
+	desired.Subjects = append(desired.Subjects, GenerateAuthorizationSubjects(desired.Source)...)
+	desired.Resources = append(desired.Resources, GenerateAuthorizationResources(desired.Target)...)
+	desired.Source, desired.Target = nil, nil
 	actual, err := GeneratePolicyParameters(observed)
 	if err != nil {
 		return false, err
 	}
 
-	l.Info(cmp.Diff(desired, actual, cmpopts.IgnoreTypes(&runtimev1alpha1.Reference{}, &runtimev1alpha1.Selector{}, []runtimev1alpha1.Reference{})))
+	// the API does not preserve the order of the attributes, e.g. the accountId ones it adds to authorization policies
+	sortAttributes := []cmp.Option{
+		cmpopts.SortSlices(func(a, b v1alpha1.SubjectAttribute) bool {
+			return attributeLess(a.Name, a.Value, b.Name, b.Value)
+		}),
+		cmpopts.SortSlices(func(a, b v1alpha1.ResourceAttribute) bool {
+			return attributeLess(a.Name, a.Value, b.Name, b.Value)
+		}),
+	}
+	ignoreRefs := cmpopts.IgnoreTypes(&runtimev1alpha1.Reference{}, &runtimev1alpha1.Selector{}, &v1beta1.GenericReference{}, []runtimev1alpha1.Reference{})
+
+	l.Info(cmp.Diff(desired, actual, append(sortAttributes, ignoreRefs)...))
 
-	return cmp.Equal(desired, actual, cmpopts.EquateEmpty(),
-		cmpopts.IgnoreFields(v1alpha1.PolicyParameters{}),
-		cmpopts.IgnoreTypes(&runtimev1alpha1.Reference{}, &runtimev1alpha1.Selector{}, []runtimev1alpha1.Reference{})), nil
+	return cmp.Equal(desired, actual, append(sortAttributes, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(v1alpha1.PolicyParameters{}), ignoreRefs)...), nil
+}
+
+// attributeLess orders policy attributes by name, then by value
+func attributeLess(aName, aValue, bName, bValue *string) bool {
+	if reference.FromPtrValue(aName) != reference.FromPtrValue(bName) {
+		return reference.FromPtrValue(aName) < reference.FromPtrValue(bName)
+	}
+	return reference.FromPtrValue(aValue) < reference.FromPtrValue(bValue)
 }
 
 // GeneratePolicyParameters generates service instance parameters from resource instance
@@ -209,10 +292,11 @@ func GenerateCRSubjectAttributes(in []iampmv1.SubjectAttribute) []v1alpha1.Subje
 	return o
 }
 
-// GenerateSDKResources -
-func GenerateSDKResources(in []v1alpha1.PolicyResource) []iampmv1.PolicyResource {
+// GenerateSDKResources generates the resources of a policy, including the one of the target of an authorization
+// policy
+func GenerateSDKResources(in []v1alpha1.PolicyResource, target *v1alpha1.AuthorizationTarget) []iampmv1.PolicyResource {
 	o := []iampmv1.PolicyResource{}
-	for _, res := range in {
+	for _, res := range append(append([]v1alpha1.PolicyResource{}, in...), GenerateAuthorizationResources(target)...) {
 		item := iampmv1.PolicyResource{
 			Attributes: GenerateSDKResourceAttributes(res.Attributes),
 		}
@@ -247,10 +331,10 @@ func GenerateSDKRoles(in []v1alpha1.PolicyRole) []iampmv1.PolicyRole {
 	return o
 }
 
-// GenerateSDKSubjects -
-func GenerateSDKSubjects(in []v1alpha1.PolicySubject) []iampmv1.PolicySubject {
+// GenerateSDKSubjects generates the subjects of a policy, including the one of the source of an authorization policy
+func GenerateSDKSubjects(in []v1alpha1.PolicySubject, source *v1alpha1.AuthorizationSource) []iampmv1.PolicySubject {
 	o := []iampmv1.PolicySubject{}
-	for _, pol := range in {
+	for _, pol := range append(append([]v1alpha1.PolicySubject{}, in...), GenerateAuthorizationSubjects(source)...) {
 		item := iampmv1.PolicySubject{
 			Attributes: GenerateSDKSubjectAttributes(pol.Attributes),
 		}
@@ -271,3 +355,62 @@ func GenerateSDKSubjectAttributes(in []v1alpha1.SubjectAttribute) []iampmv1.Subj
 	}
 	return o
 }
+
+// GenerateAuthorizationSubjects generates the subject of an authorization policy from its source
+func GenerateAuthorizationSubjects(in *v1alpha1.AuthorizationSource) []v1alpha1.PolicySubject {
+	if in == nil {
+		return nil
+	}
+	attrs := []v1alpha1.SubjectAttribute{}
+	if in.AccountID != nil {
+		attrs = append(attrs, v1alpha1.SubjectAttribute{Name: reference.ToPtrValue(attrAccountID), Value: in.AccountID})
+	}
+	attrs = append(attrs, v1alpha1.SubjectAttribute{Name: reference.ToPtrValue(attrServiceName), Value: reference.ToPtrValue(in.ServiceName)})
+	if in.ServiceInstance != nil {
+		attrs = append(attrs, v1alpha1.SubjectAttribute{Name: reference.ToPtrValue(attrServiceInstance), Value: in.ServiceInstance})
+	}
+	return []v1alpha1.PolicySubject{{Attributes: attrs}}
+}
+
+// GenerateAuthorizationResources generates the resource of an authorization policy from its target
+func GenerateAuthorizationResources(in *v1alpha1.AuthorizationTarget) []v1alpha1.PolicyResource {
+	if in == nil {
+		return nil
+	}
+	attrs := []v1alpha1.ResourceAttribute{}
+	if in.AccountID != nil {
+		attrs = append(attrs, v1alpha1.ResourceAttribute{Name: reference.ToPtrValue(attrAccountID), Value: in.AccountID,
+			Operator: reference.ToPtrValue(operatorStringEquals)})
+	}
+	attrs = append(attrs, v1alpha1.ResourceAttribute{Name: reference.ToPtrValue(attrServiceName), Value: reference.ToPtrValue(in.ServiceName),
+		Operator: reference.ToPtrValue(operatorStringEquals)})
+	if in.ServiceInstance != nil {
+		attrs = append(attrs, v1alpha1.ResourceAttribute{Name: reference.ToPtrValue(attrServiceInstance), Value: in.ServiceInstance,
+			Operator: reference.ToPtrValue(operatorStringEquals)})
+	}
+	return []v1alpha1.PolicyResource{{Attributes: attrs}}
+}
+
+// subjectAttributeValue returns the value of the first subject attribute with the given name
+func subjectAttributeValue(in []iampmv1.PolicySubject, name string) *string {
+	for _, s := range in {
+		for _, a := range s.Attributes {
+			if reference.FromPtrValue(a.Name) == name {
+				return a.Value
+			}
+		}
+	}
+	return nil
+}
+
+// resourceAttributeValue returns the value of the first resource attribute with the given name
+func resourceAttributeValue(in []iampmv1.PolicyResource, name string) *string {
+	for _, r := range in {
+		for _, a := range r.Attributes {
+			if reference.FromPtrValue(a.Name) == name {
+				return a.Value
+			}
+		}
+	}
+	return nil
+}
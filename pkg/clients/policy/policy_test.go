@@ -3,9 +3,12 @@ package policy
 import (
 	"testing"
 
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
 	"github.com/go-openapi/strfmt"
 	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
 
 	iampmv1 "github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
 
@@ -14,27 +17,74 @@ import (
 )
 
 var (
-	policyTypeAccess     = "access"
-	policyTypeAuth       = "authorization"
-	policyAttributeName  = "iam_id"
-	policyAttributeValue = "IBMid-123453user"
-	createdByID          = "IBMid-123453user"
-	roleID               = "crn:v1:bluemix:public:iam::::role:Editor"
-	resAttr1Name         = "accountId"
-	resAttr1Value        = "my-account-id"
-	resAttr2Name         = "serviceName"
-	resAttr2Value        = "cos"
-	resAttr3Name         = "resource"
-	resAttr3Value        = "mycos"
-	resAttr3Operator     = "stringEquals"
-	policyDescription    = "this is my policy 1"
-	policyID             = "12345678-abcd-1a2b-a1b2-1234567890ab"
-	createdAt, _         = strfmt.ParseDateTime("2020-10-31T02:33:06Z")
-	lastModifiedAt, _    = strfmt.ParseDateTime("2020-10-31T03:33:06Z")
-	hRef                 = "https://iam.cloud.ibm.com/v1/policies/12345678-abcd-1a2b-a1b2-1234567890ab"
-	eTag                 = "1-eb832c7ff8c8016a542974b9f880b55e"
+	policyTypeAccess        = "access"
+	policyTypeAuth          = "authorization"
+	policyAttributeName     = "iam_id"
+	policyAttributeValue    = "IBMid-123453user"
+	createdByID             = "IBMid-123453user"
+	roleID                  = "crn:v1:bluemix:public:iam::::role:Editor"
+	resAttr1Name            = "accountId"
+	resAttr1Value           = "my-account-id"
+	resAttr2Name            = "serviceName"
+	resAttr2Value           = "cos"
+	resAttr3Name            = "resource"
+	resAttr3Value           = "mycos"
+	resAttr3Operator        = "stringEquals"
+	policyDescription       = "this is my policy 1"
+	policyID                = "12345678-abcd-1a2b-a1b2-1234567890ab"
+	createdAt, _            = strfmt.ParseDateTime("2020-10-31T02:33:06Z")
+	lastModifiedAt, _       = strfmt.ParseDateTime("2020-10-31T03:33:06Z")
+	hRef                    = "https://iam.cloud.ibm.com/v1/policies/12345678-abcd-1a2b-a1b2-1234567890ab"
+	eTag                    = "1-eb832c7ff8c8016a542974b9f880b55e"
+	authAccountID           = "0b5a00334eaf9eb9339d2ab48f20d7f5"
+	authSourceService       = "cloud-object-storage"
+	authSourceInstance      = "e1a3b5c7-1234-4cde-8f90-1a2b3c4d5e6f"
+	authTargetService       = "kms"
+	authTargetInstance      = "f2b4c6d8-5678-4def-9a01-2b3c4d5e6f70"
+	authOtherInstance       = "a1b2c3d4-9012-4abc-8def-3c4d5e6f7081"
+	authAttrAccountID       = "accountId"
+	authAttrServiceName     = "serviceName"
+	authAttrServiceInstance = "serviceInstance"
+	authOperator            = "stringEquals"
 )
 
+// authParams turns the parameters of a policy into the ones of an authorization policy of COS on a Key Protect instance
+func authParams(p *v1alpha1.PolicyParameters) {
+	p.Type = policyTypeAuth
+	p.Subjects = nil
+	p.Resources = nil
+	p.Source = &v1alpha1.AuthorizationSource{
+		ServiceName:     authSourceService,
+		ServiceInstance: &authSourceInstance,
+	}
+	p.Target = &v1alpha1.AuthorizationTarget{
+		ServiceName:     authTargetService,
+		ServiceInstance: &authTargetInstance,
+	}
+}
+
+func authSubjects(accountID bool) []iampmv1.PolicySubject {
+	attrs := []iampmv1.SubjectAttribute{}
+	if accountID {
+		attrs = append(attrs, iampmv1.SubjectAttribute{Name: &authAttrAccountID, Value: &authAccountID})
+	}
+	attrs = append(attrs,
+		iampmv1.SubjectAttribute{Name: &authAttrServiceName, Value: &authSourceService},
+		iampmv1.SubjectAttribute{Name: &authAttrServiceInstance, Value: &authSourceInstance})
+	return []iampmv1.PolicySubject{{Attributes: attrs}}
+}
+
+func authResources(accountID bool) []iampmv1.PolicyResource {
+	attrs := []iampmv1.ResourceAttribute{}
+	if accountID {
+		attrs = append(attrs, iampmv1.ResourceAttribute{Name: &authAttrAccountID, Value: &authAccountID, Operator: &authOperator})
+	}
+	attrs = append(attrs,
+		iampmv1.ResourceAttribute{Name: &authAttrServiceName, Value: &authTargetService, Operator: &authOperator},
+		iampmv1.ResourceAttribute{Name: &authAttrServiceInstance, Value: &authTargetInstance, Operator: &authOperator})
+	return []iampmv1.PolicyResource{{Attributes: attrs}}
+}
+
 func params(m ...func(*v1alpha1.PolicyParameters)) *v1alpha1.PolicyParameters {
 	p := &v1alpha1.PolicyParameters{
 		Type: policyTypeAccess,
@@ -265,6 +315,28 @@ func TestGenerateCreatePolicyOptions(t *testing.T) {
 				p.Type = nil
 			})},
 		},
+		"Authorization": {
+			args: args{params: *params(authParams)},
+			want: want{instance: instanceOpts(func(p *iampmv1.CreatePolicyOptions) {
+				p.Type = &policyTypeAuth
+				p.Subjects = authSubjects(false)
+				p.Resources = authResources(false)
+			})},
+		},
+		"AuthorizationAllServiceInstances": {
+			args: args{params: *params(authParams, func(p *v1alpha1.PolicyParameters) {
+				p.Source.ServiceInstance = nil
+				p.Source.AccountID = &authAccountID
+			})},
+			want: want{instance: instanceOpts(func(p *iampmv1.CreatePolicyOptions) {
+				p.Type = &policyTypeAuth
+				p.Subjects = []iampmv1.PolicySubject{{Attributes: []iampmv1.SubjectAttribute{
+					{Name: &authAttrAccountID, Value: &authAccountID},
+					{Name: &authAttrServiceName, Value: &authSourceService},
+				}}}
+				p.Resources = authResources(false)
+			})},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
@@ -339,6 +411,61 @@ func TestLateInitializeSpecs(t *testing.T) {
 			want: want{
 				params: params()},
 		},
+		"AuthorizationAccountIDs": {
+			args: args{
+				params: params(authParams),
+				instance: instance(func(i *iampmv1.Policy) {
+					i.Type = &policyTypeAuth
+					i.Subjects = authSubjects(true)
+					i.Resources = authResources(true)
+				}),
+			},
+			want: want{
+				params: params(authParams, func(p *v1alpha1.PolicyParameters) {
+					p.Source.AccountID = &authAccountID
+					p.Target.AccountID = &authAccountID
+				})},
+		},
+		"SubjectAttributeValue": {
+			args: args{
+				params: params(func(p *v1alpha1.PolicyParameters) {
+					p.Subjects[0].Attributes[0].Value = nil
+				}),
+				instance: instance(),
+			},
+			want: want{
+				params: params()},
+		},
+		"TargetWithSubjects": {
+			args: args{
+				params: params(func(p *v1alpha1.PolicyParameters) {
+					p.Resources = nil
+					p.Target = &v1alpha1.AuthorizationTarget{ServiceName: authTargetService}
+				}),
+				instance: instance(func(i *iampmv1.Policy) {
+					i.Resources = authResources(true)
+				}),
+			},
+			want: want{
+				params: params(func(p *v1alpha1.PolicyParameters) {
+					p.Resources = nil
+					p.Target = &v1alpha1.AuthorizationTarget{ServiceName: authTargetService, AccountID: &authAccountID}
+				})},
+		},
+		"MoreAttributesThanInstance": {
+			args: args{
+				params: params(func(p *v1alpha1.PolicyParameters) {
+					p.Subjects = append(p.Subjects, v1alpha1.PolicySubject{Attributes: []v1alpha1.SubjectAttribute{{Name: &policyAttributeName}}})
+					p.Resources[0].Attributes = append(p.Resources[0].Attributes, v1alpha1.ResourceAttribute{Name: &resAttr1Name})
+				}),
+				instance: instance(),
+			},
+			want: want{
+				params: params(func(p *v1alpha1.PolicyParameters) {
+					p.Subjects = append(p.Subjects, v1alpha1.PolicySubject{Attributes: []v1alpha1.SubjectAttribute{{Name: &policyAttributeName}}})
+					p.Resources[0].Attributes = append(p.Resources[0].Attributes, v1alpha1.ResourceAttribute{Name: &resAttr1Name})
+				})},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
@@ -350,6 +477,49 @@ func TestLateInitializeSpecs(t *testing.T) {
 	}
 }
 
+func TestValidateAttributes(t *testing.T) {
+	cases := map[string]struct {
+		params *v1alpha1.PolicyParameters
+		err    error
+	}{
+		"Valid": {
+			params: params(),
+		},
+		"ReferenceOnly": {
+			params: params(func(p *v1alpha1.PolicyParameters) {
+				p.Subjects[0].Attributes[0].Value = nil
+				p.Subjects[0].Attributes[0].AccessGroupIDRef = &runtimev1alpha1.Reference{Name: "group"}
+			}),
+		},
+		"SubjectNoName": {
+			params: params(func(p *v1alpha1.PolicyParameters) {
+				p.Subjects[0].Attributes[0].Name = nil
+			}),
+			err: errors.Errorf(errAttributeNoName, 0, "subject", 0),
+		},
+		"SubjectNoValue": {
+			params: params(func(p *v1alpha1.PolicyParameters) {
+				p.Subjects[0].Attributes[0].Value = nil
+			}),
+			err: errors.Errorf(errAttributeNoValue, 0, "subject", 0),
+		},
+		"ResourceNoValue": {
+			params: params(func(p *v1alpha1.PolicyParameters) {
+				p.Resources[0].Attributes[2].Value = nil
+			}),
+			err: errors.Errorf(errAttributeNoValue, 2, "resource", 0),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateAttributes(*tc.params)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("ValidateAttributes(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGenerateObservation(t *testing.T) {
 	type args struct {
 		instance *iampmv1.Policy
@@ -411,6 +581,59 @@ func TestIsUpToDate(t *testing.T) {
 			},
 			want: want{upToDate: false, isErr: false},
 		},
+		"AuthorizationIsUpToDate": {
+			args: args{
+				params: params(authParams, func(p *v1alpha1.PolicyParameters) {
+					p.Source.AccountID = &authAccountID
+					p.Target.AccountID = &authAccountID
+				}),
+				instance: instance(func(i *iampmv1.Policy) {
+					i.Type = &policyTypeAuth
+					i.Subjects = authSubjects(true)
+					i.Resources = authResources(true)
+				}),
+			},
+			want: want{upToDate: true, isErr: false},
+		},
+		"AttributesInAnotherOrder": {
+			args: args{
+				params: params(),
+				instance: instance(func(i *iampmv1.Policy) {
+					attrs := i.Resources[0].Attributes
+					i.Resources[0].Attributes = []iampmv1.ResourceAttribute{attrs[2], attrs[0], attrs[1]}
+				}),
+			},
+			want: want{upToDate: true, isErr: false},
+		},
+		"AuthorizationAccountIDsLast": {
+			args: args{
+				params: params(authParams, func(p *v1alpha1.PolicyParameters) {
+					p.Source.AccountID = &authAccountID
+					p.Target.AccountID = &authAccountID
+				}),
+				instance: instance(func(i *iampmv1.Policy) {
+					i.Type = &policyTypeAuth
+					i.Subjects = authSubjects(false)
+					i.Subjects[0].Attributes = append(i.Subjects[0].Attributes, iampmv1.SubjectAttribute{Name: &authAttrAccountID, Value: &authAccountID})
+					i.Resources = authResources(false)
+					i.Resources[0].Attributes = append(i.Resources[0].Attributes, iampmv1.ResourceAttribute{Name: &authAttrAccountID, Value: &authAccountID, Operator: &authOperator})
+				}),
+			},
+			want: want{upToDate: true, isErr: false},
+		},
+		"AuthorizationNeedsUpdate": {
+			args: args{
+				params: params(authParams, func(p *v1alpha1.PolicyParameters) {
+					p.Target.ServiceInstance = &authOtherInstance
+				}),
+				instance: instance(func(i *iampmv1.Policy) {
+					i.Type = &policyTypeAuth
+					i.Subjects = authSubjects(false)
+					i.Resources = authResources(false)
+				}),
+			},
+			want: want{upToDate: false, isErr: false},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
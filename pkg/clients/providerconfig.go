@@ -0,0 +1,250 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
+)
+
+const (
+	errApplyProviderConfigDefaults = "cannot apply the defaults of the provider config"
+	errInvalidNamingRegex          = "invalid regular expression in the naming policy of the provider config"
+	errNamePrefix                  = "name %q does not start with %q, as required by the naming policy of the provider config"
+	errNameRegex                   = "name %q does not match %q, as required by the naming policy of the provider config"
+	errValueDenied                 = "%s %q is denied by the guardrails of the provider config"
+	errValueNotAllowed             = "%s %q is not allowed by the guardrails of the provider config, allowed values are: %s"
+)
+
+var vpcZoneRegex = regexp.MustCompile(`^([a-z]+-[a-z]+)-[0-9]+$`)
+
+const (
+	// TypeCompliant resources comply with the policies declared in their ProviderConfig.
+	TypeCompliant runtimev1alpha1.ConditionType = "Compliant"
+
+	// ReasonCompliant means that the resource complies with the policies of its ProviderConfig.
+	ReasonCompliant runtimev1alpha1.ConditionReason = "PolicyCompliant"
+
+	// ReasonPolicyViolated means that the resource violates a policy of its ProviderConfig.
+	ReasonPolicyViolated runtimev1alpha1.ConditionReason = "PolicyViolated"
+)
+
+// Compliant returns a condition that indicates the resource complies with the policies of its ProviderConfig.
+func Compliant() runtimev1alpha1.Condition {
+	return runtimev1alpha1.Condition{
+		Type:               TypeCompliant,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonCompliant,
+	}
+}
+
+// NonCompliant returns a condition that indicates the resource violates a policy of its ProviderConfig.
+func NonCompliant(err error) runtimev1alpha1.Condition {
+	return runtimev1alpha1.Condition{
+		Type:               TypeCompliant,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonPolicyViolated,
+		Message:            err.Error(),
+	}
+}
+
+// CheckNamingPolicy returns an error if the given name violates the naming policy of the ProviderConfig. Optional
+// names that are not set are left to IBM Cloud to generate, and are not checked.
+func CheckNamingPolicy(pc *v1beta1.ProviderConfigSpec, name *string) error {
+	if pc == nil || pc.NamingPolicy == nil || name == nil {
+		return nil
+	}
+	if p := pc.NamingPolicy.Prefix; p != nil && !strings.HasPrefix(*name, *p) {
+		return errors.Errorf(errNamePrefix, *name, *p)
+	}
+	if r := pc.NamingPolicy.Regex; r != nil {
+		re, err := regexp.Compile(*r)
+		if err != nil {
+			return errors.Wrap(err, errInvalidNamingRegex)
+		}
+		if !re.MatchString(*name) {
+			return errors.Errorf(errNameRegex, *name, *r)
+		}
+	}
+	return nil
+}
+
+// GuardedValues are the values of a managed resource that are subject to the guardrails of its ProviderConfig.
+type GuardedValues struct {
+	Services       []string
+	Plans          []string
+	Regions        []string
+	Zones          []string
+	ClusterFlavors []string
+}
+
+// CheckGuardrails returns an error if any of the given values is not allowed by the guardrails of the ProviderConfig.
+// The regions of the given VPC zones are checked as well.
+func CheckGuardrails(pc *v1beta1.ProviderConfigSpec, v GuardedValues) error {
+	if pc == nil || pc.Guardrails == nil {
+		return nil
+	}
+	regions := append([]string{}, v.Regions...)
+	for _, z := range v.Zones {
+		if r := ZoneRegion(z); r != "" {
+			regions = append(regions, r)
+		}
+	}
+	g := pc.Guardrails
+	checks := []struct {
+		kind   string
+		list   *v1beta1.AllowDenyList
+		values []string
+	}{
+		{kind: "service", list: g.Services, values: v.Services},
+		{kind: "plan", list: g.Plans, values: v.Plans},
+		{kind: "region", list: g.Regions, values: regions},
+		{kind: "zone", list: g.Zones, values: v.Zones},
+		{kind: "cluster flavor", list: g.ClusterFlavors, values: v.ClusterFlavors},
+	}
+	for _, c := range checks {
+		for _, value := range c.values {
+			if err := checkAllowed(c.list, c.kind, value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ZoneRegion returns the region of the given VPC zone (e.g. `us-south` for `us-south-1`), and an empty string if
+// it is not the name of a VPC zone.
+func ZoneRegion(zone string) string {
+	if m := vpcZoneRegex.FindStringSubmatch(zone); m != nil {
+		return m[1]
+	}
+	return ""
+}
+
+func checkAllowed(l *v1beta1.AllowDenyList, kind, value string) error {
+	if l == nil {
+		return nil
+	}
+	for _, d := range l.Denied {
+		if d == value {
+			return errors.Errorf(errValueDenied, kind, value)
+		}
+	}
+	if len(l.Allowed) == 0 {
+		return nil
+	}
+	for _, a := range l.Allowed {
+		if a == value {
+			return nil
+		}
+	}
+	return errors.Errorf(errValueNotAllowed, kind, value, strings.Join(l.Allowed, ", "))
+}
+
+// EnforcePolicies reflects the outcome of the given policy checks (e.g. CheckNamingPolicy, CheckGuardrails,
+// CheckMonthlyBudget) in the Compliant condition of the managed resource, and returns the first violation. A non-nil
+// error means the resource must be neither created nor updated. The condition is only set when the ProviderConfig
+// declares policies.
+func EnforcePolicies(mg resource.Managed, pc *v1beta1.ProviderConfigSpec, checks ...error) error {
+	if !hasPolicies(pc) {
+		return nil
+	}
+	for _, err := range checks {
+		if err != nil {
+			mg.SetConditions(NonCompliant(err))
+			return err
+		}
+	}
+	mg.SetConditions(Compliant())
+	return nil
+}
+
+func hasPolicies(pc *v1beta1.ProviderConfigSpec) bool {
+	if pc == nil {
+		return false
+	}
+	return pc.NamingPolicy != nil || pc.Guardrails != nil || (pc.CostEstimation != nil && pc.CostEstimation.MonthlyBudget != nil)
+}
+
+// DefaultTags returns the default tags of the ProviderConfig if the given tags are not set, and nil if
+// no default should be applied.
+func DefaultTags(pc *v1beta1.ProviderConfigSpec, tags []string) []string {
+	if pc == nil || tags != nil || len(pc.DefaultTags) == 0 {
+		return nil
+	}
+	return append([]string{}, pc.DefaultTags...)
+}
+
+// DefaultResourceGroupID returns the ID of the default resource group of the ProviderConfig, or nil if the
+// ProviderConfig does not declare one.
+func DefaultResourceGroupID(client ClientSession) (*string, error) {
+	pc := client.ProviderConfig()
+	if pc == nil || pc.DefaultResourceGroupName == nil {
+		return nil, nil
+	}
+	return GetResourceGroupID(client, pc.DefaultResourceGroupName)
+}
+
+// A ProviderConfigDefaulter applies the defaults declared in a ProviderConfig to a managed resource, returning
+// true if the resource was changed.
+type ProviderConfigDefaulter func(pc *v1beta1.ProviderConfigSpec, mg resource.Managed) bool
+
+// ProviderConfigDefaults is a managed.Initializer that applies the defaults declared in the ProviderConfig
+// of a managed resource to its spec.
+type ProviderConfigDefaults struct {
+	kube  client.Client
+	apply ProviderConfigDefaulter
+}
+
+// NewProviderConfigDefaults returns a ProviderConfigDefaults initializer that uses the supplied defaulter.
+func NewProviderConfigDefaults(kube client.Client, apply ProviderConfigDefaulter) *ProviderConfigDefaults {
+	return &ProviderConfigDefaults{kube: kube, apply: apply}
+}
+
+// Initialize applies the defaults of the ProviderConfig of the given managed resource, and persists them.
+func (d *ProviderConfigDefaults) Initialize(ctx context.Context, mg resource.Managed) error {
+	ref := mg.GetProviderConfigReference()
+	if ref == nil {
+		return nil
+	}
+	pc := &v1beta1.ProviderConfig{}
+	if err := d.kube.Get(ctx, types.NamespacedName{Name: ref.Name}, pc); err != nil {
+		// a missing ProviderConfig is reported when connecting
+		return errors.Wrap(resource.IgnoreNotFound(err), errGetProviderCfg)
+	}
+	if pc.GetName() == "" {
+		return nil
+	}
+	if !d.apply(&pc.Spec, mg) {
+		return nil
+	}
+	return errors.Wrap(d.kube.Update(ctx, mg), errApplyProviderConfigDefaults)
+}
@@ -0,0 +1,277 @@
+package clients
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
+)
+
+func namingPolicy(prefix, regex *string) *v1beta1.ProviderConfigSpec {
+	return &v1beta1.ProviderConfigSpec{NamingPolicy: &v1beta1.NamingPolicy{Prefix: prefix, Regex: regex}}
+}
+
+func TestCheckNamingPolicy(t *testing.T) {
+	cases := map[string]struct {
+		pc      *v1beta1.ProviderConfigSpec
+		name    *string
+		wantErr bool
+	}{
+		"NoPolicy": {
+			pc:   &v1beta1.ProviderConfigSpec{},
+			name: reference.ToPtrValue("anything"),
+		},
+		"NoName": {
+			pc: namingPolicy(reference.ToPtrValue("team-a-"), nil),
+		},
+		"PrefixMatches": {
+			pc:   namingPolicy(reference.ToPtrValue("team-a-"), nil),
+			name: reference.ToPtrValue("team-a-db"),
+		},
+		"PrefixViolated": {
+			pc:      namingPolicy(reference.ToPtrValue("team-a-"), nil),
+			name:    reference.ToPtrValue("team-b-db"),
+			wantErr: true,
+		},
+		"RegexMatches": {
+			pc:   namingPolicy(nil, reference.ToPtrValue("^[a-z]+-(dev|prod)$")),
+			name: reference.ToPtrValue("db-dev"),
+		},
+		"RegexViolated": {
+			pc:      namingPolicy(nil, reference.ToPtrValue("^[a-z]+-(dev|prod)$")),
+			name:    reference.ToPtrValue("db-test"),
+			wantErr: true,
+		},
+		"InvalidRegex": {
+			pc:      namingPolicy(nil, reference.ToPtrValue("(")),
+			name:    reference.ToPtrValue("db-dev"),
+			wantErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := CheckNamingPolicy(tc.pc, tc.name)
+			if diff := cmp.Diff(tc.wantErr, err != nil); diff != "" {
+				t.Errorf("CheckNamingPolicy(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCheckGuardrails(t *testing.T) {
+	pc := &v1beta1.ProviderConfigSpec{
+		Guardrails: &v1beta1.Guardrails{
+			Plans:          &v1beta1.AllowDenyList{Allowed: []string{"lite", "standard"}, Denied: []string{"standard"}},
+			Regions:        &v1beta1.AllowDenyList{Allowed: []string{"us-south", "eu-de"}},
+			ClusterFlavors: &v1beta1.AllowDenyList{Denied: []string{"mx2.128x1024"}},
+		},
+	}
+	cases := map[string]struct {
+		pc      *v1beta1.ProviderConfigSpec
+		values  GuardedValues
+		wantErr bool
+	}{
+		"NoProviderConfig": {
+			values: GuardedValues{Plans: []string{"standard"}},
+		},
+		"NoGuardrails": {
+			pc:     &v1beta1.ProviderConfigSpec{},
+			values: GuardedValues{Plans: []string{"standard"}},
+		},
+		"Allowed": {
+			pc:     pc,
+			values: GuardedValues{Services: []string{"cloud-object-storage"}, Plans: []string{"lite"}, Regions: []string{"eu-de"}},
+		},
+		"NotAllowed": {
+			pc:      pc,
+			values:  GuardedValues{Regions: []string{"jp-tok"}},
+			wantErr: true,
+		},
+		"ZoneInAllowedRegion": {
+			pc:     pc,
+			values: GuardedValues{Zones: []string{"eu-de-2", "dal10"}},
+		},
+		"ZoneNotInAllowedRegion": {
+			pc:      pc,
+			values:  GuardedValues{Zones: []string{"jp-tok-1"}},
+			wantErr: true,
+		},
+		"DeniedEvenIfAllowed": {
+			pc:      pc,
+			values:  GuardedValues{Plans: []string{"standard"}},
+			wantErr: true,
+		},
+		"DeniedWithoutAllowList": {
+			pc:      pc,
+			values:  GuardedValues{ClusterFlavors: []string{"bx2.4x16", "mx2.128x1024"}},
+			wantErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := CheckGuardrails(tc.pc, tc.values)
+			if diff := cmp.Diff(tc.wantErr, err != nil); diff != "" {
+				t.Errorf("CheckGuardrails(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEnforcePolicies(t *testing.T) {
+	cases := map[string]struct {
+		pc         *v1beta1.ProviderConfigSpec
+		name       string
+		wantErr    bool
+		wantCond   corev1.ConditionStatus
+		wantReason runtimev1alpha1.ConditionReason
+	}{
+		"NoPolicy": {
+			pc:       &v1beta1.ProviderConfigSpec{},
+			name:     "anything",
+			wantCond: corev1.ConditionUnknown,
+		},
+		"Compliant": {
+			pc:         namingPolicy(reference.ToPtrValue("team-a-"), nil),
+			name:       "team-a-db",
+			wantCond:   corev1.ConditionTrue,
+			wantReason: ReasonCompliant,
+		},
+		"NonCompliant": {
+			pc:         namingPolicy(reference.ToPtrValue("team-a-"), nil),
+			name:       "db",
+			wantErr:    true,
+			wantCond:   corev1.ConditionFalse,
+			wantReason: ReasonPolicyViolated,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &fake.Managed{}
+			err := EnforcePolicies(mg, tc.pc, CheckNamingPolicy(tc.pc, &tc.name))
+			if diff := cmp.Diff(tc.wantErr, err != nil); diff != "" {
+				t.Errorf("EnforcePolicies(...): -want error, +got error:\n%s", diff)
+			}
+			c := mg.GetCondition(TypeCompliant)
+			if diff := cmp.Diff(tc.wantCond, c.Status); diff != "" {
+				t.Errorf("EnforcePolicies(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantReason, c.Reason); diff != "" {
+				t.Errorf("EnforcePolicies(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDefaultTags(t *testing.T) {
+	pc := &v1beta1.ProviderConfigSpec{DefaultTags: []string{"team:a"}}
+	cases := map[string]struct {
+		pc   *v1beta1.ProviderConfigSpec
+		tags []string
+		want []string
+	}{
+		"NoProviderConfig": {},
+		"NoDefaults": {
+			pc: &v1beta1.ProviderConfigSpec{},
+		},
+		"Defaulted": {
+			pc:   pc,
+			want: []string{"team:a"},
+		},
+		"AlreadySet": {
+			pc:   pc,
+			tags: []string{},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, DefaultTags(tc.pc, tc.tags)); diff != "" {
+				t.Errorf("DefaultTags(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestProviderConfigDefaultsInitialize(t *testing.T) {
+	pcName := "ibm-cloud"
+	errBoom := "boom"
+
+	type want struct {
+		applied bool
+		updated bool
+		err     bool
+	}
+	cases := map[string]struct {
+		mg   resource.Managed
+		kube *test.MockClient
+		want want
+	}{
+		"NoProviderConfigRef": {
+			mg:   &fake.Managed{},
+			kube: &test.MockClient{},
+		},
+		"ProviderConfigNotFound": {
+			mg: &fake.Managed{ProviderConfigReferencer: fake.ProviderConfigReferencer{Ref: &runtimev1alpha1.Reference{Name: pcName}}},
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, pcName)),
+			},
+		},
+		"GetFailed": {
+			mg: &fake.Managed{ProviderConfigReferencer: fake.ProviderConfigReferencer{Ref: &runtimev1alpha1.Reference{Name: pcName}}},
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(errors.New(errBoom)),
+			},
+			want: want{err: true},
+		},
+		"Applied": {
+			mg: &fake.Managed{ProviderConfigReferencer: fake.ProviderConfigReferencer{Ref: &runtimev1alpha1.Reference{Name: pcName}}},
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj runtime.Object) error {
+					obj.(*v1beta1.ProviderConfig).SetName(pcName)
+					return nil
+				}),
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			want: want{applied: true, updated: true},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			applied, updated := false, false
+			if tc.kube.MockUpdate != nil {
+				mu := tc.kube.MockUpdate
+				tc.kube.MockUpdate = func(ctx context.Context, obj runtime.Object, opts ...client.UpdateOption) error {
+					updated = true
+					return mu(ctx, obj, opts...)
+				}
+			}
+			d := NewProviderConfigDefaults(tc.kube, func(pc *v1beta1.ProviderConfigSpec, mg resource.Managed) bool {
+				applied = true
+				return true
+			})
+			err := d.Initialize(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("Initialize(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.applied, applied); diff != "" {
+				t.Errorf("Initialize(...): -want applied, +got applied:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.updated, updated); diff != "" {
+				t.Errorf("Initialize(...): -want updated, +got updated:\n%s", diff)
+			}
+		})
+	}
+}
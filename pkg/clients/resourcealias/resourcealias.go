@@ -0,0 +1,131 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcealias
+
+import (
+	"github.com/IBM-Cloud/bluemix-go/crn"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	rcv2 "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/resourcecontrollerv2/v1alpha1"
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+)
+
+const (
+	// StateActive represents an alias in an active state
+	StateActive = "active"
+	// StateInactive represents an alias in an inactive state
+	StateInactive = "inactive"
+)
+
+// LateInitializeSpec fills optional and unassigned fields with the values in *rcv2.ResourceAlias object.
+func LateInitializeSpec(spec *v1alpha1.ResourceAliasParameters, in *rcv2.ResourceAlias) error {
+	if spec.Source == nil {
+		spec.Source = in.ResourceInstanceID
+	}
+	if spec.Target == "" {
+		spec.Target = reference.FromPtrValue(in.TargetCRN)
+	}
+	return nil
+}
+
+// GenerateCreateResourceAliasOptions produces CreateResourceAliasOptions object from ResourceAliasParameters object.
+func GenerateCreateResourceAliasOptions(in v1alpha1.ResourceAliasParameters, o *rcv2.CreateResourceAliasOptions) error {
+	o.Name = reference.ToPtrValue(in.Name)
+	o.Source = in.Source
+	o.Target = reference.ToPtrValue(in.Target)
+	return nil
+}
+
+// GenerateUpdateResourceAliasOptions produces UpdateResourceAliasOptions object from ResourceAliasParameters object.
+func GenerateUpdateResourceAliasOptions(id string, in v1alpha1.ResourceAliasParameters, o *rcv2.UpdateResourceAliasOptions) error {
+	o.ID = reference.ToPtrValue(id)
+	o.Name = reference.ToPtrValue(in.Name)
+	return nil
+}
+
+// GenerateObservation produces ResourceAliasObservation object from *rcv2.ResourceAlias object.
+func GenerateObservation(in *rcv2.ResourceAlias) (v1alpha1.ResourceAliasObservation, error) {
+	o := v1alpha1.ResourceAliasObservation{
+		ID:                  reference.FromPtrValue(in.ID),
+		GUID:                reference.FromPtrValue(in.GUID),
+		CRN:                 reference.FromPtrValue(in.CRN),
+		URL:                 reference.FromPtrValue(in.URL),
+		AccountID:           reference.FromPtrValue(in.AccountID),
+		ResourceGroupID:     reference.FromPtrValue(in.ResourceGroupID),
+		ResourceGroupCRN:    reference.FromPtrValue(in.ResourceGroupCRN),
+		State:               reference.FromPtrValue(in.State),
+		ResourceInstanceID:  reference.FromPtrValue(in.ResourceInstanceID),
+		RegionInstanceID:    reference.FromPtrValue(in.RegionInstanceID),
+		ResourceInstanceURL: reference.FromPtrValue(in.ResourceInstanceURL),
+		ResourceBindingsURL: reference.FromPtrValue(in.ResourceBindingsURL),
+		ResourceKeysURL:     reference.FromPtrValue(in.ResourceKeysURL),
+		CreatedAt:           ibmc.DateTimeToMetaV1Time(in.CreatedAt),
+		UpdatedAt:           ibmc.DateTimeToMetaV1Time(in.UpdatedAt),
+		DeletedAt:           ibmc.DateTimeToMetaV1Time(in.DeletedAt),
+		CreatedBy:           reference.FromPtrValue(in.CreatedBy),
+		UpdatedBy:           reference.FromPtrValue(in.UpdatedBy),
+		DeletedBy:           reference.FromPtrValue(in.DeletedBy),
+	}
+	return o, nil
+}
+
+// IsUpToDate checks whether current state is up-to-date compared to the given set of parameters.
+func IsUpToDate(in *v1alpha1.ResourceAliasParameters, observed *rcv2.ResourceAlias, l logging.Logger) (bool, error) {
+	desired := in.DeepCopy()
+	actual, err := GenerateResourceAliasParameters(observed)
+	if err != nil {
+		return false, err
+	}
+
+	diff := cmp.Diff(desired, actual,
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(v1alpha1.ResourceAliasParameters{}, "Source", "Tags", "AccessTags"),
+		cmpopts.IgnoreTypes(&runtimev1alpha1.Reference{}, &runtimev1alpha1.Selector{}, &v1beta1.GenericReference{}))
+	if diff != "" {
+		l.Info("IsUpToDate", "Diff", diff)
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// GenerateResourceAliasParameters generates resource alias parameters from a resource alias
+func GenerateResourceAliasParameters(in *rcv2.ResourceAlias) (*v1alpha1.ResourceAliasParameters, error) {
+	o := &v1alpha1.ResourceAliasParameters{
+		Name:   reference.FromPtrValue(in.Name),
+		Source: in.ResourceInstanceID,
+		Target: reference.FromPtrValue(in.TargetCRN),
+	}
+	return o, nil
+}
+
+// TargetRegion returns the region of the given target CRN, and an empty string if it is not a valid CRN
+func TargetRegion(target string) string {
+	c, err := crn.Parse(target)
+	if err != nil {
+		return ""
+	}
+	return c.Region
+}
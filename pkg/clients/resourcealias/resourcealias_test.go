@@ -0,0 +1,282 @@
+package resourcealias
+
+import (
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/go-cmp/cmp"
+
+	rcv2 "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/resourcecontrollerv2/v1alpha1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+)
+
+var (
+	aliasName    = "myalias"
+	aliasName2   = "myalias2"
+	aliasID      = "crn:v1:bluemix:public:cloudantnosqldb:us-south:a/0b5a00334eaf9eb9339d2ab48f20d7f5:78d88b2b-bbbb-4fe5-9d0a-dbaf1a2b57b9:resource-alias:3d4d1e2b-bbbb-4ec9-8b2e-4ac5b6e9a0a0"
+	aliasGUID    = "3d4d1e2b-bbbb-4ec9-8b2e-4ac5b6e9a0a0"
+	aliasURL     = "/v2/resource_aliases/3d4d1e2b-bbbb-4ec9-8b2e-4ac5b6e9a0a0"
+	accountID    = "0b5a00334eaf9eb9339d2ab48f20d7f5"
+	rgID         = "d6bd9d3e6c1f4fd9a0e0cbf0fca8c6e6"
+	rgCRN        = "crn:v1:bluemix:public:resource-controller::a/0b5a00334eaf9eb9339d2ab48f20d7f5::resource-group:d6bd9d3e6c1f4fd9a0e0cbf0fca8c6e6"
+	targetCRN    = "crn:v1:bluemix:public:cf:us-south:o/5e939cd5-6377-4383-b9e0-9db22cd11753::cf-space:66c8b915-101a-406c-a784-e6636676e4f5"
+	state        = "active"
+	instanceID   = "78d88b2b-bbbb-4fe5-9d0a-dbaf1a2b57b9"
+	regionInstID = "78d88b2b-bbbb-4fe5-9d0a-dbaf1a2b57b9"
+	instanceURL  = "/v2/resource_instances/78d88b2b-bbbb-4fe5-9d0a-dbaf1a2b57b9"
+	bindingsURL  = "/v2/resource_aliases/3d4d1e2b-bbbb-4ec9-8b2e-4ac5b6e9a0a0/resource_bindings"
+	keysURL      = "/v2/resource_aliases/3d4d1e2b-bbbb-4ec9-8b2e-4ac5b6e9a0a0/resource_keys"
+	createdBy    = "IBMid-5500093BHN"
+	createdAt, _ = strfmt.ParseDateTime("2020-10-31T02:33:06Z")
+	updatedAt, _ = strfmt.ParseDateTime("2020-10-31T03:33:06Z")
+)
+
+func params(m ...func(*v1alpha1.ResourceAliasParameters)) *v1alpha1.ResourceAliasParameters {
+	p := &v1alpha1.ResourceAliasParameters{
+		Name:   aliasName,
+		Source: &instanceID,
+		Target: targetCRN,
+	}
+
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func observation(m ...func(*v1alpha1.ResourceAliasObservation)) *v1alpha1.ResourceAliasObservation {
+	o := &v1alpha1.ResourceAliasObservation{
+		ID:                  aliasID,
+		GUID:                aliasGUID,
+		CRN:                 aliasID,
+		URL:                 aliasURL,
+		AccountID:           accountID,
+		ResourceGroupID:     rgID,
+		ResourceGroupCRN:    rgCRN,
+		State:               state,
+		ResourceInstanceID:  instanceID,
+		RegionInstanceID:    regionInstID,
+		ResourceInstanceURL: instanceURL,
+		ResourceBindingsURL: bindingsURL,
+		ResourceKeysURL:     keysURL,
+		CreatedAt:           ibmc.DateTimeToMetaV1Time(&createdAt),
+		UpdatedAt:           ibmc.DateTimeToMetaV1Time(&updatedAt),
+		CreatedBy:           createdBy,
+		UpdatedBy:           createdBy,
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+	return o
+}
+
+func instance(m ...func(*rcv2.ResourceAlias)) *rcv2.ResourceAlias {
+	i := &rcv2.ResourceAlias{
+		ID:                  &aliasID,
+		GUID:                &aliasGUID,
+		CRN:                 &aliasID,
+		URL:                 &aliasURL,
+		Name:                &aliasName,
+		AccountID:           &accountID,
+		ResourceGroupID:     &rgID,
+		ResourceGroupCRN:    &rgCRN,
+		TargetCRN:           &targetCRN,
+		State:               &state,
+		ResourceInstanceID:  &instanceID,
+		RegionInstanceID:    &regionInstID,
+		ResourceInstanceURL: &instanceURL,
+		ResourceBindingsURL: &bindingsURL,
+		ResourceKeysURL:     &keysURL,
+		CreatedAt:           &createdAt,
+		UpdatedAt:           &updatedAt,
+		CreatedBy:           &createdBy,
+		UpdatedBy:           &createdBy,
+	}
+
+	for _, f := range m {
+		f(i)
+	}
+	return i
+}
+
+func TestGenerateCreateResourceAliasOptions(t *testing.T) {
+	type args struct {
+		params v1alpha1.ResourceAliasParameters
+	}
+	type want struct {
+		instance *rcv2.CreateResourceAliasOptions
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"FullConversion": {
+			args: args{params: *params()},
+			want: want{instance: &rcv2.CreateResourceAliasOptions{Name: &aliasName, Source: &instanceID, Target: &targetCRN}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &rcv2.CreateResourceAliasOptions{}
+			GenerateCreateResourceAliasOptions(tc.args.params, r)
+			if diff := cmp.Diff(tc.want.instance, r); diff != "" {
+				t.Errorf("GenerateCreateResourceAliasOptions(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateUpdateResourceAliasOptions(t *testing.T) {
+	type args struct {
+		id     string
+		params v1alpha1.ResourceAliasParameters
+	}
+	type want struct {
+		instance *rcv2.UpdateResourceAliasOptions
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"Rename": {
+			args: args{id: aliasID, params: *params(func(p *v1alpha1.ResourceAliasParameters) {
+				p.Name = aliasName2
+			})},
+			want: want{instance: &rcv2.UpdateResourceAliasOptions{ID: &aliasID, Name: &aliasName2}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &rcv2.UpdateResourceAliasOptions{}
+			GenerateUpdateResourceAliasOptions(tc.args.id, tc.args.params, r)
+			if diff := cmp.Diff(tc.want.instance, r); diff != "" {
+				t.Errorf("GenerateUpdateResourceAliasOptions(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSpecs(t *testing.T) {
+	type args struct {
+		instance *rcv2.ResourceAlias
+		params   *v1alpha1.ResourceAliasParameters
+	}
+	type want struct {
+		params *v1alpha1.ResourceAliasParameters
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"SomeFields": {
+			args: args{
+				params: params(func(p *v1alpha1.ResourceAliasParameters) {
+					p.Source = nil
+					p.Target = ""
+				}),
+				instance: instance(),
+			},
+			want: want{params: params()},
+		},
+		"AllFilledAlready": {
+			args: args{
+				params:   params(),
+				instance: instance(),
+			},
+			want: want{params: params()},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSpec(tc.args.params, tc.args.instance)
+			if diff := cmp.Diff(tc.want.params, tc.args.params); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	type args struct {
+		instance *rcv2.ResourceAlias
+	}
+	type want struct {
+		obs v1alpha1.ResourceAliasObservation
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"FullConversion": {
+			args: args{instance: instance()},
+			want: want{*observation()},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := GenerateObservation(tc.args.instance)
+			if diff := cmp.Diff(nil, err); diff != "" {
+				t.Errorf("GenerateObservation(...): want error != got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, o); diff != "" {
+				t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	type args struct {
+		params   *v1alpha1.ResourceAliasParameters
+		instance *rcv2.ResourceAlias
+	}
+	type want struct {
+		upToDate bool
+		isErr    bool
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"IsUpToDate": {
+			args: args{
+				params:   params(),
+				instance: instance(),
+			},
+			want: want{upToDate: true, isErr: false},
+		},
+		"IgnoresSourceFormat": {
+			args: args{
+				params: params(func(p *v1alpha1.ResourceAliasParameters) {
+					p.Source = &aliasID
+				}),
+				instance: instance(),
+			},
+			want: want{upToDate: true, isErr: false},
+		},
+		"NeedsUpdate": {
+			args: args{
+				params: params(func(p *v1alpha1.ResourceAliasParameters) {
+					p.Name = aliasName2
+				}),
+				instance: instance(),
+			},
+			want: want{upToDate: false, isErr: false},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r, err := IsUpToDate(tc.args.params, tc.args.instance, logging.NewNopLogger())
+			if err != nil && !tc.want.isErr {
+				t.Error("IsUpToDate(...) unexpected error")
+			}
+			if diff := cmp.Diff(tc.want.upToDate, r); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcegroup
+
+import (
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	rmgrv2 "github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/resourcemanagerv2/v1alpha1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+)
+
+const (
+	// StateActive represents a resource group in an active state
+	StateActive = "ACTIVE"
+	// StateDeleted represents a deleted resource group, which can still be retrieved for a while
+	StateDeleted = "DELETED"
+	// StatePendingReclamation represents a deleted resource group, whose removal is pending
+	StatePendingReclamation = "PENDING_RECLAMATION"
+)
+
+// IsActive returns true if the state of the resource group is active
+func IsActive(state string) bool {
+	return strings.EqualFold(state, StateActive)
+}
+
+// IsDeleted returns true if the state of the resource group is one of a deleted group
+func IsDeleted(state string) bool {
+	return strings.EqualFold(state, StateDeleted) || strings.EqualFold(state, StatePendingReclamation)
+}
+
+// LateInitializeSpec fills optional and unassigned fields with the values in *rmgrv2.ResourceGroup object.
+func LateInitializeSpec(spec *v1alpha1.ResourceGroupParameters, in *rmgrv2.ResourceGroup) error {
+	if spec.AccountID == nil {
+		spec.AccountID = in.AccountID
+	}
+	return nil
+}
+
+// GenerateCreateResourceGroupOptions produces CreateResourceGroupOptions object from ResourceGroupParameters object.
+func GenerateCreateResourceGroupOptions(in v1alpha1.ResourceGroupParameters, o *rmgrv2.CreateResourceGroupOptions) error {
+	o.Name = reference.ToPtrValue(in.Name)
+	o.AccountID = in.AccountID
+	return nil
+}
+
+// GenerateUpdateResourceGroupOptions produces UpdateResourceGroupOptions object from ResourceGroupParameters object.
+func GenerateUpdateResourceGroupOptions(id string, in v1alpha1.ResourceGroupParameters, o *rmgrv2.UpdateResourceGroupOptions) error {
+	o.ID = reference.ToPtrValue(id)
+	o.Name = reference.ToPtrValue(in.Name)
+	return nil
+}
+
+// GenerateObservation produces ResourceGroupObservation object from *rmgrv2.ResourceGroup object and
+// (optional) *rmgrv2.QuotaDefinition object.
+func GenerateObservation(in *rmgrv2.ResourceGroup, quota *rmgrv2.QuotaDefinition) (v1alpha1.ResourceGroupObservation, error) {
+	o := v1alpha1.ResourceGroupObservation{
+		ID:        reference.FromPtrValue(in.ID),
+		CRN:       reference.FromPtrValue(in.CRN),
+		AccountID: reference.FromPtrValue(in.AccountID),
+		State:     reference.FromPtrValue(in.State),
+		Default:   ibmc.BoolValue(in.Default),
+		QuotaID:   reference.FromPtrValue(in.QuotaID),
+		QuotaURL:  reference.FromPtrValue(in.QuotaURL),
+		Quota:     GenerateQuotaDefinitionObservation(quota),
+		CreatedAt: ibmc.DateTimeToMetaV1Time(in.CreatedAt),
+		UpdatedAt: ibmc.DateTimeToMetaV1Time(in.UpdatedAt),
+	}
+	return o, nil
+}
+
+// GenerateQuotaDefinitionObservation produces QuotaDefinitionObservation object from *rmgrv2.QuotaDefinition object.
+func GenerateQuotaDefinitionObservation(in *rmgrv2.QuotaDefinition) *v1alpha1.QuotaDefinitionObservation {
+	if in == nil {
+		return nil
+	}
+	o := &v1alpha1.QuotaDefinitionObservation{
+		ID:                                  reference.FromPtrValue(in.ID),
+		Name:                                reference.FromPtrValue(in.Name),
+		Type:                                reference.FromPtrValue(in.Type),
+		NumberOfApps:                        int64FromFloat64Ptr(in.NumberOfApps),
+		NumberOfServiceInstances:            int64FromFloat64Ptr(in.NumberOfServiceInstances),
+		DefaultNumberOfInstancesPerLitePlan: int64FromFloat64Ptr(in.DefaultNumberOfInstancesPerLitePlan),
+		InstancesPerApp:                     int64FromFloat64Ptr(in.InstancesPerApp),
+		InstanceMemory:                      reference.FromPtrValue(in.InstanceMemory),
+		TotalAppMemory:                      reference.FromPtrValue(in.TotalAppMemory),
+		VSILimit:                            int64FromFloat64Ptr(in.VsiLimit),
+	}
+	for _, q := range in.ResourceQuotas {
+		o.ResourceQuotas = append(o.ResourceQuotas, v1alpha1.ResourceQuota{
+			ID:         reference.FromPtrValue(q.ID),
+			ResourceID: reference.FromPtrValue(q.ResourceID),
+			CRN:        reference.FromPtrValue(q.CRN),
+			Limit:      int64FromFloat64Ptr(q.Limit),
+		})
+	}
+	return o
+}
+
+func int64FromFloat64Ptr(f *float64) int64 {
+	if f == nil {
+		return 0
+	}
+	return int64(*f)
+}
+
+// IsUpToDate checks whether current state is up-to-date compared to the given set of parameters.
+func IsUpToDate(in *v1alpha1.ResourceGroupParameters, observed *rmgrv2.ResourceGroup, l logging.Logger) (bool, error) {
+	desired := in.DeepCopy()
+	actual, err := GenerateResourceGroupParameters(observed)
+	if err != nil {
+		return false, err
+	}
+
+	diff := cmp.Diff(desired, actual, cmpopts.EquateEmpty())
+	if diff != "" {
+		l.Info("IsUpToDate", "Diff", diff)
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// GenerateResourceGroupParameters generates resource group parameters from a resource group
+func GenerateResourceGroupParameters(in *rmgrv2.ResourceGroup) (*v1alpha1.ResourceGroupParameters, error) {
+	o := &v1alpha1.ResourceGroupParameters{
+		Name:      reference.FromPtrValue(in.Name),
+		AccountID: in.AccountID,
+	}
+	return o, nil
+}
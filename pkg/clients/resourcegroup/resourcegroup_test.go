@@ -0,0 +1,292 @@
+package resourcegroup
+
+import (
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/go-cmp/cmp"
+
+	rmgrv2 "github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/resourcemanagerv2/v1alpha1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+)
+
+var (
+	accountID    = "aa5a00334eaf9eb9339d2ab48f20d7ff"
+	rgName       = "tenant-a"
+	rgName2      = "tenant-b"
+	rgID         = "0be5ad401ae913d8ff665d92680664ed"
+	rgCRN        = "crn:v1:bluemix:public:resource-controller::a/aa5a00334eaf9eb9339d2ab48f20d7ff::resource-group:0be5ad401ae913d8ff665d92680664ed"
+	rgState      = "ACTIVE"
+	rgDefault    = false
+	quotaID      = "7ce89f4a4e2f4ac0b5c8b7a3a0f6a5a4"
+	quotaURL     = "/v2/quota_definitions/7ce89f4a4e2f4ac0b5c8b7a3a0f6a5a4"
+	quotaName    = "Trial Quota"
+	quotaType    = "Trial"
+	vsiLimit     = float64(25)
+	limit        = float64(4)
+	createdAt, _ = strfmt.ParseDateTime("2020-10-31T02:33:06Z")
+	updatedAt, _ = strfmt.ParseDateTime("2020-10-31T03:33:06Z")
+)
+
+func params(m ...func(*v1alpha1.ResourceGroupParameters)) *v1alpha1.ResourceGroupParameters {
+	p := &v1alpha1.ResourceGroupParameters{
+		Name:      rgName,
+		AccountID: &accountID,
+	}
+
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func quota() *rmgrv2.QuotaDefinition {
+	return &rmgrv2.QuotaDefinition{
+		ID:       &quotaID,
+		Name:     &quotaName,
+		Type:     &quotaType,
+		VsiLimit: &vsiLimit,
+		ResourceQuotas: []rmgrv2.ResourceQuota{
+			{
+				ResourceID: &rgName,
+				Limit:      &limit,
+			},
+		},
+	}
+}
+
+func observation(m ...func(*v1alpha1.ResourceGroupObservation)) *v1alpha1.ResourceGroupObservation {
+	o := &v1alpha1.ResourceGroupObservation{
+		ID:        rgID,
+		CRN:       rgCRN,
+		AccountID: accountID,
+		State:     rgState,
+		Default:   rgDefault,
+		QuotaID:   quotaID,
+		QuotaURL:  quotaURL,
+		Quota: &v1alpha1.QuotaDefinitionObservation{
+			ID:       quotaID,
+			Name:     quotaName,
+			Type:     quotaType,
+			VSILimit: int64(vsiLimit),
+			ResourceQuotas: []v1alpha1.ResourceQuota{
+				{
+					ResourceID: rgName,
+					Limit:      int64(limit),
+				},
+			},
+		},
+		CreatedAt: ibmc.DateTimeToMetaV1Time(&createdAt),
+		UpdatedAt: ibmc.DateTimeToMetaV1Time(&updatedAt),
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+	return o
+}
+
+func instance(m ...func(*rmgrv2.ResourceGroup)) *rmgrv2.ResourceGroup {
+	i := &rmgrv2.ResourceGroup{
+		ID:        &rgID,
+		CRN:       &rgCRN,
+		AccountID: &accountID,
+		Name:      &rgName,
+		State:     &rgState,
+		Default:   &rgDefault,
+		QuotaID:   &quotaID,
+		QuotaURL:  &quotaURL,
+		CreatedAt: &createdAt,
+		UpdatedAt: &updatedAt,
+	}
+
+	for _, f := range m {
+		f(i)
+	}
+	return i
+}
+
+func TestGenerateCreateResourceGroupOptions(t *testing.T) {
+	type args struct {
+		params v1alpha1.ResourceGroupParameters
+	}
+	type want struct {
+		instance *rmgrv2.CreateResourceGroupOptions
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"FullConversion": {
+			args: args{params: *params()},
+			want: want{instance: &rmgrv2.CreateResourceGroupOptions{Name: &rgName, AccountID: &accountID}},
+		},
+		"MissingFields": {
+			args: args{
+				params: *params(func(p *v1alpha1.ResourceGroupParameters) {
+					p.AccountID = nil
+				})},
+			want: want{instance: &rmgrv2.CreateResourceGroupOptions{Name: &rgName}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &rmgrv2.CreateResourceGroupOptions{}
+			GenerateCreateResourceGroupOptions(tc.args.params, r)
+			if diff := cmp.Diff(tc.want.instance, r); diff != "" {
+				t.Errorf("GenerateCreateResourceGroupOptions(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateUpdateResourceGroupOptions(t *testing.T) {
+	type args struct {
+		id     string
+		params v1alpha1.ResourceGroupParameters
+	}
+	type want struct {
+		instance *rmgrv2.UpdateResourceGroupOptions
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"Rename": {
+			args: args{id: rgID, params: *params(func(p *v1alpha1.ResourceGroupParameters) {
+				p.Name = rgName2
+			})},
+			want: want{instance: &rmgrv2.UpdateResourceGroupOptions{ID: &rgID, Name: &rgName2}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &rmgrv2.UpdateResourceGroupOptions{}
+			GenerateUpdateResourceGroupOptions(tc.args.id, tc.args.params, r)
+			if diff := cmp.Diff(tc.want.instance, r); diff != "" {
+				t.Errorf("GenerateUpdateResourceGroupOptions(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSpecs(t *testing.T) {
+	type args struct {
+		instance *rmgrv2.ResourceGroup
+		params   *v1alpha1.ResourceGroupParameters
+	}
+	type want struct {
+		params *v1alpha1.ResourceGroupParameters
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"SomeFields": {
+			args: args{
+				params: params(func(p *v1alpha1.ResourceGroupParameters) {
+					p.AccountID = nil
+				}),
+				instance: instance(),
+			},
+			want: want{params: params()},
+		},
+		"AllFilledAlready": {
+			args: args{
+				params:   params(),
+				instance: instance(),
+			},
+			want: want{params: params()},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSpec(tc.args.params, tc.args.instance)
+			if diff := cmp.Diff(tc.want.params, tc.args.params); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	type args struct {
+		instance *rmgrv2.ResourceGroup
+		quota    *rmgrv2.QuotaDefinition
+	}
+	type want struct {
+		obs v1alpha1.ResourceGroupObservation
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"FullConversion": {
+			args: args{instance: instance(), quota: quota()},
+			want: want{*observation()},
+		},
+		"NoQuota": {
+			args: args{instance: instance()},
+			want: want{*observation(func(o *v1alpha1.ResourceGroupObservation) {
+				o.Quota = nil
+			})},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := GenerateObservation(tc.args.instance, tc.args.quota)
+			if diff := cmp.Diff(nil, err); diff != "" {
+				t.Errorf("GenerateObservation(...): want error != got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, o); diff != "" {
+				t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	type args struct {
+		params   *v1alpha1.ResourceGroupParameters
+		instance *rmgrv2.ResourceGroup
+	}
+	type want struct {
+		upToDate bool
+		isErr    bool
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"IsUpToDate": {
+			args: args{
+				params:   params(),
+				instance: instance(),
+			},
+			want: want{upToDate: true, isErr: false},
+		},
+		"NeedsUpdate": {
+			args: args{
+				params: params(func(p *v1alpha1.ResourceGroupParameters) {
+					p.Name = rgName2
+				}),
+				instance: instance(),
+			},
+			want: want{upToDate: false, isErr: false},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r, err := IsUpToDate(tc.args.params, tc.args.instance, logging.NewNopLogger())
+			if err != nil && !tc.want.isErr {
+				t.Error("IsUpToDate(...) unexpected error")
+			}
+			if diff := cmp.Diff(tc.want.upToDate, r); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
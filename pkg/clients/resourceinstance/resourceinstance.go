@@ -17,16 +17,22 @@ limitations under the License.
 package resourceinstance
 
 import (
+	"strings"
+
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
 
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
+	gcat "github.com/IBM/platform-services-go-sdk/globalcatalogv1"
 	rcv2 "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 
 	"github.com/crossplane-contrib/provider-ibm-cloud/apis/resourcecontrollerv2/v1alpha1"
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
 	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
 )
 
@@ -62,7 +68,11 @@ func LateInitializeSpec(client ibmc.ClientSession, spec *v1alpha1.ResourceInstan
 
 // GenerateCreateResourceInstanceOptions produces CreateResourceInstanceOptions object from ResourceInstanceParameters object.
 func GenerateCreateResourceInstanceOptions(client ibmc.ClientSession, in v1alpha1.ResourceInstanceParameters, o *rcv2.CreateResourceInstanceOptions) error {
-	rgID, err := ibmc.GetResourceGroupID(client, in.ResourceGroupName)
+	rgName := in.ResourceGroupName
+	if rgName == nil {
+		rgName = client.ProviderConfig().DefaultResourceGroupName
+	}
+	rgID, err := ibmc.GetResourceGroupID(client, rgName)
 	if err != nil {
 		return errors.Wrap(err, errGetResGroupID)
 	}
@@ -77,12 +87,36 @@ func GenerateCreateResourceInstanceOptions(client ibmc.ClientSession, in v1alpha
 	o.ResourceGroup = rgID
 	o.ResourcePlanID = rPlanID
 	o.Tags = in.Tags
+	if o.Tags == nil {
+		o.Tags = ibmc.DefaultTags(client.ProviderConfig(), in.Tags)
+	}
 	o.AllowCleanup = in.AllowCleanup
 	o.Parameters = ibmc.RawExtensionToMap(in.Parameters)
 
 	return nil
 }
 
+// ApplyProviderConfigDefaults sets the tags and resource group of a ResourceInstance to the defaults of its
+// ProviderConfig, when not specified.
+func ApplyProviderConfigDefaults(pc *v1beta1.ProviderConfigSpec, mg resource.Managed) bool {
+	cr, ok := mg.(*v1alpha1.ResourceInstance)
+	if !ok {
+		return false
+	}
+	changed := false
+	spec := &cr.Spec.ForProvider
+	if tags := ibmc.DefaultTags(pc, spec.Tags); tags != nil {
+		spec.Tags = tags
+		changed = true
+	}
+	if spec.ResourceGroupName == nil && spec.ResourceGroupNameRef == nil && spec.ResourceGroupNameSelector == nil &&
+		pc.DefaultResourceGroupName != nil {
+		spec.ResourceGroupName = reference.ToPtrValue(*pc.DefaultResourceGroupName)
+		changed = true
+	}
+	return changed
+}
+
 // GenerateUpdateResourceInstanceOptions produces UpdateResourceInstanceOptions object from ResourceInstanceParameters object.
 func GenerateUpdateResourceInstanceOptions(client ibmc.ClientSession, id string, in v1alpha1.ResourceInstanceParameters, o *rcv2.UpdateResourceInstanceOptions) error {
 	rPlanID, err := ibmc.GetResourcePlanID(client, in.ServiceName, in.ResourcePlanName)
@@ -169,7 +203,8 @@ func IsUpToDate(client ibmc.ClientSession, in *v1alpha1.ResourceInstanceParamete
 
 	diff := (cmp.Diff(desired, actual,
 		cmpopts.EquateEmpty(),
-		cmpopts.IgnoreFields(v1alpha1.ResourceInstanceParameters{})))
+		cmpopts.IgnoreFields(v1alpha1.ResourceInstanceParameters{}, "AccessTags"),
+		cmpopts.IgnoreTypes(&runtimev1alpha1.Reference{}, &runtimev1alpha1.Selector{})))
 
 	if diff != "" {
 		l.Info("IsUpToDate", "Diff", diff)
@@ -210,3 +245,20 @@ func GenerateResourceInstanceParameters(client ibmc.ClientSession, in *rcv2.Reso
 
 	return o, nil
 }
+
+// MonthlyUsage is the usage of a resource instance in cost estimates: the instance itself, for the whole month.
+// Metrics charged by consumption (e.g. storage or API calls) are not estimated.
+func MonthlyUsage(m gcat.Metrics) (float64, bool) {
+	if !strings.Contains(strings.ToUpper(reference.FromPtrValue(m.ChargeUnitName)), "INSTANCE") {
+		return 0, false
+	}
+	if ibmc.IsHourlyMetric(m) {
+		return ibmc.HoursPerMonth, true
+	}
+	return 1, true
+}
+
+// EstimateMonthlyCost returns the estimated monthly cost of a resource instance of the given plan.
+func EstimateMonthlyCost(client ibmc.ClientSession, planID string) (float64, error) {
+	return ibmc.EstimatePlanMonthlyCost(client, planID, MonthlyUsage)
+}
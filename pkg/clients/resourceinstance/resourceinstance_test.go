@@ -24,12 +24,14 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 
 	rcv2 "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 
 	"github.com/crossplane-contrib/provider-ibm-cloud/apis/resourcecontrollerv2/v1alpha1"
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
 	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
 )
 
@@ -220,6 +222,76 @@ func TestGenerateCreateResourceInstanceOptions(t *testing.T) {
 	}
 }
 
+func TestApplyProviderConfigDefaults(t *testing.T) {
+	pc := &v1beta1.ProviderConfigSpec{
+		DefaultTags:              []string{"team:a"},
+		DefaultResourceGroupName: reference.ToPtrValue("tenant-a"),
+	}
+	type want struct {
+		params  *v1alpha1.ResourceInstanceParameters
+		changed bool
+	}
+	cases := map[string]struct {
+		pc     *v1beta1.ProviderConfigSpec
+		params *v1alpha1.ResourceInstanceParameters
+		want   want
+	}{
+		"NoDefaults": {
+			pc: &v1beta1.ProviderConfigSpec{},
+			params: params(func(p *v1alpha1.ResourceInstanceParameters) {
+				p.Tags = nil
+				p.ResourceGroupName = nil
+			}),
+			want: want{params: params(func(p *v1alpha1.ResourceInstanceParameters) {
+				p.Tags = nil
+				p.ResourceGroupName = nil
+			})},
+		},
+		"Defaulted": {
+			pc: pc,
+			params: params(func(p *v1alpha1.ResourceInstanceParameters) {
+				p.Tags = nil
+				p.ResourceGroupName = nil
+			}),
+			want: want{
+				params: params(func(p *v1alpha1.ResourceInstanceParameters) {
+					p.Tags = []string{"team:a"}
+					p.ResourceGroupName = reference.ToPtrValue("tenant-a")
+				}),
+				changed: true,
+			},
+		},
+		"ResourceGroupReferenced": {
+			pc: pc,
+			params: params(func(p *v1alpha1.ResourceInstanceParameters) {
+				p.ResourceGroupName = nil
+				p.ResourceGroupNameRef = &runtimev1alpha1.Reference{Name: "tenant-b"}
+			}),
+			want: want{params: params(func(p *v1alpha1.ResourceInstanceParameters) {
+				p.ResourceGroupName = nil
+				p.ResourceGroupNameRef = &runtimev1alpha1.Reference{Name: "tenant-b"}
+			})},
+		},
+		"AlreadySet": {
+			pc:     pc,
+			params: params(),
+			want:   want{params: params()},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &v1alpha1.ResourceInstance{Spec: v1alpha1.ResourceInstanceSpec{ForProvider: *tc.params}}
+			changed := ApplyProviderConfigDefaults(tc.pc, cr)
+			if diff := cmp.Diff(tc.want.changed, changed); diff != "" {
+				t.Errorf("ApplyProviderConfigDefaults(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.params, &cr.Spec.ForProvider); diff != "" {
+				t.Errorf("ApplyProviderConfigDefaults(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 // Test GenerateUpdateResourceInstanceOptions method
 func TestGenerateUpdateResourceInstanceOptions(t *testing.T) {
 	type args struct {
@@ -31,6 +31,7 @@ import (
 	rcv2 "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 
 	"github.com/crossplane-contrib/provider-ibm-cloud/apis/resourcecontrollerv2/v1alpha1"
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
 	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
 )
 
@@ -116,7 +117,7 @@ func IsUpToDate(client ibmc.ClientSession, in *v1alpha1.ResourceKeyParameters, o
 
 	diff := (cmp.Diff(desired, actual,
 		cmpopts.EquateEmpty(),
-		cmpopts.IgnoreFields(v1alpha1.ResourceKeyParameters{}, "Source", "Parameters"), cmpopts.IgnoreTypes(&runtimev1alpha1.Reference{}, &runtimev1alpha1.Selector{}, []runtimev1alpha1.Reference{})))
+		cmpopts.IgnoreFields(v1alpha1.ResourceKeyParameters{}, "Source", "Parameters", "Tags", "AccessTags"), cmpopts.IgnoreTypes(&runtimev1alpha1.Reference{}, &runtimev1alpha1.Selector{}, &v1beta1.GenericReference{}, []runtimev1alpha1.Reference{})))
 
 	if diff != "" {
 		fmt.Printf(">>> %s\n", diff)
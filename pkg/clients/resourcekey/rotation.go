@@ -0,0 +1,217 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcekey
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/resourcecontrollerv2/v1alpha1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+)
+
+const (
+	// AnnotationRotatedKeys holds the IDs of the keys replaced by a rotation which have not been deleted yet,
+	// with the time after which each of them is deleted. Unlike the status, it is saved together with the
+	// external name of the new key, so that a replaced key is never left behind.
+	AnnotationRotatedKeys = "ibmcloud.crossplane.io/rotated-keys"
+
+	// maxRotationHistory is the number of deleted keys kept in the rotation history. Keys which
+	// are still within their overlap window are always kept.
+	maxRotationHistory = 10
+
+	errRotationIntervalOrSchedule = "exactly one of rotation interval or schedule must be set"
+	errRotationInterval           = "rotation interval must be positive"
+	errParseRotatedKeys           = "cannot parse the " + AnnotationRotatedKeys + " annotation"
+)
+
+// NextRotationTime returns the time of the first rotation after the given one.
+func NextRotationTime(r *v1alpha1.ResourceKeyRotation, from time.Time) (time.Time, error) {
+	if (r.Interval == nil) == (r.Schedule == nil) {
+		return time.Time{}, errors.New(errRotationIntervalOrSchedule)
+	}
+	if r.Interval != nil {
+		if r.Interval.Duration <= 0 {
+			return time.Time{}, errors.New(errRotationInterval)
+		}
+		return from.Add(r.Interval.Duration), nil
+	}
+	s, err := ibmc.ParseCronSchedule(*r.Schedule)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return s.Next(from), nil
+}
+
+// IsRotationDue refreshes the next rotation time in the status of the given key and returns
+// true if the key has to be rotated at the given time. Keys without a rotation never are.
+func IsRotationDue(cr *v1alpha1.ResourceKey, now time.Time) (bool, error) {
+	if cr.Spec.Rotation == nil {
+		if cr.Status.Rotation != nil {
+			cr.Status.Rotation.NextRotationTime = nil
+		}
+		return false, nil
+	}
+	if cr.Status.Rotation == nil {
+		cr.Status.Rotation = &v1alpha1.ResourceKeyRotationStatus{}
+	}
+
+	from := cr.Status.AtProvider.CreatedAt
+	if cr.Status.Rotation.LastRotationTime != nil {
+		from = cr.Status.Rotation.LastRotationTime
+	}
+	if from == nil {
+		return false, nil
+	}
+
+	next, err := NextRotationTime(cr.Spec.Rotation, from.Time)
+	if err != nil {
+		return false, err
+	}
+	if next.IsZero() {
+		cr.Status.Rotation.NextRotationTime = nil
+		return false, nil
+	}
+	cr.Status.Rotation.NextRotationTime = &metav1.Time{Time: next}
+	return !now.Before(next), nil
+}
+
+// RecordRotation records that the key with the given ID has been replaced at the given time, both in
+// the AnnotationRotatedKeys annotation of the given key, which drives its deletion, and in its status.
+func RecordRotation(cr *v1alpha1.ResourceKey, replacedID string, now time.Time) error {
+	keys, err := rotatedKeys(cr)
+	if err != nil {
+		return err
+	}
+	if cr.Status.Rotation == nil {
+		cr.Status.Rotation = &v1alpha1.ResourceKeyRotationStatus{}
+	}
+	overlap := time.Duration(0)
+	if cr.Spec.Rotation != nil && cr.Spec.Rotation.Overlap != nil {
+		overlap = cr.Spec.Rotation.Overlap.Duration
+	}
+
+	rotatedAt := metav1.Time{Time: now}
+	deleteAfter := metav1.Time{Time: now.Add(overlap)}
+	keys[replacedID] = deleteAfter
+	setRotatedKeys(cr, keys)
+
+	cr.Status.Rotation.LastRotationTime = &rotatedAt
+	cr.Status.Rotation.NextRotationTime = nil
+	cr.Status.Rotation.History = append(cr.Status.Rotation.History, v1alpha1.RotatedResourceKey{
+		ID:          replacedID,
+		RotatedAt:   rotatedAt,
+		DeleteAfter: deleteAfter,
+	})
+	return nil
+}
+
+// ExpiredRotatedKeys returns the IDs of the replaced keys whose overlap window has passed
+// at the given time and which have not been deleted yet.
+func ExpiredRotatedKeys(cr *v1alpha1.ResourceKey, now time.Time) ([]string, error) {
+	keys, err := rotatedKeys(cr)
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for id, deleteAfter := range keys {
+		if !now.Before(deleteAfter.Time) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// PendingRotatedKeys returns the IDs of the replaced keys which have not been deleted yet.
+func PendingRotatedKeys(cr *v1alpha1.ResourceKey) ([]string, error) {
+	keys, err := rotatedKeys(cr)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(keys))
+	for id := range keys {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// MarkRotatedKeyDeleted records the deletion of a replaced key, removing it from the AnnotationRotatedKeys
+// annotation and trimming the history of deleted keys.
+func MarkRotatedKeyDeleted(cr *v1alpha1.ResourceKey, id string, now time.Time) error {
+	keys, err := rotatedKeys(cr)
+	if err != nil {
+		return err
+	}
+	delete(keys, id)
+	setRotatedKeys(cr, keys)
+
+	s := cr.Status.Rotation
+	if s == nil {
+		return nil
+	}
+	deleted := 0
+	for i := range s.History {
+		if s.History[i].ID == id && s.History[i].DeletedAt == nil {
+			s.History[i].DeletedAt = &metav1.Time{Time: now}
+		}
+		if s.History[i].DeletedAt != nil {
+			deleted++
+		}
+	}
+
+	history := make([]v1alpha1.RotatedResourceKey, 0, len(s.History))
+	for _, k := range s.History {
+		if k.DeletedAt != nil && deleted > maxRotationHistory {
+			deleted--
+			continue
+		}
+		history = append(history, k)
+	}
+	s.History = history
+	return nil
+}
+
+// rotatedKeys returns the replaced keys recorded in the AnnotationRotatedKeys annotation of the given key
+func rotatedKeys(cr *v1alpha1.ResourceKey) (map[string]metav1.Time, error) {
+	keys := map[string]metav1.Time{}
+	v, ok := cr.GetAnnotations()[AnnotationRotatedKeys]
+	if !ok || v == "" {
+		return keys, nil
+	}
+	if err := json.Unmarshal([]byte(v), &keys); err != nil {
+		return nil, errors.Wrap(err, errParseRotatedKeys)
+	}
+	return keys, nil
+}
+
+// setRotatedKeys records the given replaced keys in the AnnotationRotatedKeys annotation of the given key
+func setRotatedKeys(cr *v1alpha1.ResourceKey, keys map[string]metav1.Time) {
+	if len(keys) == 0 {
+		meta.RemoveAnnotations(cr, AnnotationRotatedKeys)
+		return
+	}
+	// a map of strings and times cannot fail to marshal
+	v, _ := json.Marshal(keys)
+	meta.AddAnnotations(cr, map[string]string{AnnotationRotatedKeys: string(v)})
+}
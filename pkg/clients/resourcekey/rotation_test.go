@@ -0,0 +1,175 @@
+package resourcekey
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/resourcecontrollerv2/v1alpha1"
+)
+
+func TestNextRotationTime(t *testing.T) {
+	from := time.Date(2021, time.March, 10, 14, 37, 0, 0, time.UTC)
+	schedule := "0 3 1 */3 *"
+	badSchedule := "0 3 1"
+
+	cases := map[string]struct {
+		rotation v1alpha1.ResourceKeyRotation
+		next     time.Time
+		isErr    bool
+	}{
+		"Interval": {
+			rotation: v1alpha1.ResourceKeyRotation{Interval: &metav1.Duration{Duration: 90 * 24 * time.Hour}},
+			next:     from.Add(90 * 24 * time.Hour),
+		},
+		"Schedule": {
+			rotation: v1alpha1.ResourceKeyRotation{Schedule: &schedule},
+			next:     time.Date(2021, time.April, 1, 3, 0, 0, 0, time.UTC),
+		},
+		"Neither": {
+			isErr: true,
+		},
+		"Both": {
+			rotation: v1alpha1.ResourceKeyRotation{Interval: &metav1.Duration{Duration: time.Hour}, Schedule: &schedule},
+			isErr:    true,
+		},
+		"NonPositiveInterval": {
+			rotation: v1alpha1.ResourceKeyRotation{Interval: &metav1.Duration{}},
+			isErr:    true,
+		},
+		"BadSchedule": {
+			rotation: v1alpha1.ResourceKeyRotation{Schedule: &badSchedule},
+			isErr:    true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			next, err := NextRotationTime(&tc.rotation, from)
+			if diff := cmp.Diff(tc.isErr, err != nil); diff != "" {
+				t.Errorf("NextRotationTime(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.next, next); diff != "" {
+				t.Errorf("NextRotationTime(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsRotationDue(t *testing.T) {
+	now := time.Date(2021, time.March, 10, 14, 37, 0, 0, time.UTC)
+	created := metav1.NewTime(now.Add(-100 * 24 * time.Hour))
+	lastRotation := metav1.NewTime(now.Add(-10 * 24 * time.Hour))
+	rotation := &v1alpha1.ResourceKeyRotation{Interval: &metav1.Duration{Duration: 90 * 24 * time.Hour}}
+
+	cases := map[string]struct {
+		cr   *v1alpha1.ResourceKey
+		due  bool
+		next *metav1.Time
+	}{
+		"NoRotation": {
+			cr: &v1alpha1.ResourceKey{},
+		},
+		"DueSinceCreation": {
+			cr: &v1alpha1.ResourceKey{
+				Spec:   v1alpha1.ResourceKeySpec{Rotation: rotation},
+				Status: v1alpha1.ResourceKeyStatus{AtProvider: v1alpha1.ResourceKeyObservation{CreatedAt: &created}},
+			},
+			due:  true,
+			next: &metav1.Time{Time: created.Add(90 * 24 * time.Hour)},
+		},
+		"NotDueSinceLastRotation": {
+			cr: &v1alpha1.ResourceKey{
+				Spec: v1alpha1.ResourceKeySpec{Rotation: rotation},
+				Status: v1alpha1.ResourceKeyStatus{
+					AtProvider: v1alpha1.ResourceKeyObservation{CreatedAt: &created},
+					Rotation:   &v1alpha1.ResourceKeyRotationStatus{LastRotationTime: &lastRotation},
+				},
+			},
+			next: &metav1.Time{Time: lastRotation.Add(90 * 24 * time.Hour)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			due, err := IsRotationDue(tc.cr, now)
+			if err != nil {
+				t.Errorf("IsRotationDue(...): unexpected error %s", err)
+			}
+			if diff := cmp.Diff(tc.due, due); diff != "" {
+				t.Errorf("IsRotationDue(...): -want, +got:\n%s", diff)
+			}
+			var next *metav1.Time
+			if tc.cr.Status.Rotation != nil {
+				next = tc.cr.Status.Rotation.NextRotationTime
+			}
+			if diff := cmp.Diff(tc.next, next); diff != "" {
+				t.Errorf("IsRotationDue(...): next rotation -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRotationHistory(t *testing.T) {
+	now := time.Date(2021, time.March, 10, 14, 37, 0, 0, time.UTC)
+	cr := &v1alpha1.ResourceKey{
+		Spec: v1alpha1.ResourceKeySpec{Rotation: &v1alpha1.ResourceKeyRotation{
+			Interval: &metav1.Duration{Duration: time.Hour},
+			Overlap:  &metav1.Duration{Duration: 30 * time.Minute},
+		}},
+	}
+
+	if err := RecordRotation(cr, "key-0", now); err != nil {
+		t.Fatalf("RecordRotation(...): %s", err)
+	}
+	expired, _ := ExpiredRotatedKeys(cr, now.Add(29*time.Minute))
+	if diff := cmp.Diff([]string(nil), expired); diff != "" {
+		t.Errorf("ExpiredRotatedKeys(...): -want, +got:\n%s", diff)
+	}
+	expired, _ = ExpiredRotatedKeys(cr, now.Add(30*time.Minute))
+	if diff := cmp.Diff([]string{"key-0"}, expired); diff != "" {
+		t.Errorf("ExpiredRotatedKeys(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(&metav1.Time{Time: now}, cr.Status.Rotation.LastRotationTime); diff != "" {
+		t.Errorf("RecordRotation(...): -want, +got:\n%s", diff)
+	}
+
+	// the replaced keys are tracked by the annotation, even when the status is lost
+	lost := cr.DeepCopy()
+	lost.Status = v1alpha1.ResourceKeyStatus{}
+	expired, _ = ExpiredRotatedKeys(lost, now.Add(30*time.Minute))
+	if diff := cmp.Diff([]string{"key-0"}, expired); diff != "" {
+		t.Errorf("ExpiredRotatedKeys(...): status lost -want, +got:\n%s", diff)
+	}
+
+	// rotate and delete many more keys than are kept in the history
+	for i := 1; i <= 2*maxRotationHistory; i++ {
+		_ = RecordRotation(cr, fmt.Sprintf("key-%d", i), now)
+		_ = MarkRotatedKeyDeleted(cr, fmt.Sprintf("key-%d", i-1), now)
+	}
+	pending := fmt.Sprintf("key-%d", 2*maxRotationHistory)
+	pendingKeys, _ := PendingRotatedKeys(cr)
+	if diff := cmp.Diff([]string{pending}, pendingKeys); diff != "" {
+		t.Errorf("PendingRotatedKeys(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(maxRotationHistory+1, len(cr.Status.Rotation.History)); diff != "" {
+		t.Errorf("MarkRotatedKeyDeleted(...): history length -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(pending, cr.Status.Rotation.History[maxRotationHistory].ID); diff != "" {
+		t.Errorf("MarkRotatedKeyDeleted(...): -want, +got:\n%s", diff)
+	}
+
+	_ = MarkRotatedKeyDeleted(cr, pending, now)
+	if _, ok := cr.GetAnnotations()[AnnotationRotatedKeys]; ok {
+		t.Errorf("MarkRotatedKeyDeleted(...): annotation %s not removed", AnnotationRotatedKeys)
+	}
+}
+
+func TestRotatedKeysInvalidAnnotation(t *testing.T) {
+	cr := &v1alpha1.ResourceKey{}
+	cr.SetAnnotations(map[string]string{AnnotationRotatedKeys: "key-0"})
+	if _, err := PendingRotatedKeys(cr); err == nil {
+		t.Errorf("PendingRotatedKeys(...): want error, got nil")
+	}
+}
@@ -0,0 +1,187 @@
+package rule
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/contextbasedrestrictionsv1/v1alpha1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/cbr"
+)
+
+const (
+	// StateActive represents a rule that exists, and is applied according to its enforcement mode. The API does
+	// not report the state of a rule, so this is the state of any rule it returns
+	StateActive = "active"
+)
+
+// LateInitializeSpec fills optional and unassigned fields with the values in *cbr.Rule object.
+func LateInitializeSpec(spec *v1alpha1.RuleParameters, in *cbr.Rule) error {
+	if spec.Description == nil {
+		spec.Description = in.Description
+	}
+	if spec.EnforcementMode == nil {
+		spec.EnforcementMode = in.EnforcementMode
+	}
+	for i, r := range spec.Resources {
+		if i >= len(in.Resources) {
+			break
+		}
+		for j, attr := range r.Attributes {
+			if attr.Operator == nil && j < len(in.Resources[i].Attributes) {
+				spec.Resources[i].Attributes[j].Operator = in.Resources[i].Attributes[j].Operator
+			}
+		}
+		for j, tag := range r.Tags {
+			if tag.Operator == nil && j < len(in.Resources[i].Tags) {
+				spec.Resources[i].Tags[j].Operator = in.Resources[i].Tags[j].Operator
+			}
+		}
+	}
+	return nil
+}
+
+// GenerateCreateRuleOptions produces CreateRuleOptions object from RuleParameters object.
+func GenerateCreateRuleOptions(in v1alpha1.RuleParameters, o *cbr.CreateRuleOptions) error {
+	o.Description = in.Description
+	o.Contexts = GenerateSDKContexts(in.Contexts)
+	o.Resources = GenerateSDKResources(in.Resources)
+	o.EnforcementMode = in.EnforcementMode
+	return nil
+}
+
+// GenerateReplaceRuleOptions produces ReplaceRuleOptions object from RuleParameters object.
+func GenerateReplaceRuleOptions(id, eTag string, in v1alpha1.RuleParameters, o *cbr.ReplaceRuleOptions) error {
+	o.RuleID = reference.ToPtrValue(id)
+	o.IfMatch = reference.ToPtrValue(eTag)
+	o.Description = in.Description
+	o.Contexts = GenerateSDKContexts(in.Contexts)
+	o.Resources = GenerateSDKResources(in.Resources)
+	o.EnforcementMode = in.EnforcementMode
+	return nil
+}
+
+// GenerateObservation produces RuleObservation object from *cbr.Rule object.
+func GenerateObservation(in *cbr.Rule) (v1alpha1.RuleObservation, error) {
+	o := v1alpha1.RuleObservation{
+		ID:               reference.FromPtrValue(in.ID),
+		CRN:              reference.FromPtrValue(in.CRN),
+		Href:             reference.FromPtrValue(in.Href),
+		CreatedAt:        ibmc.DateTimeToMetaV1Time(in.CreatedAt),
+		CreatedByID:      reference.FromPtrValue(in.CreatedByID),
+		LastModifiedAt:   ibmc.DateTimeToMetaV1Time(in.LastModifiedAt),
+		LastModifiedByID: reference.FromPtrValue(in.LastModifiedByID),
+		State:            StateActive,
+	}
+	return o, nil
+}
+
+// IsUpToDate checks whether current state is up-to-date compared to the given
+// set of parameters.
+func IsUpToDate(in *v1alpha1.RuleParameters, observed *cbr.Rule, l logging.Logger) (bool, error) {
+	desired := in.DeepCopy()
+	actual, err := GenerateRuleParameters(observed)
+	if err != nil {
+		return false, err
+	}
+
+	l.Info(cmp.Diff(desired, actual, cmpopts.IgnoreTypes(&runtimev1alpha1.Reference{}, &runtimev1alpha1.Selector{})))
+
+	return cmp.Equal(desired, actual, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreTypes(&runtimev1alpha1.Reference{}, &runtimev1alpha1.Selector{})), nil
+}
+
+// GenerateRuleParameters generates rule parameters from a rule
+func GenerateRuleParameters(in *cbr.Rule) (*v1alpha1.RuleParameters, error) {
+	o := &v1alpha1.RuleParameters{
+		Description:     in.Description,
+		Contexts:        GenerateCRContexts(in.Contexts),
+		Resources:       GenerateCRResources(in.Resources),
+		EnforcementMode: in.EnforcementMode,
+	}
+	return o, nil
+}
+
+// GenerateSDKContexts -
+func GenerateSDKContexts(in []v1alpha1.RuleContext) []cbr.RuleContext {
+	o := []cbr.RuleContext{}
+	for _, c := range in {
+		item := cbr.RuleContext{Attributes: []cbr.RuleContextAttribute{}}
+		for _, attr := range c.Attributes {
+			item.Attributes = append(item.Attributes, cbr.RuleContextAttribute{
+				Name:  reference.ToPtrValue(attr.Name),
+				Value: attr.Value,
+			})
+		}
+		o = append(o, item)
+	}
+	return o
+}
+
+// GenerateSDKResources -
+func GenerateSDKResources(in []v1alpha1.RuleResource) []cbr.Resource {
+	o := []cbr.Resource{}
+	for _, r := range in {
+		item := cbr.Resource{Attributes: []cbr.ResourceAttribute{}}
+		for _, attr := range r.Attributes {
+			item.Attributes = append(item.Attributes, cbr.ResourceAttribute{
+				Name:     reference.ToPtrValue(attr.Name),
+				Value:    attr.Value,
+				Operator: attr.Operator,
+			})
+		}
+		for _, tag := range r.Tags {
+			item.Tags = append(item.Tags, cbr.ResourceTagAttribute{
+				Name:     reference.ToPtrValue(tag.Name),
+				Value:    reference.ToPtrValue(tag.Value),
+				Operator: tag.Operator,
+			})
+		}
+		o = append(o, item)
+	}
+	return o
+}
+
+// GenerateCRContexts -
+func GenerateCRContexts(in []cbr.RuleContext) []v1alpha1.RuleContext {
+	o := []v1alpha1.RuleContext{}
+	for _, c := range in {
+		item := v1alpha1.RuleContext{}
+		for _, attr := range c.Attributes {
+			item.Attributes = append(item.Attributes, v1alpha1.RuleContextAttribute{
+				Name:  reference.FromPtrValue(attr.Name),
+				Value: attr.Value,
+			})
+		}
+		o = append(o, item)
+	}
+	return o
+}
+
+// GenerateCRResources -
+func GenerateCRResources(in []cbr.Resource) []v1alpha1.RuleResource {
+	o := []v1alpha1.RuleResource{}
+	for _, r := range in {
+		item := v1alpha1.RuleResource{}
+		for _, attr := range r.Attributes {
+			item.Attributes = append(item.Attributes, v1alpha1.RuleResourceAttribute{
+				Name:     reference.FromPtrValue(attr.Name),
+				Value:    attr.Value,
+				Operator: attr.Operator,
+			})
+		}
+		for _, tag := range r.Tags {
+			item.Tags = append(item.Tags, v1alpha1.RuleResourceTag{
+				Name:     reference.FromPtrValue(tag.Name),
+				Value:    reference.FromPtrValue(tag.Value),
+				Operator: tag.Operator,
+			})
+		}
+		o = append(o, item)
+	}
+	return o
+}
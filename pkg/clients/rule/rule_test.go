@@ -0,0 +1,255 @@
+package rule
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/go-cmp/cmp"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/contextbasedrestrictionsv1/v1alpha1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/cbr"
+)
+
+var (
+	rID             = "6c4f8d2a3b2e4c1d9a7f5e6b8c0d1e2f"
+	rETag           = "1-eb832c7ff8c8016a542974b9f880b55e"
+	rCRN            = "crn:v1:bluemix:public:context-based-restrictions:global:a/12ab34cd56ef78ab90cd12ef34ab56cd::rule:" + rID
+	rDescription    = "restrict my bucket to my zone"
+	rDescription2   = "another description"
+	rZoneKey        = "networkZoneId"
+	rZoneID         = "65810ac762004f22ac19f8f8edf70a34"
+	rZoneID2        = "559052eb8f43302824e7ae490c0281eb"
+	rEndpointKey    = "endpointType"
+	rEndpoint       = "private"
+	rAccountKey     = "accountId"
+	rAccountID      = "12ab34cd56ef78ab90cd12ef34ab56cd"
+	rServiceKey     = "serviceName"
+	rService        = "cloud-object-storage"
+	rInstanceKey    = "serviceInstance"
+	rInstance       = "a1b2c3d4-e5f6-1234-5678-90abcdef1234"
+	rTagKey         = "env"
+	rTagValue       = "prod"
+	rStringEquals   = "stringEquals"
+	rEnabled        = "enabled"
+	rReport         = "report"
+	rHref           = "https://cbr.cloud.ibm.com/v1/rules/" + rID
+	rIamID          = "IBMid-123453user"
+	rCreatedAt, _   = strfmt.ParseDateTime("2020-10-31T02:33:06Z")
+	rModifiedAt, _  = strfmt.ParseDateTime("2020-10-31T03:33:06Z")
+	rZoneRef        = &runtimev1alpha1.Reference{Name: "my-zone"}
+	rServiceInstRef = &runtimev1alpha1.Reference{Name: "my-cos"}
+)
+
+func params(m ...func(*v1alpha1.RuleParameters)) *v1alpha1.RuleParameters {
+	p := &v1alpha1.RuleParameters{
+		Description: &rDescription,
+		Contexts: []v1alpha1.RuleContext{{
+			Attributes: []v1alpha1.RuleContextAttribute{
+				{Name: rZoneKey, Value: &rZoneID, ZoneIDRef: rZoneRef},
+				{Name: rEndpointKey, Value: &rEndpoint},
+			},
+		}},
+		Resources: []v1alpha1.RuleResource{{
+			Attributes: []v1alpha1.RuleResourceAttribute{
+				{Name: rAccountKey, Value: &rAccountID, Operator: &rStringEquals},
+				{Name: rServiceKey, Value: &rService, Operator: &rStringEquals},
+				{Name: rInstanceKey, Value: &rInstance, ServiceInstanceRef: rServiceInstRef, Operator: &rStringEquals},
+			},
+			Tags: []v1alpha1.RuleResourceTag{{Name: rTagKey, Value: rTagValue, Operator: &rStringEquals}},
+		}},
+		EnforcementMode: &rEnabled,
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func sdkContexts(zoneID *string) []cbr.RuleContext {
+	return []cbr.RuleContext{{
+		Attributes: []cbr.RuleContextAttribute{
+			{Name: &rZoneKey, Value: zoneID},
+			{Name: &rEndpointKey, Value: &rEndpoint},
+		},
+	}}
+}
+
+func sdkResources() []cbr.Resource {
+	return []cbr.Resource{{
+		Attributes: []cbr.ResourceAttribute{
+			{Name: &rAccountKey, Value: &rAccountID, Operator: &rStringEquals},
+			{Name: &rServiceKey, Value: &rService, Operator: &rStringEquals},
+			{Name: &rInstanceKey, Value: &rInstance, Operator: &rStringEquals},
+		},
+		Tags: []cbr.ResourceTagAttribute{{Name: &rTagKey, Value: &rTagValue, Operator: &rStringEquals}},
+	}}
+}
+
+func instance(m ...func(*cbr.Rule)) *cbr.Rule {
+	i := &cbr.Rule{
+		ID:               &rID,
+		CRN:              &rCRN,
+		Description:      &rDescription,
+		Contexts:         sdkContexts(&rZoneID),
+		Resources:        sdkResources(),
+		EnforcementMode:  &rEnabled,
+		Href:             &rHref,
+		CreatedAt:        &rCreatedAt,
+		CreatedByID:      &rIamID,
+		LastModifiedAt:   &rModifiedAt,
+		LastModifiedByID: &rIamID,
+	}
+	for _, f := range m {
+		f(i)
+	}
+	return i
+}
+
+func TestGenerateCreateRuleOptions(t *testing.T) {
+	cases := map[string]struct {
+		params v1alpha1.RuleParameters
+		want   *cbr.CreateRuleOptions
+	}{
+		"FullConversion": {
+			params: *params(),
+			want: &cbr.CreateRuleOptions{Description: &rDescription, Contexts: sdkContexts(&rZoneID),
+				Resources: sdkResources(), EnforcementMode: &rEnabled},
+		},
+		"DefaultEnforcementMode": {
+			params: *params(func(p *v1alpha1.RuleParameters) {
+				p.Description = nil
+				p.EnforcementMode = nil
+			}),
+			want: &cbr.CreateRuleOptions{Contexts: sdkContexts(&rZoneID), Resources: sdkResources()},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &cbr.CreateRuleOptions{}
+			_ = GenerateCreateRuleOptions(tc.params, r)
+			if diff := cmp.Diff(tc.want, r); diff != "" {
+				t.Errorf("GenerateCreateRuleOptions(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateReplaceRuleOptions(t *testing.T) {
+	r := &cbr.ReplaceRuleOptions{}
+	_ = GenerateReplaceRuleOptions(rID, rETag, *params(func(p *v1alpha1.RuleParameters) {
+		p.EnforcementMode = &rReport
+	}), r)
+	want := &cbr.ReplaceRuleOptions{RuleID: &rID, IfMatch: &rETag, Description: &rDescription,
+		Contexts: sdkContexts(&rZoneID), Resources: sdkResources(), EnforcementMode: &rReport}
+	if diff := cmp.Diff(want, r); diff != "" {
+		t.Errorf("GenerateReplaceRuleOptions(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeSpecs(t *testing.T) {
+	cases := map[string]struct {
+		params   *v1alpha1.RuleParameters
+		instance *cbr.Rule
+		want     *v1alpha1.RuleParameters
+	}{
+		"SomeFields": {
+			params: params(func(p *v1alpha1.RuleParameters) {
+				p.Description = nil
+				p.EnforcementMode = nil
+				p.Resources[0].Attributes[0].Operator = nil
+				p.Resources[0].Tags[0].Operator = nil
+			}),
+			instance: instance(),
+			want:     params(),
+		},
+		"AllFilledAlready": {
+			params: params(),
+			instance: instance(func(i *cbr.Rule) {
+				i.Description = &rDescription2
+				i.EnforcementMode = &rReport
+			}),
+			want: params(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_ = LateInitializeSpec(tc.params, tc.instance)
+			if diff := cmp.Diff(tc.want, tc.params); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	o, err := GenerateObservation(instance())
+	if err != nil {
+		t.Errorf("GenerateObservation() unexpected error: %v", err)
+	}
+	want := v1alpha1.RuleObservation{
+		ID:               rID,
+		CRN:              rCRN,
+		Href:             rHref,
+		CreatedAt:        ibmc.DateTimeToMetaV1Time(&rCreatedAt),
+		CreatedByID:      rIamID,
+		LastModifiedAt:   ibmc.DateTimeToMetaV1Time(&rModifiedAt),
+		LastModifiedByID: rIamID,
+		State:            StateActive,
+	}
+	if diff := cmp.Diff(want, o); diff != "" {
+		t.Errorf("GenerateObservation() -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	type args struct {
+		params   *v1alpha1.RuleParameters
+		instance *cbr.Rule
+	}
+	type want struct {
+		upToDate bool
+		isErr    bool
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"IsUpToDate": {
+			args: args{params: params(), instance: instance()},
+			want: want{upToDate: true},
+		},
+		"ContextsNeedUpdate": {
+			args: args{
+				params: params(),
+				instance: instance(func(i *cbr.Rule) {
+					i.Contexts = sdkContexts(&rZoneID2)
+				}),
+			},
+			want: want{upToDate: false},
+		},
+		"EnforcementModeNeedsUpdate": {
+			args: args{
+				params: params(),
+				instance: instance(func(i *cbr.Rule) {
+					i.EnforcementMode = &rReport
+				}),
+			},
+			want: want{upToDate: false},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r, err := IsUpToDate(tc.args.params, tc.args.instance, logging.NewNopLogger())
+			if err != nil && !tc.want.isErr {
+				t.Error("IsUpToDate(...) unexpected error")
+			}
+			if diff := cmp.Diff(tc.want.upToDate, r); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
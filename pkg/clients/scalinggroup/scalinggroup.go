@@ -1,6 +1,9 @@
 package scalinggroup
 
 import (
+	"strings"
+
+	"github.com/IBM-Cloud/bluemix-go/crn"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 
@@ -9,13 +12,20 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 
 	icdv5 "github.com/IBM/experimental-go-sdk/ibmclouddatabasesv5"
+	gcat "github.com/IBM/platform-services-go-sdk/globalcatalogv1"
+	rcv2 "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 
 	"github.com/crossplane-contrib/provider-ibm-cloud/apis/ibmclouddatabasesv5/v1alpha1"
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
 	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
 )
 
-// MemberGroupID is the default ID for members group
-const MemberGroupID = "member"
+const (
+	// MemberGroupID is the default ID for members group
+	MemberGroupID = "member"
+
+	mbPerGB = 1024
+)
 
 // LateInitializeSpec fills optional and unassigned fields with the values in *icdv5.Group object.
 func LateInitializeSpec(spec *v1alpha1.ScalingGroupParameters, in *icdv5.Groups) error { // nolint:gocyclo
@@ -152,11 +162,11 @@ func IsUpToDate(id string, in *v1alpha1.ScalingGroupParameters, observed *icdv5.
 		return false, err
 	}
 
-	l.Info(cmp.Diff(desired, actual, cmpopts.IgnoreTypes(&runtimev1alpha1.Reference{}, &runtimev1alpha1.Selector{}, []runtimev1alpha1.Reference{})))
+	l.Info(cmp.Diff(desired, actual, cmpopts.IgnoreTypes(&runtimev1alpha1.Reference{}, &runtimev1alpha1.Selector{}, &v1beta1.GenericReference{}, []runtimev1alpha1.Reference{})))
 
 	return cmp.Equal(desired, actual, cmpopts.EquateEmpty(),
 		cmpopts.IgnoreFields(v1alpha1.ScalingGroupParameters{}),
-		cmpopts.IgnoreTypes(&runtimev1alpha1.Reference{}, &runtimev1alpha1.Selector{}, []runtimev1alpha1.Reference{})), nil
+		cmpopts.IgnoreTypes(&runtimev1alpha1.Reference{}, &runtimev1alpha1.Selector{}, &v1beta1.GenericReference{}, []runtimev1alpha1.Reference{})), nil
 }
 
 // GenerateScalingGroupParameters generates scaling group parameters from groups
@@ -189,3 +199,95 @@ func GenerateScalingGroupParameters(id string, in *icdv5.Groups) (*v1alpha1.Scal
 	}
 	return o, nil
 }
+
+// MonthlyUsage returns the usage of the allocations of scaling groups in cost estimates: the memory and disk (in GB)
+// and the CPUs allocated to all the groups, for the whole month.
+func MonthlyUsage(groups []v1alpha1.Group) ibmc.MonthlyUsage {
+	var memoryGB, diskGB, cpus float64
+	for _, g := range groups {
+		memoryGB += float64(g.Memory.AllocationMb) / mbPerGB
+		diskGB += float64(g.Disk.AllocationMb) / mbPerGB
+		cpus += float64(g.CPU.AllocationCount)
+	}
+	return func(m gcat.Metrics) (float64, bool) {
+		var q float64
+		unit := strings.ToUpper(reference.FromPtrValue(m.ChargeUnitName))
+		switch {
+		case strings.Contains(unit, "MEMORY") || strings.Contains(unit, "RAM"):
+			q = memoryGB
+		case strings.Contains(unit, "DISK") || strings.Contains(unit, "STORAGE"):
+			q = diskGB
+		case strings.Contains(unit, "CORE") || strings.Contains(unit, "CPU"):
+			q = cpus
+		default:
+			return 0, false
+		}
+		if ibmc.IsHourlyMetric(m) {
+			q *= ibmc.HoursPerMonth
+		}
+		return q, true
+	}
+}
+
+// DesiredGroups returns the groups of a ScalingGroup as they will be allocated once its spec is applied: like
+// GenerateSetDeploymentScalingGroupOptions, the first group takes the desired number of members, each with the
+// desired allocation per member.
+func DesiredGroups(in v1alpha1.ScalingGroup) []v1alpha1.Group {
+	groups := make([]v1alpha1.Group, len(in.Status.AtProvider.Groups))
+	copy(groups, in.Status.AtProvider.Groups)
+	if len(groups) == 0 || groups[0].Members.AllocationCount == 0 {
+		return groups
+	}
+	pars := in.Spec.ForProvider
+	g := &groups[0]
+	current := g.Members.AllocationCount
+	memoryMb, diskMb, cpus := g.Memory.AllocationMb/current, g.Disk.AllocationMb/current, g.CPU.AllocationCount/current
+	if pars.Members != nil {
+		g.Members.AllocationCount = pars.Members.AllocationCount
+	}
+	if pars.MemberMemory != nil {
+		memoryMb = pars.MemberMemory.AllocationMb
+	}
+	if pars.MemberDisk != nil {
+		diskMb = pars.MemberDisk.AllocationMb
+	}
+	if pars.MemberCPU != nil {
+		cpus = pars.MemberCPU.AllocationCount
+	}
+	g.Memory.AllocationMb = memoryMb * g.Members.AllocationCount
+	g.Disk.AllocationMb = diskMb * g.Members.AllocationCount
+	g.CPU.AllocationCount = cpus * g.Members.AllocationCount
+	return groups
+}
+
+// EstimateMonthlyCost returns the estimated monthly cost of the allocations of the scaling groups of the database
+// deployment with the given CRN, according to the pricing of the plan of the deployment.
+func EstimateMonthlyCost(client ibmc.ClientSession, deploymentCRN string, groups []v1alpha1.Group) (float64, error) {
+	instance, _, err := client.ResourceControllerV2().GetResourceInstance(&rcv2.GetResourceInstanceOptions{ID: &deploymentCRN})
+	if err != nil {
+		return 0, err
+	}
+	return ibmc.EstimatePlanMonthlyCost(client, reference.FromPtrValue(instance.ResourcePlanID), MonthlyUsage(groups))
+}
+
+// DeploymentGuardedValues returns the service, plan and region of the database deployment with the given CRN, which
+// are subject to the guardrails of the ProviderConfig.
+func DeploymentGuardedValues(client ibmc.ClientSession, deploymentCRN string) (ibmc.GuardedValues, error) {
+	c, err := crn.Parse(deploymentCRN)
+	if err != nil {
+		return ibmc.GuardedValues{}, err
+	}
+	instance, _, err := client.ResourceControllerV2().GetResourceInstance(&rcv2.GetResourceInstanceOptions{ID: &deploymentCRN})
+	if err != nil {
+		return ibmc.GuardedValues{}, err
+	}
+	plan, err := ibmc.GetResourcePlanName(client, c.ServiceName, reference.FromPtrValue(instance.ResourcePlanID))
+	if err != nil {
+		return ibmc.GuardedValues{}, err
+	}
+	return ibmc.GuardedValues{
+		Services: []string{c.ServiceName},
+		Plans:    []string{reference.FromPtrValue(plan)},
+		Regions:  []string{c.Region},
+	}, nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 
 	icdv5 "github.com/IBM/experimental-go-sdk/ibmclouddatabasesv5"
+	gcat "github.com/IBM/platform-services-go-sdk/globalcatalogv1"
 
 	//  note that missing a  newline in between a built in package import and a github package import results in "File is not `goimports`-ed"
 	"github.com/crossplane-contrib/provider-ibm-cloud/apis/ibmclouddatabasesv5/v1alpha1"
@@ -395,3 +396,107 @@ func TestIsUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestMonthlyUsage(t *testing.T) {
+	groups := []v1alpha1.Group{{
+		ID:     MemberGroupID,
+		Memory: v1alpha1.GroupMemory{AllocationMb: 8192},
+		Disk:   v1alpha1.GroupDisk{AllocationMb: 20480},
+		CPU:    v1alpha1.GroupCPU{AllocationCount: 6},
+	}}
+	cases := map[string]struct {
+		unit   string
+		want   float64
+		wantOk bool
+	}{
+		"Memory": {
+			unit:   "MEMORY_GIGABYTE_MONTHS",
+			want:   8,
+			wantOk: true,
+		},
+		"HourlyDisk": {
+			unit:   "DISK_GIGABYTE_HOURS",
+			want:   20 * ibmc.HoursPerMonth,
+			wantOk: true,
+		},
+		"CPU": {
+			unit:   "VIRTUAL_PROCESSOR_CORE_MONTHS",
+			want:   6,
+			wantOk: true,
+		},
+		"NotEstimated": {
+			unit: "BACKUP_GIGABYTE_MONTHS",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, ok := MonthlyUsage(groups)(gcat.Metrics{ChargeUnitName: reference.ToPtrValue(tc.unit)})
+			if diff := cmp.Diff(tc.wantOk, ok); diff != "" {
+				t.Errorf("MonthlyUsage(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("MonthlyUsage(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDesiredGroups(t *testing.T) {
+	current := v1alpha1.Group{
+		ID:      MemberGroupID,
+		Members: v1alpha1.GroupMembers{AllocationCount: 2},
+		Memory:  v1alpha1.GroupMemory{AllocationMb: 8192},
+		Disk:    v1alpha1.GroupDisk{AllocationMb: 20480},
+		CPU:     v1alpha1.GroupCPU{AllocationCount: 6},
+	}
+	cases := map[string]struct {
+		params v1alpha1.ScalingGroupParameters
+		groups []v1alpha1.Group
+		want   []v1alpha1.Group
+	}{
+		"NoGroups": {
+			want: []v1alpha1.Group{},
+		},
+		"NothingDesired": {
+			groups: []v1alpha1.Group{current},
+			want:   []v1alpha1.Group{current},
+		},
+		"MoreMembers": {
+			params: v1alpha1.ScalingGroupParameters{Members: &v1alpha1.SetMembersGroupMembers{AllocationCount: 3}},
+			groups: []v1alpha1.Group{current},
+			want: []v1alpha1.Group{{
+				ID:      MemberGroupID,
+				Members: v1alpha1.GroupMembers{AllocationCount: 3},
+				Memory:  v1alpha1.GroupMemory{AllocationMb: 12288},
+				Disk:    v1alpha1.GroupDisk{AllocationMb: 30720},
+				CPU:     v1alpha1.GroupCPU{AllocationCount: 9},
+			}},
+		},
+		"MoreMemoryPerMember": {
+			params: v1alpha1.ScalingGroupParameters{MemberMemory: &v1alpha1.SetMemoryGroupMemory{AllocationMb: 8192}},
+			groups: []v1alpha1.Group{current},
+			want: []v1alpha1.Group{{
+				ID:      MemberGroupID,
+				Members: v1alpha1.GroupMembers{AllocationCount: 2},
+				Memory:  v1alpha1.GroupMemory{AllocationMb: 16384},
+				Disk:    v1alpha1.GroupDisk{AllocationMb: 20480},
+				CPU:     v1alpha1.GroupCPU{AllocationCount: 6},
+			}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			in := v1alpha1.ScalingGroup{
+				Spec:   v1alpha1.ScalingGroupSpec{ForProvider: tc.params},
+				Status: v1alpha1.ScalingGroupStatus{AtProvider: v1alpha1.ScalingGroupObservation{Groups: tc.groups}},
+			}
+			got := DesiredGroups(in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("DesiredGroups(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.groups, in.Status.AtProvider.Groups); diff != "" {
+				t.Errorf("DesiredGroups(...): status changed, -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
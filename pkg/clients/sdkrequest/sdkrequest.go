@@ -0,0 +1,38 @@
+// Package sdkrequest sends the requests of the minimal clients of the IBM Cloud APIs that the SDKs in use do not cover
+// yet, through the base service of an SDK client. It does not import the other client packages, so any of them can
+// use it.
+package sdkrequest
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Do sends a JSON request to the given path of the service, and unmarshals the response body into result, if any.
+// The request is bound to the given context, so it is cancelled along with the reconciliation it is part of.
+func Do(ctx context.Context, service *core.BaseService, method, path string, pathParams, headers map[string]string, body, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = service.GetEnableGzipCompression()
+	if _, err := builder.ResolveRequestURL(service.Options.URL, path, pathParams); err != nil {
+		return nil, err
+	}
+
+	builder.AddHeader("Accept", "application/json")
+	for k, v := range headers {
+		builder.AddHeader(k, v)
+	}
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		if _, err := builder.SetBodyContentJSON(body); err != nil {
+			return nil, err
+		}
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	return service.Request(request, result)
+}
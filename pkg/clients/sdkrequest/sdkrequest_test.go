@@ -0,0 +1,64 @@
+package sdkrequest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDo(t *testing.T) {
+	type result struct {
+		ID string `json:"id"`
+	}
+	type want struct {
+		result result
+		err    bool
+	}
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	cases := map[string]struct {
+		ctx  context.Context
+		want want
+	}{
+		"Successful": {
+			ctx:  context.Background(),
+			want: want{result: result{ID: "id-1"}},
+		},
+		"CancelledContext": {
+			ctx:  cancelled,
+			want: want{err: true},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/v1/things/id-1", r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(result{ID: "id-1"})
+			}))
+			defer server.Close()
+			service, err := core.NewBaseService(&core.ServiceOptions{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+			if err != nil {
+				t.Fatalf("cannot create the base service: %s", err)
+			}
+
+			got := result{}
+			_, err = Do(tc.ctx, service, http.MethodGet, `/v1/things/{id}`, map[string]string{"id": "id-1"}, nil, nil, &got)
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("Do(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("Do(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
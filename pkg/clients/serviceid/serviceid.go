@@ -0,0 +1,88 @@
+package serviceid
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	iamidv1 "github.com/IBM/platform-services-go-sdk/iamidentityv1"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/iamidentityv1/v1alpha1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+)
+
+const (
+	// StateActive represents a service ID in a running, available, and ready state
+	StateActive = "active"
+)
+
+// LateInitializeSpec fills optional and unassigned fields with the values in *iamidv1.ServiceID object.
+func LateInitializeSpec(spec *v1alpha1.ServiceIDParameters, in *iamidv1.ServiceID) error {
+	if spec.Description == nil {
+		spec.Description = in.Description
+	}
+	if spec.UniqueInstanceCrns == nil {
+		spec.UniqueInstanceCrns = in.UniqueInstanceCrns
+	}
+	return nil
+}
+
+// GenerateCreateServiceIDOptions produces CreateServiceIDOptions object from ServiceIDParameters object.
+func GenerateCreateServiceIDOptions(in v1alpha1.ServiceIDParameters, o *iamidv1.CreateServiceIDOptions) error {
+	o.AccountID = reference.ToPtrValue(in.AccountID)
+	o.Name = reference.ToPtrValue(in.Name)
+	o.Description = in.Description
+	o.UniqueInstanceCrns = in.UniqueInstanceCrns
+	return nil
+}
+
+// GenerateUpdateServiceIDOptions produces UpdateServiceIDOptions object from ServiceIDParameters object.
+func GenerateUpdateServiceIDOptions(id, eTag string, in v1alpha1.ServiceIDParameters, o *iamidv1.UpdateServiceIDOptions) error {
+	o.ID = reference.ToPtrValue(id)
+	o.Name = reference.ToPtrValue(in.Name)
+	o.Description = in.Description
+	o.UniqueInstanceCrns = in.UniqueInstanceCrns
+	o.SetIfMatch(eTag)
+	return nil
+}
+
+// GenerateObservation produces ServiceIDObservation object from *iamidv1.ServiceID object.
+func GenerateObservation(in *iamidv1.ServiceID) (v1alpha1.ServiceIDObservation, error) {
+	o := v1alpha1.ServiceIDObservation{
+		ID:         reference.FromPtrValue(in.ID),
+		IamID:      reference.FromPtrValue(in.IamID),
+		EntityTag:  reference.FromPtrValue(in.EntityTag),
+		CRN:        reference.FromPtrValue(in.CRN),
+		Locked:     ibmc.BoolValue(in.Locked),
+		CreatedAt:  ibmc.DateTimeToMetaV1Time(in.CreatedAt),
+		ModifiedAt: ibmc.DateTimeToMetaV1Time(in.ModifiedAt),
+	}
+	return o, nil
+}
+
+// IsUpToDate checks whether current state is up-to-date compared to the given
+// set of parameters.
+func IsUpToDate(in *v1alpha1.ServiceIDParameters, observed *iamidv1.ServiceID, l logging.Logger) (bool, error) {
+	desired := in.DeepCopy()
+	actual, err := GenerateServiceIDParameters(observed)
+	if err != nil {
+		return false, err
+	}
+
+	l.Info(cmp.Diff(desired, actual, cmpopts.EquateEmpty()))
+
+	return cmp.Equal(desired, actual, cmpopts.EquateEmpty()), nil
+}
+
+// GenerateServiceIDParameters generates service ID parameters from service ID
+func GenerateServiceIDParameters(in *iamidv1.ServiceID) (*v1alpha1.ServiceIDParameters, error) {
+	o := &v1alpha1.ServiceIDParameters{
+		AccountID:          reference.FromPtrValue(in.AccountID),
+		Name:               reference.FromPtrValue(in.Name),
+		Description:        in.Description,
+		UniqueInstanceCrns: in.UniqueInstanceCrns,
+	}
+	return o, nil
+}
@@ -0,0 +1,301 @@
+package serviceid
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+
+	iamidv1 "github.com/IBM/platform-services-go-sdk/iamidentityv1"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/iamidentityv1/v1alpha1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+)
+
+var (
+	sidName         = "myServiceID"
+	sidDescription  = "service ID for my app"
+	accountID       = "aa5a00334eaf9eb9339d2ab48f20d7ff"
+	sidID           = "ServiceId-12345678-abcd-1a2b-a1b2-1234567890ab"
+	iamID           = "iam-ServiceId-12345678-abcd-1a2b-a1b2-1234567890ab"
+	instanceCrn     = "crn:v1:bluemix:public:cloud-object-storage:global:a/aa5a00334eaf9eb9339d2ab48f20d7ff:1234::"
+	createdAt, _    = strfmt.ParseDateTime("2020-10-31T02:33:06Z")
+	modifiedAt, _   = strfmt.ParseDateTime("2020-10-31T03:33:06Z")
+	eTag            = "1-eb832c7ff8c8016a542974b9f880b55e"
+	crn             = "crn:v1:bluemix:public:iam-identity::a/aa5a00334eaf9eb9339d2ab48f20d7ff::serviceid:" + sidID
+	sidLocked       = false
+	sidNewName      = "myNewServiceID"
+	sidCrns         = []string{instanceCrn}
+	sidOtherCrns    = []string{instanceCrn, instanceCrn + "5678"}
+	sidDescription2 = "another description"
+)
+
+func params(m ...func(*v1alpha1.ServiceIDParameters)) *v1alpha1.ServiceIDParameters {
+	p := &v1alpha1.ServiceIDParameters{
+		AccountID:          accountID,
+		Name:               sidName,
+		Description:        &sidDescription,
+		UniqueInstanceCrns: sidCrns,
+	}
+
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func observation(m ...func(*v1alpha1.ServiceIDObservation)) *v1alpha1.ServiceIDObservation {
+	o := &v1alpha1.ServiceIDObservation{
+		ID:         sidID,
+		IamID:      iamID,
+		EntityTag:  eTag,
+		CRN:        crn,
+		Locked:     sidLocked,
+		CreatedAt:  ibmc.DateTimeToMetaV1Time(&createdAt),
+		ModifiedAt: ibmc.DateTimeToMetaV1Time(&modifiedAt),
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+	return o
+}
+
+func instance(m ...func(*iamidv1.ServiceID)) *iamidv1.ServiceID {
+	i := &iamidv1.ServiceID{
+		ID:                 &sidID,
+		IamID:              &iamID,
+		EntityTag:          &eTag,
+		CRN:                &crn,
+		Locked:             &sidLocked,
+		CreatedAt:          &createdAt,
+		ModifiedAt:         &modifiedAt,
+		AccountID:          &accountID,
+		Name:               &sidName,
+		Description:        &sidDescription,
+		UniqueInstanceCrns: sidCrns,
+	}
+
+	for _, f := range m {
+		f(i)
+	}
+	return i
+}
+
+func instanceOpts(m ...func(*iamidv1.CreateServiceIDOptions)) *iamidv1.CreateServiceIDOptions {
+	i := &iamidv1.CreateServiceIDOptions{
+		AccountID:          &accountID,
+		Name:               &sidName,
+		Description:        &sidDescription,
+		UniqueInstanceCrns: sidCrns,
+	}
+	for _, f := range m {
+		f(i)
+	}
+	return i
+}
+
+func instanceUpdOpts(m ...func(*iamidv1.UpdateServiceIDOptions)) *iamidv1.UpdateServiceIDOptions {
+	i := &iamidv1.UpdateServiceIDOptions{
+		ID:                 &sidID,
+		IfMatch:            &eTag,
+		Name:               &sidNewName,
+		Description:        &sidDescription,
+		UniqueInstanceCrns: sidCrns,
+	}
+
+	for _, f := range m {
+		f(i)
+	}
+	return i
+}
+
+func TestGenerateCreateServiceIDOptions(t *testing.T) {
+	type args struct {
+		params v1alpha1.ServiceIDParameters
+	}
+	type want struct {
+		instance *iamidv1.CreateServiceIDOptions
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"FullConversion": {
+			args: args{params: *params()},
+			want: want{instance: instanceOpts()},
+		},
+		"MissingFields": {
+			args: args{
+				params: *params(func(p *v1alpha1.ServiceIDParameters) {
+					p.Description = nil
+					p.UniqueInstanceCrns = nil
+				})},
+			want: want{instance: instanceOpts(func(p *iamidv1.CreateServiceIDOptions) {
+				p.Description = nil
+				p.UniqueInstanceCrns = nil
+			})},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &iamidv1.CreateServiceIDOptions{}
+			GenerateCreateServiceIDOptions(tc.args.params, r)
+			if diff := cmp.Diff(tc.want.instance, r); diff != "" {
+				t.Errorf("GenerateCreateServiceIDOptions(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateUpdateServiceIDOptions(t *testing.T) {
+	type args struct {
+		id     string
+		etag   string
+		params v1alpha1.ServiceIDParameters
+	}
+	type want struct {
+		instance *iamidv1.UpdateServiceIDOptions
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"FullConversion": {
+			args: args{id: sidID, etag: eTag, params: *params(func(p *v1alpha1.ServiceIDParameters) {
+				p.Name = sidNewName
+			})},
+			want: want{instance: instanceUpdOpts()},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &iamidv1.UpdateServiceIDOptions{}
+			GenerateUpdateServiceIDOptions(tc.args.id, tc.args.etag, tc.args.params, r)
+			if diff := cmp.Diff(tc.want.instance, r); diff != "" {
+				t.Errorf("GenerateUpdateServiceIDOptions(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSpecs(t *testing.T) {
+	type args struct {
+		instance *iamidv1.ServiceID
+		params   *v1alpha1.ServiceIDParameters
+	}
+	type want struct {
+		params *v1alpha1.ServiceIDParameters
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"SomeFields": {
+			args: args{
+				params: params(func(p *v1alpha1.ServiceIDParameters) {
+					p.Description = nil
+					p.UniqueInstanceCrns = nil
+				}),
+				instance: instance(),
+			},
+			want: want{
+				params: params()},
+		},
+		"AllFilledAlready": {
+			args: args{
+				params: params(),
+				instance: instance(func(i *iamidv1.ServiceID) {
+					i.Description = &sidDescription2
+					i.UniqueInstanceCrns = sidOtherCrns
+				}),
+			},
+			want: want{
+				params: params()},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSpec(tc.args.params, tc.args.instance)
+			if diff := cmp.Diff(tc.want.params, tc.args.params); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	type args struct {
+		instance *iamidv1.ServiceID
+	}
+	type want struct {
+		obs v1alpha1.ServiceIDObservation
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"FullConversion": {
+			args: args{
+				instance: instance(),
+			},
+			want: want{*observation()},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := GenerateObservation(tc.args.instance)
+			if diff := cmp.Diff(nil, err); diff != "" {
+				t.Errorf("GenerateObservation(...): want error != got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, o); diff != "" {
+				t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	type args struct {
+		params   *v1alpha1.ServiceIDParameters
+		instance *iamidv1.ServiceID
+	}
+	type want struct {
+		upToDate bool
+		isErr    bool
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"IsUpToDate": {
+			args: args{
+				params:   params(),
+				instance: instance(),
+			},
+			want: want{upToDate: true, isErr: false},
+		},
+		"NeedsUpdate": {
+			args: args{
+				params: params(func(p *v1alpha1.ServiceIDParameters) {
+					p.UniqueInstanceCrns = sidOtherCrns
+				}),
+				instance: instance(),
+			},
+			want: want{upToDate: false, isErr: false},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r, err := IsUpToDate(tc.args.params, tc.args.instance, logging.NewNopLogger())
+			if err != nil && !tc.want.isErr {
+				t.Error("IsUpToDate(...) unexpected error")
+			}
+			if diff := cmp.Diff(tc.want.upToDate, r); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -32,6 +32,8 @@ import (
 	gcat "github.com/IBM/platform-services-go-sdk/globalcatalogv1"
 	gtagv1 "github.com/IBM/platform-services-go-sdk/globaltaggingv1"
 	rmgrv2 "github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
 )
 
 var (
@@ -131,14 +133,20 @@ func ReturnConditionalDate(condition bool, val *strfmt.DateTime) *strfmt.DateTim
 //
 //	the test client ready to go
 func GetTestClient(serverURL string) (ClientSession, error) {
+	return GetTestClientWithProviderConfig(serverURL, nil)
+}
+
+// GetTestClientWithProviderConfig returns a client to be used in unit tests, carrying the given ProviderConfig spec
+func GetTestClientWithProviderConfig(serverURL string, pc *v1beta1.ProviderConfigSpec) (ClientSession, error) {
 	opts := ClientOptions{
 		URL: serverURL,
 		Authenticator: &core.BearerTokenAuthenticator{
 			BearerToken: FakeBearerToken,
 		},
 
-		BearerToken:  FakeBearerToken,
-		RefreshToken: "does format matter?",
+		BearerToken:    FakeBearerToken,
+		RefreshToken:   "does format matter?",
+		ProviderConfig: pc,
 	}
 
 	return NewClient(opts)
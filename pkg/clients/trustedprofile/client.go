@@ -0,0 +1,312 @@
+package trustedprofile
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+
+	iamidv1 "github.com/IBM/platform-services-go-sdk/iamidentityv1"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/sdkrequest"
+)
+
+// The version of the IAM Identity SDK in use does not cover trusted profiles yet, so this file provides a minimal
+// client for the trusted profile, claim rule and link endpoints of the IAM Identity API, modeled on the SDK. It shares
+// the service URL, authenticator and HTTP client of the IAM Identity SDK client it is created from.
+
+const (
+	pathProfiles   = `/v1/profiles`
+	pathProfile    = `/v1/profiles/{profile-id}`
+	pathClaimRules = `/v1/profiles/{profile-id}/rules`
+	pathClaimRule  = `/v1/profiles/{profile-id}/rules/{rule-id}`
+	pathLinks      = `/v1/profiles/{profile-id}/links`
+	pathLink       = `/v1/profiles/{profile-id}/links/{link-id}`
+
+	errMissingOptions = "options and their required fields must be set"
+)
+
+// TrustedProfile : Response body format for trusted profile V1 REST requests.
+type TrustedProfile struct {
+	ID          *string          `json:"id,omitempty"`
+	EntityTag   *string          `json:"entity_tag,omitempty"`
+	CRN         *string          `json:"crn,omitempty"`
+	Name        *string          `json:"name,omitempty"`
+	Description *string          `json:"description,omitempty"`
+	CreatedAt   *strfmt.DateTime `json:"created_at,omitempty"`
+	ModifiedAt  *strfmt.DateTime `json:"modified_at,omitempty"`
+	IamID       *string          `json:"iam_id,omitempty"`
+	AccountID   *string          `json:"account_id,omitempty"`
+}
+
+// ProfileClaimRuleConditions : A condition of a claim rule.
+type ProfileClaimRuleConditions struct {
+	Claim    *string `json:"claim"`
+	Operator *string `json:"operator"`
+	Value    *string `json:"value"`
+}
+
+// ProfileClaimRule : Response body format for profile claim rule V1 REST requests.
+type ProfileClaimRule struct {
+	ID         *string                      `json:"id,omitempty"`
+	EntityTag  *string                      `json:"entity_tag,omitempty"`
+	CreatedAt  *strfmt.DateTime             `json:"created_at,omitempty"`
+	ModifiedAt *strfmt.DateTime             `json:"modified_at,omitempty"`
+	Name       *string                      `json:"name,omitempty"`
+	Type       *string                      `json:"type,omitempty"`
+	RealmName  *string                      `json:"realm_name,omitempty"`
+	Expiration *int64                       `json:"expiration,omitempty"`
+	CrType     *string                      `json:"cr_type,omitempty"`
+	Conditions []ProfileClaimRuleConditions `json:"conditions,omitempty"`
+}
+
+// ProfileLinkLink : The compute resource of a link.
+type ProfileLinkLink struct {
+	CRN       *string `json:"crn,omitempty"`
+	Namespace *string `json:"namespace,omitempty"`
+	Name      *string `json:"name,omitempty"`
+}
+
+// ProfileLink : Response body format for profile link V1 REST requests.
+type ProfileLink struct {
+	ID         *string          `json:"id,omitempty"`
+	EntityTag  *string          `json:"entity_tag,omitempty"`
+	CreatedAt  *strfmt.DateTime `json:"created_at,omitempty"`
+	ModifiedAt *strfmt.DateTime `json:"modified_at,omitempty"`
+	Name       *string          `json:"name,omitempty"`
+	CrType     *string          `json:"cr_type,omitempty"`
+	Link       *ProfileLinkLink `json:"link,omitempty"`
+}
+
+// CreateProfileOptions : The CreateProfile options.
+type CreateProfileOptions struct {
+	Name        *string `json:"name"`
+	AccountID   *string `json:"account_id"`
+	Description *string `json:"description,omitempty"`
+}
+
+// GetProfileOptions : The GetProfile options.
+type GetProfileOptions struct {
+	ProfileID *string
+}
+
+// UpdateProfileOptions : The UpdateProfile options.
+type UpdateProfileOptions struct {
+	ProfileID   *string `json:"-"`
+	IfMatch     *string `json:"-"`
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// DeleteProfileOptions : The DeleteProfile options.
+type DeleteProfileOptions struct {
+	ProfileID *string
+}
+
+// CreateClaimRuleOptions : The CreateClaimRule options.
+type CreateClaimRuleOptions struct {
+	ProfileID  *string                      `json:"-"`
+	Type       *string                      `json:"type"`
+	Conditions []ProfileClaimRuleConditions `json:"conditions"`
+	Name       *string                      `json:"name,omitempty"`
+	RealmName  *string                      `json:"realm_name,omitempty"`
+	CrType     *string                      `json:"cr_type,omitempty"`
+	Expiration *int64                       `json:"expiration,omitempty"`
+}
+
+// GetClaimRuleOptions : The GetClaimRule options.
+type GetClaimRuleOptions struct {
+	ProfileID *string
+	RuleID    *string
+}
+
+// UpdateClaimRuleOptions : The UpdateClaimRule options.
+type UpdateClaimRuleOptions struct {
+	ProfileID  *string                      `json:"-"`
+	RuleID     *string                      `json:"-"`
+	IfMatch    *string                      `json:"-"`
+	Type       *string                      `json:"type"`
+	Conditions []ProfileClaimRuleConditions `json:"conditions"`
+	Name       *string                      `json:"name,omitempty"`
+	RealmName  *string                      `json:"realm_name,omitempty"`
+	CrType     *string                      `json:"cr_type,omitempty"`
+	Expiration *int64                       `json:"expiration,omitempty"`
+}
+
+// DeleteClaimRuleOptions : The DeleteClaimRule options.
+type DeleteClaimRuleOptions struct {
+	ProfileID *string
+	RuleID    *string
+}
+
+// CreateLinkOptions : The CreateLink options.
+type CreateLinkOptions struct {
+	ProfileID *string          `json:"-"`
+	Name      *string          `json:"name,omitempty"`
+	CrType    *string          `json:"cr_type"`
+	Link      *ProfileLinkLink `json:"link"`
+}
+
+// GetLinkOptions : The GetLink options.
+type GetLinkOptions struct {
+	ProfileID *string
+	LinkID    *string
+}
+
+// DeleteLinkOptions : The DeleteLink options.
+type DeleteLinkOptions struct {
+	ProfileID *string
+	LinkID    *string
+}
+
+// Client is a client of the trusted profile endpoints of the IAM Identity API
+type Client struct {
+	service *core.BaseService
+}
+
+// NewClient returns a client of the trusted profile endpoints, which shares the base service of the given IAM Identity
+// client.
+func NewClient(iamIdentity *iamidv1.IamIdentityV1) *Client {
+	return &Client{service: iamIdentity.Service}
+}
+
+// CreateProfile creates a trusted profile.
+func (c *Client) CreateProfile(ctx context.Context, o *CreateProfileOptions) (*TrustedProfile, *core.DetailedResponse, error) {
+	if o == nil || o.Name == nil || o.AccountID == nil {
+		return nil, nil, errors.New(errMissingOptions)
+	}
+	result := &TrustedProfile{}
+	response, err := sdkrequest.Do(ctx, c.service, http.MethodPost, pathProfiles, nil, nil, o, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+// GetProfile retrieves a trusted profile.
+func (c *Client) GetProfile(ctx context.Context, o *GetProfileOptions) (*TrustedProfile, *core.DetailedResponse, error) {
+	if o == nil || o.ProfileID == nil {
+		return nil, nil, errors.New(errMissingOptions)
+	}
+	result := &TrustedProfile{}
+	response, err := sdkrequest.Do(ctx, c.service, http.MethodGet, pathProfile,
+		map[string]string{"profile-id": *o.ProfileID}, nil, nil, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+// UpdateProfile updates the name or description of a trusted profile.
+func (c *Client) UpdateProfile(ctx context.Context, o *UpdateProfileOptions) (*TrustedProfile, *core.DetailedResponse, error) {
+	if o == nil || o.ProfileID == nil || o.IfMatch == nil {
+		return nil, nil, errors.New(errMissingOptions)
+	}
+	result := &TrustedProfile{}
+	response, err := sdkrequest.Do(ctx, c.service, http.MethodPut, pathProfile,
+		map[string]string{"profile-id": *o.ProfileID},
+		map[string]string{"If-Match": *o.IfMatch}, o, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+// DeleteProfile deletes a trusted profile, along with its claim rules and links.
+func (c *Client) DeleteProfile(ctx context.Context, o *DeleteProfileOptions) (*core.DetailedResponse, error) {
+	if o == nil || o.ProfileID == nil {
+		return nil, errors.New(errMissingOptions)
+	}
+	return sdkrequest.Do(ctx, c.service, http.MethodDelete, pathProfile,
+		map[string]string{"profile-id": *o.ProfileID}, nil, nil, nil)
+}
+
+// CreateClaimRule creates a claim rule of a trusted profile.
+func (c *Client) CreateClaimRule(ctx context.Context, o *CreateClaimRuleOptions) (*ProfileClaimRule, *core.DetailedResponse, error) {
+	if o == nil || o.ProfileID == nil || o.Type == nil {
+		return nil, nil, errors.New(errMissingOptions)
+	}
+	result := &ProfileClaimRule{}
+	response, err := sdkrequest.Do(ctx, c.service, http.MethodPost, pathClaimRules,
+		map[string]string{"profile-id": *o.ProfileID}, nil, o, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+// GetClaimRule retrieves a claim rule of a trusted profile.
+func (c *Client) GetClaimRule(ctx context.Context, o *GetClaimRuleOptions) (*ProfileClaimRule, *core.DetailedResponse, error) {
+	if o == nil || o.ProfileID == nil || o.RuleID == nil {
+		return nil, nil, errors.New(errMissingOptions)
+	}
+	result := &ProfileClaimRule{}
+	response, err := sdkrequest.Do(ctx, c.service, http.MethodGet, pathClaimRule,
+		map[string]string{"profile-id": *o.ProfileID, "rule-id": *o.RuleID}, nil, nil, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+// UpdateClaimRule updates a claim rule of a trusted profile.
+func (c *Client) UpdateClaimRule(ctx context.Context, o *UpdateClaimRuleOptions) (*ProfileClaimRule, *core.DetailedResponse, error) {
+	if o == nil || o.ProfileID == nil || o.RuleID == nil || o.IfMatch == nil || o.Type == nil {
+		return nil, nil, errors.New(errMissingOptions)
+	}
+	result := &ProfileClaimRule{}
+	response, err := sdkrequest.Do(ctx, c.service, http.MethodPut, pathClaimRule,
+		map[string]string{"profile-id": *o.ProfileID, "rule-id": *o.RuleID}, map[string]string{"If-Match": *o.IfMatch}, o, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+// DeleteClaimRule deletes a claim rule of a trusted profile.
+func (c *Client) DeleteClaimRule(ctx context.Context, o *DeleteClaimRuleOptions) (*core.DetailedResponse, error) {
+	if o == nil || o.ProfileID == nil || o.RuleID == nil {
+		return nil, errors.New(errMissingOptions)
+	}
+	return sdkrequest.Do(ctx, c.service, http.MethodDelete, pathClaimRule,
+		map[string]string{"profile-id": *o.ProfileID, "rule-id": *o.RuleID}, nil, nil, nil)
+}
+
+// CreateLink creates a link between a trusted profile and a compute resource.
+func (c *Client) CreateLink(ctx context.Context, o *CreateLinkOptions) (*ProfileLink, *core.DetailedResponse, error) {
+	if o == nil || o.ProfileID == nil || o.CrType == nil || o.Link == nil {
+		return nil, nil, errors.New(errMissingOptions)
+	}
+	result := &ProfileLink{}
+	response, err := sdkrequest.Do(ctx, c.service, http.MethodPost, pathLinks,
+		map[string]string{"profile-id": *o.ProfileID}, nil, o, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+// GetLink retrieves a link of a trusted profile.
+func (c *Client) GetLink(ctx context.Context, o *GetLinkOptions) (*ProfileLink, *core.DetailedResponse, error) {
+	if o == nil || o.ProfileID == nil || o.LinkID == nil {
+		return nil, nil, errors.New(errMissingOptions)
+	}
+	result := &ProfileLink{}
+	response, err := sdkrequest.Do(ctx, c.service, http.MethodGet, pathLink,
+		map[string]string{"profile-id": *o.ProfileID, "link-id": *o.LinkID}, nil, nil, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+// DeleteLink deletes a link of a trusted profile.
+func (c *Client) DeleteLink(ctx context.Context, o *DeleteLinkOptions) (*core.DetailedResponse, error) {
+	if o == nil || o.ProfileID == nil || o.LinkID == nil {
+		return nil, errors.New(errMissingOptions)
+	}
+	return sdkrequest.Do(ctx, c.service, http.MethodDelete, pathLink,
+		map[string]string{"profile-id": *o.ProfileID, "link-id": *o.LinkID}, nil, nil, nil)
+}
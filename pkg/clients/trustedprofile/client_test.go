@@ -0,0 +1,208 @@
+package trustedprofile
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/google/go-cmp/cmp"
+
+	iamidv1 "github.com/IBM/platform-services-go-sdk/iamidentityv1"
+
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+)
+
+type request struct {
+	method  string
+	path    string
+	ifMatch string
+	body    map[string]interface{}
+}
+
+func setupClient(t *testing.T, status int, response interface{}, got *request) (*Client, *httptest.Server) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got.method = r.Method
+		got.path = r.URL.Path
+		got.ifMatch = r.Header.Get("If-Match")
+		b, _ := io.ReadAll(r.Body)
+		_ = r.Body.Close()
+		if len(b) > 0 {
+			if err := json.Unmarshal(b, &got.body); err != nil {
+				t.Errorf("r: cannot unmarshal request body: %s", err)
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		if response != nil {
+			_ = json.NewEncoder(w).Encode(response)
+		}
+	}))
+
+	iamIdentity, err := iamidv1.NewIamIdentityV1(&iamidv1.IamIdentityV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	if err != nil {
+		t.Fatalf("cannot create IAM Identity client: %s", err)
+	}
+	return NewClient(iamIdentity), server
+}
+
+func TestClientRequests(t *testing.T) {
+	profileID := "Profile-12345678-abcd-1a2b-a1b2-1234567890ab"
+	ruleID := "ClaimRule-12345678-abcd-1a2b-a1b2-1234567890ab"
+	linkID := "ProfileLink-12345678-abcd-1a2b-a1b2-1234567890ab"
+	eTag := "1-eb832c7ff8c8016a542974b9f880b55e"
+	name := "myprofile"
+	accountID := "aa5a00334eaf9eb9339d2ab48f20d7ff"
+	ruleType := "Profile-CR"
+	crType := "IKS_SA"
+	crn := "crn:v1:bluemix:public:containers-kubernetes:us-south:a/aa5a00334eaf9eb9339d2ab48f20d7ff:c1234::"
+	namespace := "default"
+	claim := "namespace"
+	operator := "EQUALS"
+
+	cases := map[string]struct {
+		call func(c *Client) error
+		want request
+	}{
+		"CreateProfile": {
+			call: func(c *Client) error {
+				_, _, err := c.CreateProfile(context.Background(), &CreateProfileOptions{Name: &name, AccountID: &accountID})
+				return err
+			},
+			want: request{method: http.MethodPost, path: "/v1/profiles",
+				body: map[string]interface{}{"name": name, "account_id": accountID}},
+		},
+		"GetProfile": {
+			call: func(c *Client) error {
+				_, _, err := c.GetProfile(context.Background(), &GetProfileOptions{ProfileID: &profileID})
+				return err
+			},
+			want: request{method: http.MethodGet, path: "/v1/profiles/" + profileID},
+		},
+		"UpdateProfile": {
+			call: func(c *Client) error {
+				_, _, err := c.UpdateProfile(context.Background(), &UpdateProfileOptions{ProfileID: &profileID, IfMatch: &eTag, Name: &name})
+				return err
+			},
+			want: request{method: http.MethodPut, path: "/v1/profiles/" + profileID, ifMatch: eTag,
+				body: map[string]interface{}{"name": name}},
+		},
+		"DeleteProfile": {
+			call: func(c *Client) error {
+				_, err := c.DeleteProfile(context.Background(), &DeleteProfileOptions{ProfileID: &profileID})
+				return err
+			},
+			want: request{method: http.MethodDelete, path: "/v1/profiles/" + profileID},
+		},
+		"CreateClaimRule": {
+			call: func(c *Client) error {
+				_, _, err := c.CreateClaimRule(context.Background(), &CreateClaimRuleOptions{ProfileID: &profileID, Type: &ruleType, CrType: &crType,
+					Conditions: []ProfileClaimRuleConditions{{Claim: &claim, Operator: &operator, Value: &namespace}}})
+				return err
+			},
+			want: request{method: http.MethodPost, path: "/v1/profiles/" + profileID + "/rules",
+				body: map[string]interface{}{"type": ruleType, "cr_type": crType,
+					"conditions": []interface{}{map[string]interface{}{"claim": claim, "operator": operator, "value": namespace}}}},
+		},
+		"UpdateClaimRule": {
+			call: func(c *Client) error {
+				_, _, err := c.UpdateClaimRule(context.Background(), &UpdateClaimRuleOptions{ProfileID: &profileID, RuleID: &ruleID, IfMatch: &eTag,
+					Type: &ruleType, Conditions: []ProfileClaimRuleConditions{}})
+				return err
+			},
+			want: request{method: http.MethodPut, path: "/v1/profiles/" + profileID + "/rules/" + ruleID, ifMatch: eTag,
+				body: map[string]interface{}{"type": ruleType, "conditions": []interface{}{}}},
+		},
+		"DeleteClaimRule": {
+			call: func(c *Client) error {
+				_, err := c.DeleteClaimRule(context.Background(), &DeleteClaimRuleOptions{ProfileID: &profileID, RuleID: &ruleID})
+				return err
+			},
+			want: request{method: http.MethodDelete, path: "/v1/profiles/" + profileID + "/rules/" + ruleID},
+		},
+		"CreateLink": {
+			call: func(c *Client) error {
+				_, _, err := c.CreateLink(context.Background(), &CreateLinkOptions{ProfileID: &profileID, CrType: &crType,
+					Link: &ProfileLinkLink{CRN: &crn, Namespace: &namespace}})
+				return err
+			},
+			want: request{method: http.MethodPost, path: "/v1/profiles/" + profileID + "/links",
+				body: map[string]interface{}{"cr_type": crType,
+					"link": map[string]interface{}{"crn": crn, "namespace": namespace}}},
+		},
+		"GetLink": {
+			call: func(c *Client) error {
+				_, _, err := c.GetLink(context.Background(), &GetLinkOptions{ProfileID: &profileID, LinkID: &linkID})
+				return err
+			},
+			want: request{method: http.MethodGet, path: "/v1/profiles/" + profileID + "/links/" + linkID},
+		},
+		"DeleteLink": {
+			call: func(c *Client) error {
+				_, err := c.DeleteLink(context.Background(), &DeleteLinkOptions{ProfileID: &profileID, LinkID: &linkID})
+				return err
+			},
+			want: request{method: http.MethodDelete, path: "/v1/profiles/" + profileID + "/links/" + linkID},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := request{}
+			c, server := setupClient(t, http.StatusOK, map[string]string{"id": "someID"}, &got)
+			defer server.Close()
+
+			if err := tc.call(c); err != nil {
+				t.Errorf("%s(...): unexpected error: %s", name, err)
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(request{})); diff != "" {
+				t.Errorf("%s(...): -want request, +got request:\n%s", name, diff)
+			}
+		})
+	}
+}
+
+func TestClientResponses(t *testing.T) {
+	profileID := "Profile-12345678-abcd-1a2b-a1b2-1234567890ab"
+	iamID := "iam-" + profileID
+
+	t.Run("Successful", func(t *testing.T) {
+		c, server := setupClient(t, http.StatusOK, &TrustedProfile{ID: &profileID, IamID: &iamID}, &request{})
+		defer server.Close()
+
+		got, _, err := c.GetProfile(context.Background(), &GetProfileOptions{ProfileID: &profileID})
+		if err != nil {
+			t.Errorf("GetProfile(...): unexpected error: %s", err)
+		}
+		if diff := cmp.Diff(&TrustedProfile{ID: &profileID, IamID: &iamID}, got); diff != "" {
+			t.Errorf("GetProfile(...): -want, +got:\n%s", diff)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		c, server := setupClient(t, http.StatusNotFound, nil, &request{})
+		defer server.Close()
+
+		got, _, err := c.GetProfile(context.Background(), &GetProfileOptions{ProfileID: &profileID})
+		if err == nil || !ibmc.IsResourceNotFound(err) {
+			t.Errorf("GetProfile(...): want not found error, got: %v", err)
+		}
+		if got != nil {
+			t.Errorf("GetProfile(...): want no result, got: %v", got)
+		}
+	})
+
+	t.Run("MissingOptions", func(t *testing.T) {
+		c, server := setupClient(t, http.StatusOK, nil, &request{})
+		defer server.Close()
+
+		if _, _, err := c.GetProfile(context.Background(), &GetProfileOptions{}); err == nil {
+			t.Error("GetProfile(...): want error for missing profile ID")
+		}
+	})
+}
@@ -0,0 +1,79 @@
+package trustedprofile
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/iamidentityv1/v1alpha1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+)
+
+const (
+	// StateActive represents a trusted profile in a running, available, and ready state
+	StateActive = "active"
+)
+
+// LateInitializeSpec fills optional and unassigned fields with the values in *TrustedProfile object.
+func LateInitializeSpec(spec *v1alpha1.TrustedProfileParameters, in *TrustedProfile) error {
+	if spec.Description == nil {
+		spec.Description = in.Description
+	}
+	return nil
+}
+
+// GenerateCreateProfileOptions produces CreateProfileOptions object from TrustedProfileParameters object.
+func GenerateCreateProfileOptions(in v1alpha1.TrustedProfileParameters, o *CreateProfileOptions) error {
+	o.AccountID = reference.ToPtrValue(in.AccountID)
+	o.Name = reference.ToPtrValue(in.Name)
+	o.Description = in.Description
+	return nil
+}
+
+// GenerateUpdateProfileOptions produces UpdateProfileOptions object from TrustedProfileParameters object.
+func GenerateUpdateProfileOptions(id, eTag string, in v1alpha1.TrustedProfileParameters, o *UpdateProfileOptions) error {
+	o.ProfileID = reference.ToPtrValue(id)
+	o.IfMatch = reference.ToPtrValue(eTag)
+	o.Name = reference.ToPtrValue(in.Name)
+	o.Description = in.Description
+	return nil
+}
+
+// GenerateObservation produces TrustedProfileObservation object from *TrustedProfile object.
+func GenerateObservation(in *TrustedProfile) (v1alpha1.TrustedProfileObservation, error) {
+	o := v1alpha1.TrustedProfileObservation{
+		ID:         reference.FromPtrValue(in.ID),
+		IamID:      reference.FromPtrValue(in.IamID),
+		EntityTag:  reference.FromPtrValue(in.EntityTag),
+		CRN:        reference.FromPtrValue(in.CRN),
+		CreatedAt:  ibmc.DateTimeToMetaV1Time(in.CreatedAt),
+		ModifiedAt: ibmc.DateTimeToMetaV1Time(in.ModifiedAt),
+	}
+	return o, nil
+}
+
+// IsUpToDate checks whether current state is up-to-date compared to the given
+// set of parameters.
+func IsUpToDate(in *v1alpha1.TrustedProfileParameters, observed *TrustedProfile, l logging.Logger) (bool, error) {
+	desired := in.DeepCopy()
+	actual, err := GenerateTrustedProfileParameters(observed)
+	if err != nil {
+		return false, err
+	}
+
+	l.Info(cmp.Diff(desired, actual, cmpopts.EquateEmpty()))
+
+	return cmp.Equal(desired, actual, cmpopts.EquateEmpty()), nil
+}
+
+// GenerateTrustedProfileParameters generates trusted profile parameters from trusted profile
+func GenerateTrustedProfileParameters(in *TrustedProfile) (*v1alpha1.TrustedProfileParameters, error) {
+	o := &v1alpha1.TrustedProfileParameters{
+		AccountID:   reference.FromPtrValue(in.AccountID),
+		Name:        reference.FromPtrValue(in.Name),
+		Description: in.Description,
+	}
+	return o, nil
+}
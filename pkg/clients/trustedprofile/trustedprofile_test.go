@@ -0,0 +1,183 @@
+package trustedprofile
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/iamidentityv1/v1alpha1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+)
+
+var (
+	tpName         = "myTrustedProfile"
+	tpNewName      = "myNewTrustedProfile"
+	tpDescription  = "trusted profile for my cluster"
+	tpDescription2 = "another description"
+	tpAccountID    = "aa5a00334eaf9eb9339d2ab48f20d7ff"
+	tpID           = "Profile-12345678-abcd-1a2b-a1b2-1234567890ab"
+	tpIamID        = "iam-" + tpID
+	tpCrn          = "crn:v1:bluemix:public:iam-identity::a/aa5a00334eaf9eb9339d2ab48f20d7ff::profile:" + tpID
+	tpETag         = "1-eb832c7ff8c8016a542974b9f880b55e"
+	tpCreatedAt, _ = strfmt.ParseDateTime("2020-10-31T02:33:06Z")
+	tpModified, _  = strfmt.ParseDateTime("2020-10-31T03:33:06Z")
+)
+
+func params(m ...func(*v1alpha1.TrustedProfileParameters)) *v1alpha1.TrustedProfileParameters {
+	p := &v1alpha1.TrustedProfileParameters{
+		AccountID:   tpAccountID,
+		Name:        tpName,
+		Description: &tpDescription,
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func instance(m ...func(*TrustedProfile)) *TrustedProfile {
+	i := &TrustedProfile{
+		ID:          &tpID,
+		EntityTag:   &tpETag,
+		CRN:         &tpCrn,
+		Name:        &tpName,
+		Description: &tpDescription,
+		CreatedAt:   &tpCreatedAt,
+		ModifiedAt:  &tpModified,
+		IamID:       &tpIamID,
+		AccountID:   &tpAccountID,
+	}
+	for _, f := range m {
+		f(i)
+	}
+	return i
+}
+
+func TestGenerateCreateProfileOptions(t *testing.T) {
+	cases := map[string]struct {
+		params v1alpha1.TrustedProfileParameters
+		want   *CreateProfileOptions
+	}{
+		"FullConversion": {
+			params: *params(),
+			want:   &CreateProfileOptions{Name: &tpName, AccountID: &tpAccountID, Description: &tpDescription},
+		},
+		"MissingFields": {
+			params: *params(func(p *v1alpha1.TrustedProfileParameters) {
+				p.Description = nil
+			}),
+			want: &CreateProfileOptions{Name: &tpName, AccountID: &tpAccountID},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &CreateProfileOptions{}
+			_ = GenerateCreateProfileOptions(tc.params, r)
+			if diff := cmp.Diff(tc.want, r); diff != "" {
+				t.Errorf("GenerateCreateProfileOptions(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateUpdateProfileOptions(t *testing.T) {
+	r := &UpdateProfileOptions{}
+	_ = GenerateUpdateProfileOptions(tpID, tpETag, *params(func(p *v1alpha1.TrustedProfileParameters) {
+		p.Name = tpNewName
+	}), r)
+	want := &UpdateProfileOptions{ProfileID: &tpID, IfMatch: &tpETag, Name: &tpNewName, Description: &tpDescription}
+	if diff := cmp.Diff(want, r); diff != "" {
+		t.Errorf("GenerateUpdateProfileOptions(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeSpecs(t *testing.T) {
+	cases := map[string]struct {
+		params   *v1alpha1.TrustedProfileParameters
+		instance *TrustedProfile
+		want     *v1alpha1.TrustedProfileParameters
+	}{
+		"SomeFields": {
+			params: params(func(p *v1alpha1.TrustedProfileParameters) {
+				p.Description = nil
+			}),
+			instance: instance(),
+			want:     params(),
+		},
+		"AllFilledAlready": {
+			params: params(),
+			instance: instance(func(i *TrustedProfile) {
+				i.Description = &tpDescription2
+			}),
+			want: params(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_ = LateInitializeSpec(tc.params, tc.instance)
+			if diff := cmp.Diff(tc.want, tc.params); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	o, err := GenerateObservation(instance())
+	if diff := cmp.Diff(nil, err); diff != "" {
+		t.Errorf("GenerateObservation(...): want error != got error:\n%s", diff)
+	}
+	want := v1alpha1.TrustedProfileObservation{
+		ID:         tpID,
+		IamID:      tpIamID,
+		EntityTag:  tpETag,
+		CRN:        tpCrn,
+		CreatedAt:  ibmc.DateTimeToMetaV1Time(&tpCreatedAt),
+		ModifiedAt: ibmc.DateTimeToMetaV1Time(&tpModified),
+	}
+	if diff := cmp.Diff(want, o); diff != "" {
+		t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		params   *v1alpha1.TrustedProfileParameters
+		instance *TrustedProfile
+		want     bool
+	}{
+		"IsUpToDate": {
+			params:   params(),
+			instance: instance(),
+			want:     true,
+		},
+		"NeedsUpdate": {
+			params: params(func(p *v1alpha1.TrustedProfileParameters) {
+				p.Name = tpNewName
+			}),
+			instance: instance(),
+			want:     false,
+		},
+		"NeedsDescriptionUpdate": {
+			params: params(),
+			instance: instance(func(i *TrustedProfile) {
+				i.Description = &tpDescription2
+			}),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r, err := IsUpToDate(tc.params, tc.instance, logging.NewNopLogger())
+			if err != nil {
+				t.Error("IsUpToDate(...) unexpected error")
+			}
+			if diff := cmp.Diff(tc.want, r); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package trustedprofileclaimrule
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/iamidentityv1/v1alpha1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+	ibmctp "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/trustedprofile"
+)
+
+const (
+	// StateActive represents a claim rule in a running, available, and ready state
+	StateActive = "active"
+)
+
+// LateInitializeSpec fills optional and unassigned fields with the values in *ProfileClaimRule object.
+func LateInitializeSpec(spec *v1alpha1.TrustedProfileClaimRuleParameters, in *ibmctp.ProfileClaimRule) error {
+	if spec.Name == nil {
+		spec.Name = in.Name
+	}
+	if spec.RealmName == nil {
+		spec.RealmName = in.RealmName
+	}
+	if spec.CRType == nil {
+		spec.CRType = in.CrType
+	}
+	if spec.Expiration == nil {
+		spec.Expiration = in.Expiration
+	}
+	return nil
+}
+
+// GenerateCreateClaimRuleOptions produces CreateClaimRuleOptions object from TrustedProfileClaimRuleParameters object.
+func GenerateCreateClaimRuleOptions(in v1alpha1.TrustedProfileClaimRuleParameters, o *ibmctp.CreateClaimRuleOptions) error {
+	o.ProfileID = in.ProfileID
+	o.Type = reference.ToPtrValue(in.Type)
+	o.Conditions = GenerateSDKConditions(in.Conditions)
+	o.Name = in.Name
+	o.RealmName = in.RealmName
+	o.CrType = in.CRType
+	o.Expiration = in.Expiration
+	return nil
+}
+
+// GenerateUpdateClaimRuleOptions produces UpdateClaimRuleOptions object from TrustedProfileClaimRuleParameters object.
+func GenerateUpdateClaimRuleOptions(id, eTag string, in v1alpha1.TrustedProfileClaimRuleParameters, o *ibmctp.UpdateClaimRuleOptions) error {
+	o.ProfileID = in.ProfileID
+	o.RuleID = reference.ToPtrValue(id)
+	o.IfMatch = reference.ToPtrValue(eTag)
+	o.Type = reference.ToPtrValue(in.Type)
+	o.Conditions = GenerateSDKConditions(in.Conditions)
+	o.Name = in.Name
+	o.RealmName = in.RealmName
+	o.CrType = in.CRType
+	o.Expiration = in.Expiration
+	return nil
+}
+
+// GenerateSDKConditions generates the conditions of a claim rule for the API from crossplane ones
+func GenerateSDKConditions(in []v1alpha1.ProfileClaimRuleCondition) []ibmctp.ProfileClaimRuleConditions {
+	if in == nil {
+		return nil
+	}
+	o := make([]ibmctp.ProfileClaimRuleConditions, len(in))
+	for i, c := range in {
+		o[i] = ibmctp.ProfileClaimRuleConditions{
+			Claim:    reference.ToPtrValue(c.Claim),
+			Operator: reference.ToPtrValue(c.Operator),
+			Value:    reference.ToPtrValue(c.Value),
+		}
+	}
+	return o
+}
+
+// GenerateCrossplaneConditions generates the crossplane conditions of a claim rule from the ones of the API
+func GenerateCrossplaneConditions(in []ibmctp.ProfileClaimRuleConditions) []v1alpha1.ProfileClaimRuleCondition {
+	if in == nil {
+		return nil
+	}
+	o := make([]v1alpha1.ProfileClaimRuleCondition, len(in))
+	for i, c := range in {
+		o[i] = v1alpha1.ProfileClaimRuleCondition{
+			Claim:    reference.FromPtrValue(c.Claim),
+			Operator: reference.FromPtrValue(c.Operator),
+			Value:    reference.FromPtrValue(c.Value),
+		}
+	}
+	return o
+}
+
+// GenerateObservation produces TrustedProfileClaimRuleObservation object from *ProfileClaimRule object.
+func GenerateObservation(in *ibmctp.ProfileClaimRule) (v1alpha1.TrustedProfileClaimRuleObservation, error) {
+	o := v1alpha1.TrustedProfileClaimRuleObservation{
+		ID:         reference.FromPtrValue(in.ID),
+		EntityTag:  reference.FromPtrValue(in.EntityTag),
+		CreatedAt:  ibmc.DateTimeToMetaV1Time(in.CreatedAt),
+		ModifiedAt: ibmc.DateTimeToMetaV1Time(in.ModifiedAt),
+	}
+	return o, nil
+}
+
+// IsUpToDate checks whether current state is up-to-date compared to the given
+// set of parameters.
+func IsUpToDate(in *v1alpha1.TrustedProfileClaimRuleParameters, observed *ibmctp.ProfileClaimRule, l logging.Logger) (bool, error) {
+	desired := in.DeepCopy()
+	actual, err := GenerateTrustedProfileClaimRuleParameters(observed)
+	if err != nil {
+		return false, err
+	}
+
+	l.Info(cmp.Diff(desired, actual, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(v1alpha1.TrustedProfileClaimRuleParameters{}, "ProfileID"),
+		cmpopts.IgnoreTypes(&runtimev1alpha1.Reference{}, &runtimev1alpha1.Selector{})))
+
+	return cmp.Equal(desired, actual, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(v1alpha1.TrustedProfileClaimRuleParameters{}, "ProfileID"),
+		cmpopts.IgnoreTypes(&runtimev1alpha1.Reference{}, &runtimev1alpha1.Selector{})), nil
+}
+
+// GenerateTrustedProfileClaimRuleParameters generates claim rule parameters from claim rule
+func GenerateTrustedProfileClaimRuleParameters(in *ibmctp.ProfileClaimRule) (*v1alpha1.TrustedProfileClaimRuleParameters, error) {
+	o := &v1alpha1.TrustedProfileClaimRuleParameters{
+		Type:       reference.FromPtrValue(in.Type),
+		Conditions: GenerateCrossplaneConditions(in.Conditions),
+		Name:       in.Name,
+		RealmName:  in.RealmName,
+		CRType:     in.CrType,
+		Expiration: in.Expiration,
+	}
+	return o, nil
+}
@@ -0,0 +1,194 @@
+package trustedprofileclaimrule
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/go-cmp/cmp"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/iamidentityv1/v1alpha1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+	ibmctp "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/trustedprofile"
+)
+
+var (
+	profileID     = "Profile-12345678-abcd-1a2b-a1b2-1234567890ab"
+	ruleID        = "ClaimRule-12345678-abcd-1a2b-a1b2-1234567890ab"
+	ruleName      = "myClaimRule"
+	ruleType      = "Profile-CR"
+	crType        = "IKS_SA"
+	expiration    = int64(43200)
+	expiration2   = int64(3600)
+	claim         = "namespace"
+	operator      = "EQUALS"
+	value         = "default"
+	value2        = "kube-system"
+	eTag          = "1-eb832c7ff8c8016a542974b9f880b55e"
+	createdAt, _  = strfmt.ParseDateTime("2020-10-31T02:33:06Z")
+	modifiedAt, _ = strfmt.ParseDateTime("2020-10-31T03:33:06Z")
+)
+
+func params(m ...func(*v1alpha1.TrustedProfileClaimRuleParameters)) *v1alpha1.TrustedProfileClaimRuleParameters {
+	p := &v1alpha1.TrustedProfileClaimRuleParameters{
+		ProfileID:  &profileID,
+		Type:       ruleType,
+		Conditions: []v1alpha1.ProfileClaimRuleCondition{{Claim: claim, Operator: operator, Value: value}},
+		Name:       &ruleName,
+		CRType:     &crType,
+		Expiration: &expiration,
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func instance(m ...func(*ibmctp.ProfileClaimRule)) *ibmctp.ProfileClaimRule {
+	i := &ibmctp.ProfileClaimRule{
+		ID:         &ruleID,
+		EntityTag:  &eTag,
+		CreatedAt:  &createdAt,
+		ModifiedAt: &modifiedAt,
+		Name:       &ruleName,
+		Type:       &ruleType,
+		CrType:     &crType,
+		Expiration: &expiration,
+		Conditions: []ibmctp.ProfileClaimRuleConditions{{Claim: &claim, Operator: &operator, Value: &value}},
+	}
+	for _, f := range m {
+		f(i)
+	}
+	return i
+}
+
+func TestGenerateCreateClaimRuleOptions(t *testing.T) {
+	r := &ibmctp.CreateClaimRuleOptions{}
+	_ = GenerateCreateClaimRuleOptions(*params(), r)
+	want := &ibmctp.CreateClaimRuleOptions{
+		ProfileID:  &profileID,
+		Type:       &ruleType,
+		Conditions: []ibmctp.ProfileClaimRuleConditions{{Claim: &claim, Operator: &operator, Value: &value}},
+		Name:       &ruleName,
+		CrType:     &crType,
+		Expiration: &expiration,
+	}
+	if diff := cmp.Diff(want, r); diff != "" {
+		t.Errorf("GenerateCreateClaimRuleOptions(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateUpdateClaimRuleOptions(t *testing.T) {
+	r := &ibmctp.UpdateClaimRuleOptions{}
+	_ = GenerateUpdateClaimRuleOptions(ruleID, eTag, *params(), r)
+	want := &ibmctp.UpdateClaimRuleOptions{
+		ProfileID:  &profileID,
+		RuleID:     &ruleID,
+		IfMatch:    &eTag,
+		Type:       &ruleType,
+		Conditions: []ibmctp.ProfileClaimRuleConditions{{Claim: &claim, Operator: &operator, Value: &value}},
+		Name:       &ruleName,
+		CrType:     &crType,
+		Expiration: &expiration,
+	}
+	if diff := cmp.Diff(want, r); diff != "" {
+		t.Errorf("GenerateUpdateClaimRuleOptions(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeSpecs(t *testing.T) {
+	cases := map[string]struct {
+		params   *v1alpha1.TrustedProfileClaimRuleParameters
+		instance *ibmctp.ProfileClaimRule
+		want     *v1alpha1.TrustedProfileClaimRuleParameters
+	}{
+		"SomeFields": {
+			params: params(func(p *v1alpha1.TrustedProfileClaimRuleParameters) {
+				p.Name = nil
+				p.CRType = nil
+				p.Expiration = nil
+			}),
+			instance: instance(),
+			want:     params(),
+		},
+		"AllFilledAlready": {
+			params: params(),
+			instance: instance(func(i *ibmctp.ProfileClaimRule) {
+				i.Expiration = &expiration2
+			}),
+			want: params(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_ = LateInitializeSpec(tc.params, tc.instance)
+			if diff := cmp.Diff(tc.want, tc.params); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	o, err := GenerateObservation(instance())
+	if diff := cmp.Diff(nil, err); diff != "" {
+		t.Errorf("GenerateObservation(...): want error != got error:\n%s", diff)
+	}
+	want := v1alpha1.TrustedProfileClaimRuleObservation{
+		ID:         ruleID,
+		EntityTag:  eTag,
+		CreatedAt:  ibmc.DateTimeToMetaV1Time(&createdAt),
+		ModifiedAt: ibmc.DateTimeToMetaV1Time(&modifiedAt),
+	}
+	if diff := cmp.Diff(want, o); diff != "" {
+		t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		params   *v1alpha1.TrustedProfileClaimRuleParameters
+		instance *ibmctp.ProfileClaimRule
+		want     bool
+	}{
+		"IsUpToDate": {
+			params:   params(),
+			instance: instance(),
+			want:     true,
+		},
+		"IgnoresReferences": {
+			params: params(func(p *v1alpha1.TrustedProfileClaimRuleParameters) {
+				p.ProfileIDRef = &runtimev1alpha1.Reference{Name: "myprofile"}
+			}),
+			instance: instance(),
+			want:     true,
+		},
+		"NeedsConditionsUpdate": {
+			params: params(),
+			instance: instance(func(i *ibmctp.ProfileClaimRule) {
+				i.Conditions = []ibmctp.ProfileClaimRuleConditions{{Claim: &claim, Operator: &operator, Value: &value2}}
+			}),
+			want: false,
+		},
+		"NeedsExpirationUpdate": {
+			params: params(),
+			instance: instance(func(i *ibmctp.ProfileClaimRule) {
+				i.Expiration = &expiration2
+			}),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r, err := IsUpToDate(tc.params, tc.instance, logging.NewNopLogger())
+			if err != nil {
+				t.Error("IsUpToDate(...) unexpected error")
+			}
+			if diff := cmp.Diff(tc.want, r); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
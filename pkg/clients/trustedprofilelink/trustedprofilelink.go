@@ -0,0 +1,49 @@
+package trustedprofilelink
+
+import (
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/iamidentityv1/v1alpha1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+	ibmctp "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/trustedprofile"
+)
+
+const (
+	// StateActive represents a link in a running, available, and ready state
+	StateActive = "active"
+)
+
+// LateInitializeSpec fills optional and unassigned fields with the values in *ProfileLink object.
+func LateInitializeSpec(spec *v1alpha1.TrustedProfileLinkParameters, in *ibmctp.ProfileLink) error {
+	if spec.Name == nil {
+		spec.Name = in.Name
+	}
+	if spec.Link.ServiceAccount == nil && in.Link != nil {
+		spec.Link.ServiceAccount = in.Link.Name
+	}
+	return nil
+}
+
+// GenerateCreateLinkOptions produces CreateLinkOptions object from TrustedProfileLinkParameters object.
+func GenerateCreateLinkOptions(in v1alpha1.TrustedProfileLinkParameters, o *ibmctp.CreateLinkOptions) error {
+	o.ProfileID = in.ProfileID
+	o.Name = in.Name
+	o.CrType = reference.ToPtrValue(in.CRType)
+	o.Link = &ibmctp.ProfileLinkLink{
+		CRN:       reference.ToPtrValue(in.Link.CRN),
+		Namespace: reference.ToPtrValue(in.Link.Namespace),
+		Name:      in.Link.ServiceAccount,
+	}
+	return nil
+}
+
+// GenerateObservation produces TrustedProfileLinkObservation object from *ProfileLink object.
+func GenerateObservation(in *ibmctp.ProfileLink) (v1alpha1.TrustedProfileLinkObservation, error) {
+	o := v1alpha1.TrustedProfileLinkObservation{
+		ID:         reference.FromPtrValue(in.ID),
+		EntityTag:  reference.FromPtrValue(in.EntityTag),
+		CreatedAt:  ibmc.DateTimeToMetaV1Time(in.CreatedAt),
+		ModifiedAt: ibmc.DateTimeToMetaV1Time(in.ModifiedAt),
+	}
+	return o, nil
+}
@@ -0,0 +1,151 @@
+package trustedprofilelink
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/iamidentityv1/v1alpha1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+	ibmctp "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/trustedprofile"
+)
+
+var (
+	profileID      = "Profile-12345678-abcd-1a2b-a1b2-1234567890ab"
+	linkID         = "ProfileLink-12345678-abcd-1a2b-a1b2-1234567890ab"
+	linkName       = "myLink"
+	crType         = "IKS_SA"
+	clusterCRN     = "crn:v1:bluemix:public:containers-kubernetes:us-south:a/aa5a00334eaf9eb9339d2ab48f20d7ff:c1234::"
+	namespace      = "default"
+	serviceAccount = "my-app"
+	eTag           = "1-eb832c7ff8c8016a542974b9f880b55e"
+	createdAt, _   = strfmt.ParseDateTime("2020-10-31T02:33:06Z")
+	modifiedAt, _  = strfmt.ParseDateTime("2020-10-31T03:33:06Z")
+)
+
+func params(m ...func(*v1alpha1.TrustedProfileLinkParameters)) *v1alpha1.TrustedProfileLinkParameters {
+	p := &v1alpha1.TrustedProfileLinkParameters{
+		ProfileID: &profileID,
+		Name:      &linkName,
+		CRType:    crType,
+		Link: v1alpha1.ProfileLinkLink{
+			CRN:            clusterCRN,
+			Namespace:      namespace,
+			ServiceAccount: &serviceAccount,
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func instance(m ...func(*ibmctp.ProfileLink)) *ibmctp.ProfileLink {
+	i := &ibmctp.ProfileLink{
+		ID:         &linkID,
+		EntityTag:  &eTag,
+		CreatedAt:  &createdAt,
+		ModifiedAt: &modifiedAt,
+		Name:       &linkName,
+		CrType:     &crType,
+		Link: &ibmctp.ProfileLinkLink{
+			CRN:       &clusterCRN,
+			Namespace: &namespace,
+			Name:      &serviceAccount,
+		},
+	}
+	for _, f := range m {
+		f(i)
+	}
+	return i
+}
+
+func TestGenerateCreateLinkOptions(t *testing.T) {
+	cases := map[string]struct {
+		params v1alpha1.TrustedProfileLinkParameters
+		want   *ibmctp.CreateLinkOptions
+	}{
+		"FullConversion": {
+			params: *params(),
+			want: &ibmctp.CreateLinkOptions{
+				ProfileID: &profileID,
+				Name:      &linkName,
+				CrType:    &crType,
+				Link:      &ibmctp.ProfileLinkLink{CRN: &clusterCRN, Namespace: &namespace, Name: &serviceAccount},
+			},
+		},
+		"MissingFields": {
+			params: *params(func(p *v1alpha1.TrustedProfileLinkParameters) {
+				p.Name = nil
+				p.Link.ServiceAccount = nil
+			}),
+			want: &ibmctp.CreateLinkOptions{
+				ProfileID: &profileID,
+				CrType:    &crType,
+				Link:      &ibmctp.ProfileLinkLink{CRN: &clusterCRN, Namespace: &namespace},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &ibmctp.CreateLinkOptions{}
+			_ = GenerateCreateLinkOptions(tc.params, r)
+			if diff := cmp.Diff(tc.want, r); diff != "" {
+				t.Errorf("GenerateCreateLinkOptions(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSpecs(t *testing.T) {
+	cases := map[string]struct {
+		params   *v1alpha1.TrustedProfileLinkParameters
+		instance *ibmctp.ProfileLink
+		want     *v1alpha1.TrustedProfileLinkParameters
+	}{
+		"SomeFields": {
+			params: params(func(p *v1alpha1.TrustedProfileLinkParameters) {
+				p.Name = nil
+				p.Link.ServiceAccount = nil
+			}),
+			instance: instance(),
+			want:     params(),
+		},
+		"NoLinkInResponse": {
+			params: params(func(p *v1alpha1.TrustedProfileLinkParameters) {
+				p.Link.ServiceAccount = nil
+			}),
+			instance: instance(func(i *ibmctp.ProfileLink) {
+				i.Link = nil
+			}),
+			want: params(func(p *v1alpha1.TrustedProfileLinkParameters) {
+				p.Link.ServiceAccount = nil
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_ = LateInitializeSpec(tc.params, tc.instance)
+			if diff := cmp.Diff(tc.want, tc.params); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	o, err := GenerateObservation(instance())
+	if diff := cmp.Diff(nil, err); diff != "" {
+		t.Errorf("GenerateObservation(...): want error != got error:\n%s", diff)
+	}
+	want := v1alpha1.TrustedProfileLinkObservation{
+		ID:         linkID,
+		EntityTag:  eTag,
+		CreatedAt:  ibmc.DateTimeToMetaV1Time(&createdAt),
+		ModifiedAt: ibmc.DateTimeToMetaV1Time(&modifiedAt),
+	}
+	if diff := cmp.Diff(want, o); diff != "" {
+		t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,107 @@
+package user
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	umv1 "github.com/IBM/platform-services-go-sdk/usermanagementv1"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/usermanagementv1/v1alpha1"
+)
+
+const (
+	// StateProcessing represents a user whose invitation is being processed
+	StateProcessing = "PROCESSING"
+
+	// StatePending represents a user who has been invited, but has not accepted the invitation yet
+	StatePending = "PENDING"
+
+	// StateActive represents a user who is an active member of the account
+	StateActive = "ACTIVE"
+)
+
+// LateInitializeSpec fills optional and unassigned fields with the values in *umv1.UserSettings object.
+func LateInitializeSpec(spec *v1alpha1.UserParameters, in *umv1.UserSettings) error {
+	if spec.Language == nil {
+		spec.Language = in.Language
+	}
+	if spec.NotificationLanguage == nil {
+		spec.NotificationLanguage = in.NotificationLanguage
+	}
+	if spec.AllowedIPAddresses == nil {
+		spec.AllowedIPAddresses = in.AllowedIPAddresses
+	}
+	if spec.SelfManage == nil {
+		spec.SelfManage = in.SelfManage
+	}
+	return nil
+}
+
+// GenerateInviteUsersOptions produces InviteUsersOptions object from UserParameters object.
+func GenerateInviteUsersOptions(in v1alpha1.UserParameters, o *umv1.InviteUsersOptions) error {
+	o.AccountID = reference.ToPtrValue(in.AccountID)
+	o.Users = []umv1.InviteUser{
+		{
+			Email:       reference.ToPtrValue(in.Email),
+			AccountRole: in.AccountRole,
+		},
+	}
+	return nil
+}
+
+// GenerateUpdateUserSettingsOptions produces UpdateUserSettingsOptions object from UserParameters object.
+func GenerateUpdateUserSettingsOptions(iamID string, in v1alpha1.UserParameters, o *umv1.UpdateUserSettingsOptions) error {
+	o.AccountID = reference.ToPtrValue(in.AccountID)
+	o.IamID = reference.ToPtrValue(iamID)
+	o.Language = in.Language
+	o.NotificationLanguage = in.NotificationLanguage
+	o.AllowedIPAddresses = in.AllowedIPAddresses
+	o.SelfManage = in.SelfManage
+	return nil
+}
+
+// GenerateObservation produces UserObservation object from *umv1.UserProfile object.
+func GenerateObservation(in *umv1.UserProfile) (v1alpha1.UserObservation, error) {
+	o := v1alpha1.UserObservation{
+		IamID:     reference.FromPtrValue(in.IamID),
+		ID:        reference.FromPtrValue(in.ID),
+		Realm:     reference.FromPtrValue(in.Realm),
+		UserID:    reference.FromPtrValue(in.UserID),
+		Firstname: reference.FromPtrValue(in.Firstname),
+		Lastname:  reference.FromPtrValue(in.Lastname),
+		State:     reference.FromPtrValue(in.State),
+	}
+	return o, nil
+}
+
+// IsUpToDate checks whether the settings of a user are up-to-date compared to the given set of parameters. The
+// invitation fields of the parameters cannot be updated, so they are not compared.
+func IsUpToDate(in *v1alpha1.UserParameters, observed *umv1.UserSettings, l logging.Logger) (bool, error) {
+	desired := in.DeepCopy()
+	actual, err := GenerateUserParameters(in, observed)
+	if err != nil {
+		return false, err
+	}
+
+	l.Info(cmp.Diff(desired, actual, cmpopts.EquateEmpty()))
+
+	return cmp.Equal(desired, actual, cmpopts.EquateEmpty()), nil
+}
+
+// GenerateUserParameters generates user parameters from the settings of a user, taking the invitation fields from
+// the given parameters
+func GenerateUserParameters(invitation *v1alpha1.UserParameters, in *umv1.UserSettings) (*v1alpha1.UserParameters, error) {
+	o := &v1alpha1.UserParameters{
+		AccountID:            invitation.AccountID,
+		Email:                invitation.Email,
+		AccountRole:          invitation.AccountRole,
+		Language:             in.Language,
+		NotificationLanguage: in.NotificationLanguage,
+		AllowedIPAddresses:   in.AllowedIPAddresses,
+		SelfManage:           in.SelfManage,
+	}
+	return o, nil
+}
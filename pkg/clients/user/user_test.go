@@ -0,0 +1,275 @@
+package user
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+
+	umv1 "github.com/IBM/platform-services-go-sdk/usermanagementv1"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/usermanagementv1/v1alpha1"
+)
+
+var (
+	accountID            = "aa5a00334eaf9eb9339d2ab48f20d7ff"
+	iamID                = "IBMid-550006JKXX"
+	profileID            = "2f2d1f3b0c9a4d6fb2c1d0e9a8b7c6d5"
+	email                = "developer@example.com"
+	accountRole          = "Member"
+	realm                = "IBMid"
+	firstname            = "Jane"
+	lastname             = "Doe"
+	language             = "en"
+	notificationLanguage = "fr"
+	allowedIPAddresses   = "192.168.0.0/16,10.0.0.1"
+	selfManage           = true
+	notSelfManage        = false
+	state                = StatePending
+)
+
+func params(m ...func(*v1alpha1.UserParameters)) *v1alpha1.UserParameters {
+	p := &v1alpha1.UserParameters{
+		AccountID:            accountID,
+		Email:                email,
+		AccountRole:          &accountRole,
+		Language:             &language,
+		NotificationLanguage: &notificationLanguage,
+		AllowedIPAddresses:   &allowedIPAddresses,
+		SelfManage:           &selfManage,
+	}
+
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func settings(m ...func(*umv1.UserSettings)) *umv1.UserSettings {
+	i := &umv1.UserSettings{
+		Language:             &language,
+		NotificationLanguage: &notificationLanguage,
+		AllowedIPAddresses:   &allowedIPAddresses,
+		SelfManage:           &selfManage,
+	}
+
+	for _, f := range m {
+		f(i)
+	}
+	return i
+}
+
+func TestGenerateInviteUsersOptions(t *testing.T) {
+	type args struct {
+		params v1alpha1.UserParameters
+	}
+	type want struct {
+		instance *umv1.InviteUsersOptions
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"FullConversion": {
+			args: args{params: *params()},
+			want: want{instance: &umv1.InviteUsersOptions{
+				AccountID: &accountID,
+				Users:     []umv1.InviteUser{{Email: &email, AccountRole: &accountRole}},
+			}},
+		},
+		"MissingFields": {
+			args: args{params: *params(func(p *v1alpha1.UserParameters) {
+				p.AccountRole = nil
+			})},
+			want: want{instance: &umv1.InviteUsersOptions{
+				AccountID: &accountID,
+				Users:     []umv1.InviteUser{{Email: &email}},
+			}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &umv1.InviteUsersOptions{}
+			_ = GenerateInviteUsersOptions(tc.args.params, r)
+			if diff := cmp.Diff(tc.want.instance, r); diff != "" {
+				t.Errorf("GenerateInviteUsersOptions(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateUpdateUserSettingsOptions(t *testing.T) {
+	type args struct {
+		iamID  string
+		params v1alpha1.UserParameters
+	}
+	type want struct {
+		instance *umv1.UpdateUserSettingsOptions
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"FullConversion": {
+			args: args{iamID: iamID, params: *params()},
+			want: want{instance: &umv1.UpdateUserSettingsOptions{
+				AccountID:            &accountID,
+				IamID:                &iamID,
+				Language:             &language,
+				NotificationLanguage: &notificationLanguage,
+				AllowedIPAddresses:   &allowedIPAddresses,
+				SelfManage:           &selfManage,
+			}},
+		},
+		"MissingFields": {
+			args: args{iamID: iamID, params: *params(func(p *v1alpha1.UserParameters) {
+				p.Language = nil
+				p.AllowedIPAddresses = nil
+			})},
+			want: want{instance: &umv1.UpdateUserSettingsOptions{
+				AccountID:            &accountID,
+				IamID:                &iamID,
+				NotificationLanguage: &notificationLanguage,
+				SelfManage:           &selfManage,
+			}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &umv1.UpdateUserSettingsOptions{}
+			_ = GenerateUpdateUserSettingsOptions(tc.args.iamID, tc.args.params, r)
+			if diff := cmp.Diff(tc.want.instance, r); diff != "" {
+				t.Errorf("GenerateUpdateUserSettingsOptions(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSpecs(t *testing.T) {
+	type args struct {
+		instance *umv1.UserSettings
+		params   *v1alpha1.UserParameters
+	}
+	type want struct {
+		params *v1alpha1.UserParameters
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"SomeFields": {
+			args: args{
+				params: params(func(p *v1alpha1.UserParameters) {
+					p.Language = nil
+					p.AllowedIPAddresses = nil
+					p.SelfManage = nil
+				}),
+				instance: settings(),
+			},
+			want: want{
+				params: params()},
+		},
+		"AllFilledAlready": {
+			args: args{
+				params: params(),
+				instance: settings(func(i *umv1.UserSettings) {
+					i.Language = &notificationLanguage
+					i.SelfManage = &notSelfManage
+				}),
+			},
+			want: want{
+				params: params()},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_ = LateInitializeSpec(tc.args.params, tc.args.instance)
+			if diff := cmp.Diff(tc.want.params, tc.args.params); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	profile := &umv1.UserProfile{
+		ID:        &profileID,
+		IamID:     &iamID,
+		Realm:     &realm,
+		UserID:    &email,
+		Firstname: &firstname,
+		Lastname:  &lastname,
+		State:     &state,
+		Email:     &email,
+		AccountID: &accountID,
+	}
+	o, err := GenerateObservation(profile)
+	if diff := cmp.Diff(nil, err); diff != "" {
+		t.Errorf("GenerateObservation(...): want error != got error:\n%s", diff)
+	}
+	want := v1alpha1.UserObservation{
+		IamID:     iamID,
+		ID:        profileID,
+		Realm:     realm,
+		UserID:    email,
+		Firstname: firstname,
+		Lastname:  lastname,
+		State:     StatePending,
+	}
+	if diff := cmp.Diff(want, o); diff != "" {
+		t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	type args struct {
+		params   *v1alpha1.UserParameters
+		instance *umv1.UserSettings
+	}
+	type want struct {
+		upToDate bool
+		isErr    bool
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"IsUpToDate": {
+			args: args{
+				params:   params(),
+				instance: settings(),
+			},
+			want: want{upToDate: true, isErr: false},
+		},
+		"SelfManageDrifted": {
+			args: args{
+				params: params(),
+				instance: settings(func(i *umv1.UserSettings) {
+					i.SelfManage = &notSelfManage
+				}),
+			},
+			want: want{upToDate: false, isErr: false},
+		},
+		"IPRestrictionsRemoved": {
+			args: args{
+				params: params(),
+				instance: settings(func(i *umv1.UserSettings) {
+					i.AllowedIPAddresses = nil
+				}),
+			},
+			want: want{upToDate: false, isErr: false},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r, err := IsUpToDate(tc.args.params, tc.args.instance, logging.NewNopLogger())
+			if err != nil && !tc.want.isErr {
+				t.Error("IsUpToDate(...) unexpected error")
+			}
+			if diff := cmp.Diff(tc.want.upToDate, r); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
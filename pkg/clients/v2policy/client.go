@@ -0,0 +1,201 @@
+package v2policy
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+
+	iampmv1 "github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/sdkrequest"
+)
+
+// The version of the IAM Policy Management SDK in use only covers v1 policies, so this file provides a minimal client
+// for the v2 policy endpoints of the IAM Policy Management API, modeled on the SDK. It shares the service URL,
+// authenticator and HTTP client of the IAM Policy Management SDK client it is created from.
+
+const (
+	pathPolicies = `/v2/policies`
+	pathPolicy   = `/v2/policies/{policy_id}`
+
+	errMissingOptions = "options and their required fields must be set"
+)
+
+// V2Policy : The core set of properties associated with a v2 policy.
+type V2Policy struct {
+	ID               *string           `json:"id,omitempty"`
+	Type             *string           `json:"type,omitempty"`
+	Description      *string           `json:"description,omitempty"`
+	Subject          *V2PolicySubject  `json:"subject,omitempty"`
+	Control          *Control          `json:"control,omitempty"`
+	Resource         *V2PolicyResource `json:"resource,omitempty"`
+	Pattern          *string           `json:"pattern,omitempty"`
+	Rule             *V2PolicyRule     `json:"rule,omitempty"`
+	Href             *string           `json:"href,omitempty"`
+	CreatedAt        *strfmt.DateTime  `json:"created_at,omitempty"`
+	CreatedByID      *string           `json:"created_by_id,omitempty"`
+	LastModifiedAt   *strfmt.DateTime  `json:"last_modified_at,omitempty"`
+	LastModifiedByID *string           `json:"last_modified_by_id,omitempty"`
+	State            *string           `json:"state,omitempty"`
+}
+
+// V2PolicySubject : The subject attributes for whom the policy grants access.
+type V2PolicySubject struct {
+	Attributes []V2PolicySubjectAttribute `json:"attributes"`
+}
+
+// V2PolicySubjectAttribute : A subject attribute of a v2 policy.
+type V2PolicySubjectAttribute struct {
+	Key      *string `json:"key"`
+	Operator *string `json:"operator"`
+	Value    *string `json:"value"`
+}
+
+// V2PolicyResource : The resource attributes to which the policy grants access.
+type V2PolicyResource struct {
+	Attributes []V2PolicyResourceAttribute `json:"attributes"`
+	Tags       []V2PolicyResourceTag       `json:"tags,omitempty"`
+}
+
+// V2PolicyResourceAttribute : A resource attribute of a v2 policy. Its value is a string, or a boolean for the
+// 'stringExists' operator.
+type V2PolicyResourceAttribute struct {
+	Key      *string     `json:"key"`
+	Operator *string     `json:"operator"`
+	Value    interface{} `json:"value"`
+}
+
+// V2PolicyResourceTag : A resource tag of a v2 policy.
+type V2PolicyResourceTag struct {
+	Key      *string `json:"key"`
+	Value    *string `json:"value"`
+	Operator *string `json:"operator"`
+}
+
+// Control : Specifies the type of access granted by the policy.
+type Control struct {
+	Grant *Grant `json:"grant"`
+}
+
+// Grant : Permission granted by the policy.
+type Grant struct {
+	Roles []Roles `json:"roles"`
+}
+
+// Roles : A role associated with a policy.
+type Roles struct {
+	RoleID *string `json:"role_id"`
+}
+
+// V2PolicyRule : The rule of a v2 policy; either a single condition (key, operator and value), or several conditions
+// combined by a logical operator.
+type V2PolicyRule struct {
+	Key        *string         `json:"key,omitempty"`
+	Operator   *string         `json:"operator"`
+	Value      interface{}     `json:"value,omitempty"`
+	Conditions []RuleAttribute `json:"conditions,omitempty"`
+}
+
+// RuleAttribute : A condition of the rule of a v2 policy. Its value is a string, or a list of strings.
+type RuleAttribute struct {
+	Key      *string     `json:"key"`
+	Operator *string     `json:"operator"`
+	Value    interface{} `json:"value"`
+}
+
+// CreateV2PolicyOptions : The CreateV2Policy options.
+type CreateV2PolicyOptions struct {
+	Type        *string           `json:"type"`
+	Control     *Control          `json:"control"`
+	Description *string           `json:"description,omitempty"`
+	Subject     *V2PolicySubject  `json:"subject,omitempty"`
+	Resource    *V2PolicyResource `json:"resource,omitempty"`
+	Pattern     *string           `json:"pattern,omitempty"`
+	Rule        *V2PolicyRule     `json:"rule,omitempty"`
+}
+
+// GetV2PolicyOptions : The GetV2Policy options.
+type GetV2PolicyOptions struct {
+	ID *string
+}
+
+// UpdateV2PolicyOptions : The UpdateV2Policy options.
+type UpdateV2PolicyOptions struct {
+	ID          *string           `json:"-"`
+	IfMatch     *string           `json:"-"`
+	Type        *string           `json:"type"`
+	Control     *Control          `json:"control"`
+	Description *string           `json:"description,omitempty"`
+	Subject     *V2PolicySubject  `json:"subject,omitempty"`
+	Resource    *V2PolicyResource `json:"resource,omitempty"`
+	Pattern     *string           `json:"pattern,omitempty"`
+	Rule        *V2PolicyRule     `json:"rule,omitempty"`
+}
+
+// DeleteV2PolicyOptions : The DeleteV2Policy options.
+type DeleteV2PolicyOptions struct {
+	ID *string
+}
+
+// Client is a client of the v2 policy endpoints of the IAM Policy Management API
+type Client struct {
+	service *core.BaseService
+}
+
+// NewClient returns a client of the v2 policy endpoints, which shares the base service of the given IAM Policy
+// Management client.
+func NewClient(iamPolicyManagement *iampmv1.IamPolicyManagementV1) *Client {
+	return &Client{service: iamPolicyManagement.Service}
+}
+
+// CreateV2Policy creates a v2 policy.
+func (c *Client) CreateV2Policy(ctx context.Context, o *CreateV2PolicyOptions) (*V2Policy, *core.DetailedResponse, error) {
+	if o == nil || o.Type == nil || o.Control == nil {
+		return nil, nil, errors.New(errMissingOptions)
+	}
+	result := &V2Policy{}
+	response, err := sdkrequest.Do(ctx, c.service, http.MethodPost, pathPolicies, nil, nil, o, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+// GetV2Policy retrieves a v2 policy.
+func (c *Client) GetV2Policy(ctx context.Context, o *GetV2PolicyOptions) (*V2Policy, *core.DetailedResponse, error) {
+	if o == nil || o.ID == nil {
+		return nil, nil, errors.New(errMissingOptions)
+	}
+	result := &V2Policy{}
+	response, err := sdkrequest.Do(ctx, c.service, http.MethodGet, pathPolicy,
+		map[string]string{"policy_id": *o.ID}, nil, nil, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+// UpdateV2Policy replaces a v2 policy.
+func (c *Client) UpdateV2Policy(ctx context.Context, o *UpdateV2PolicyOptions) (*V2Policy, *core.DetailedResponse, error) {
+	if o == nil || o.ID == nil || o.IfMatch == nil || o.Type == nil || o.Control == nil {
+		return nil, nil, errors.New(errMissingOptions)
+	}
+	result := &V2Policy{}
+	response, err := sdkrequest.Do(ctx, c.service, http.MethodPut, pathPolicy, map[string]string{"policy_id": *o.ID},
+		map[string]string{"If-Match": *o.IfMatch}, o, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+// DeleteV2Policy deletes a v2 policy.
+func (c *Client) DeleteV2Policy(ctx context.Context, o *DeleteV2PolicyOptions) (*core.DetailedResponse, error) {
+	if o == nil || o.ID == nil {
+		return nil, errors.New(errMissingOptions)
+	}
+	return sdkrequest.Do(ctx, c.service, http.MethodDelete, pathPolicy, map[string]string{"policy_id": *o.ID}, nil, nil, nil)
+}
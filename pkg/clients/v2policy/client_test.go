@@ -0,0 +1,160 @@
+package v2policy
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/google/go-cmp/cmp"
+
+	iampmv1 "github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+)
+
+type request struct {
+	method  string
+	path    string
+	ifMatch string
+	body    map[string]interface{}
+}
+
+func setupClient(t *testing.T, status int, response interface{}, got *request) (*Client, *httptest.Server) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got.method = r.Method
+		got.path = r.URL.Path
+		got.ifMatch = r.Header.Get("If-Match")
+		b, _ := io.ReadAll(r.Body)
+		_ = r.Body.Close()
+		if len(b) > 0 {
+			if err := json.Unmarshal(b, &got.body); err != nil {
+				t.Errorf("r: cannot unmarshal request body: %s", err)
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		if response != nil {
+			_ = json.NewEncoder(w).Encode(response)
+		}
+	}))
+
+	iamPolicyManagement, err := iampmv1.NewIamPolicyManagementV1(&iampmv1.IamPolicyManagementV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	if err != nil {
+		t.Fatalf("cannot create IAM Policy Management client: %s", err)
+	}
+	return NewClient(iamPolicyManagement), server
+}
+
+func TestClientRequests(t *testing.T) {
+	id := "12345678-abcd-1a2b-a1b2-1234567890ab"
+	eTag := "1-eb832c7ff8c8016a542974b9f880b55e"
+	policyType := "access"
+	roleID := "crn:v1:bluemix:public:iam::::role:Viewer"
+	key := "{{environment.attributes.day_of_week}}"
+	operator := "dayOfWeekAnyOf"
+
+	cases := map[string]struct {
+		call func(c *Client) error
+		want request
+	}{
+		"CreateV2Policy": {
+			call: func(c *Client) error {
+				_, _, err := c.CreateV2Policy(context.Background(), &CreateV2PolicyOptions{Type: &policyType,
+					Control: &Control{Grant: &Grant{Roles: []Roles{{RoleID: &roleID}}}},
+					Rule:    &V2PolicyRule{Key: &key, Operator: &operator, Value: []string{"1+00:00", "2+00:00"}}})
+				return err
+			},
+			want: request{method: http.MethodPost, path: "/v2/policies",
+				body: map[string]interface{}{"type": policyType,
+					"control": map[string]interface{}{"grant": map[string]interface{}{
+						"roles": []interface{}{map[string]interface{}{"role_id": roleID}}}},
+					"rule": map[string]interface{}{"key": key, "operator": operator,
+						"value": []interface{}{"1+00:00", "2+00:00"}}}},
+		},
+		"GetV2Policy": {
+			call: func(c *Client) error {
+				_, _, err := c.GetV2Policy(context.Background(), &GetV2PolicyOptions{ID: &id})
+				return err
+			},
+			want: request{method: http.MethodGet, path: "/v2/policies/" + id},
+		},
+		"UpdateV2Policy": {
+			call: func(c *Client) error {
+				_, _, err := c.UpdateV2Policy(context.Background(), &UpdateV2PolicyOptions{ID: &id, IfMatch: &eTag, Type: &policyType,
+					Control: &Control{Grant: &Grant{Roles: []Roles{}}}})
+				return err
+			},
+			want: request{method: http.MethodPut, path: "/v2/policies/" + id, ifMatch: eTag,
+				body: map[string]interface{}{"type": policyType,
+					"control": map[string]interface{}{"grant": map[string]interface{}{"roles": []interface{}{}}}}},
+		},
+		"DeleteV2Policy": {
+			call: func(c *Client) error {
+				_, err := c.DeleteV2Policy(context.Background(), &DeleteV2PolicyOptions{ID: &id})
+				return err
+			},
+			want: request{method: http.MethodDelete, path: "/v2/policies/" + id},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := request{}
+			c, server := setupClient(t, http.StatusOK, map[string]string{"id": "someID"}, &got)
+			defer server.Close()
+
+			if err := tc.call(c); err != nil {
+				t.Errorf("%s(...): unexpected error: %s", name, err)
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(request{})); diff != "" {
+				t.Errorf("%s(...): -want request, +got request:\n%s", name, diff)
+			}
+		})
+	}
+}
+
+func TestClientResponses(t *testing.T) {
+	id := "12345678-abcd-1a2b-a1b2-1234567890ab"
+	state := "active"
+
+	t.Run("Successful", func(t *testing.T) {
+		c, server := setupClient(t, http.StatusOK, &V2Policy{ID: &id, State: &state}, &request{})
+		defer server.Close()
+
+		got, _, err := c.GetV2Policy(context.Background(), &GetV2PolicyOptions{ID: &id})
+		if err != nil {
+			t.Errorf("GetV2Policy(...): unexpected error: %s", err)
+		}
+		if diff := cmp.Diff(&V2Policy{ID: &id, State: &state}, got); diff != "" {
+			t.Errorf("GetV2Policy(...): -want, +got:\n%s", diff)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		c, server := setupClient(t, http.StatusNotFound, nil, &request{})
+		defer server.Close()
+
+		got, _, err := c.GetV2Policy(context.Background(), &GetV2PolicyOptions{ID: &id})
+		if err == nil || !ibmc.IsResourceNotFound(err) {
+			t.Errorf("GetV2Policy(...): want not found error, got: %v", err)
+		}
+		if got != nil {
+			t.Errorf("GetV2Policy(...): want no result, got: %v", got)
+		}
+	})
+
+	t.Run("MissingOptions", func(t *testing.T) {
+		c, server := setupClient(t, http.StatusOK, nil, &request{})
+		defer server.Close()
+
+		if _, _, err := c.UpdateV2Policy(context.Background(), &UpdateV2PolicyOptions{ID: &id}); err == nil {
+			t.Error("UpdateV2Policy(...): want error for missing If-Match, type and control")
+		}
+	})
+}
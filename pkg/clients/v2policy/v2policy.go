@@ -0,0 +1,318 @@
+package v2policy
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/iampolicymanagementv1/v1alpha1"
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+)
+
+const (
+	// StateActive represents a policy in a running, available, and ready state
+	StateActive = "active"
+
+	operatorStringEquals = "stringEquals"
+	operatorStringExists = "stringExists"
+)
+
+// LateInitializeSpec fills optional and unassigned fields with the values in *V2Policy object.
+func LateInitializeSpec(spec *v1alpha1.V2PolicyParameters, in *V2Policy) error { // nolint:gocyclo
+	if spec.Description == nil {
+		spec.Description = in.Description
+	}
+	if spec.Pattern == nil {
+		spec.Pattern = in.Pattern
+	}
+	if in.Subject != nil {
+		for i, attr := range spec.Subject.Attributes {
+			if attr.Operator == nil && i < len(in.Subject.Attributes) {
+				spec.Subject.Attributes[i].Operator = in.Subject.Attributes[i].Operator
+			}
+		}
+	}
+	if in.Resource != nil {
+		for i, attr := range spec.Resource.Attributes {
+			if attr.Operator == nil && i < len(in.Resource.Attributes) {
+				spec.Resource.Attributes[i].Operator = in.Resource.Attributes[i].Operator
+			}
+		}
+		for i, tag := range spec.Resource.Tags {
+			if tag.Operator == nil && i < len(in.Resource.Tags) {
+				spec.Resource.Tags[i].Operator = in.Resource.Tags[i].Operator
+			}
+		}
+	}
+	return nil
+}
+
+// GenerateCreateV2PolicyOptions produces CreateV2PolicyOptions object from V2PolicyParameters object.
+func GenerateCreateV2PolicyOptions(in v1alpha1.V2PolicyParameters, o *CreateV2PolicyOptions) error {
+	o.Type = reference.ToPtrValue(in.Type)
+	o.Description = in.Description
+	o.Subject = GenerateSDKSubject(in.Subject)
+	o.Control = GenerateSDKControl(in.Roles)
+	o.Resource = GenerateSDKResource(in.Resource)
+	o.Pattern = in.Pattern
+	o.Rule = GenerateSDKRule(in.Rule)
+	return nil
+}
+
+// GenerateUpdateV2PolicyOptions produces UpdateV2PolicyOptions object from V2PolicyParameters object.
+func GenerateUpdateV2PolicyOptions(id, eTag string, in v1alpha1.V2PolicyParameters, o *UpdateV2PolicyOptions) error {
+	o.ID = reference.ToPtrValue(id)
+	o.IfMatch = reference.ToPtrValue(eTag)
+	o.Type = reference.ToPtrValue(in.Type)
+	o.Description = in.Description
+	o.Subject = GenerateSDKSubject(in.Subject)
+	o.Control = GenerateSDKControl(in.Roles)
+	o.Resource = GenerateSDKResource(in.Resource)
+	o.Pattern = in.Pattern
+	o.Rule = GenerateSDKRule(in.Rule)
+	return nil
+}
+
+// GenerateObservation produces V2PolicyObservation object from *V2Policy object.
+func GenerateObservation(in *V2Policy) (v1alpha1.V2PolicyObservation, error) {
+	o := v1alpha1.V2PolicyObservation{
+		ID:               reference.FromPtrValue(in.ID),
+		Href:             reference.FromPtrValue(in.Href),
+		CreatedAt:        ibmc.DateTimeToMetaV1Time(in.CreatedAt),
+		CreatedByID:      reference.FromPtrValue(in.CreatedByID),
+		LastModifiedAt:   ibmc.DateTimeToMetaV1Time(in.LastModifiedAt),
+		LastModifiedByID: reference.FromPtrValue(in.LastModifiedByID),
+		State:            reference.FromPtrValue(in.State),
+	}
+	return o, nil
+}
+
+// IsUpToDate checks whether current state is up-to-date compared to the given
+// set of parameters.
+func IsUpToDate(in *v1alpha1.V2PolicyParameters, observed *V2Policy, l logging.Logger) (bool, error) {
+	desired := in.DeepCopy()
+	actual, err := GenerateV2PolicyParameters(observed)
+	if err != nil {
+		return false, err
+	}
+
+	l.Info(cmp.Diff(desired, actual, cmpopts.IgnoreTypes(&runtimev1alpha1.Reference{}, &runtimev1alpha1.Selector{}, &v1beta1.GenericReference{})))
+
+	return cmp.Equal(desired, actual, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreTypes(&runtimev1alpha1.Reference{}, &runtimev1alpha1.Selector{}, &v1beta1.GenericReference{})), nil
+}
+
+// GenerateV2PolicyParameters generates v2 policy parameters from a v2 policy
+func GenerateV2PolicyParameters(in *V2Policy) (*v1alpha1.V2PolicyParameters, error) {
+	o := &v1alpha1.V2PolicyParameters{
+		Type:        reference.FromPtrValue(in.Type),
+		Description: in.Description,
+		Subject:     GenerateCRSubject(in.Subject),
+		Roles:       GenerateCRRoles(in.Control),
+		Resource:    GenerateCRResource(in.Resource),
+		Pattern:     in.Pattern,
+		Rule:        GenerateCRRule(in.Rule),
+	}
+	return o, nil
+}
+
+// GenerateCRSubject -
+func GenerateCRSubject(in *V2PolicySubject) v1alpha1.V2PolicySubject {
+	o := v1alpha1.V2PolicySubject{}
+	if in == nil {
+		return o
+	}
+	for _, attr := range in.Attributes {
+		o.Attributes = append(o.Attributes, v1alpha1.V2PolicySubjectAttribute{
+			Key:      reference.FromPtrValue(attr.Key),
+			Operator: attr.Operator,
+			Value:    attr.Value,
+		})
+	}
+	return o
+}
+
+// GenerateCRRoles -
+func GenerateCRRoles(in *Control) []v1alpha1.PolicyRole {
+	o := []v1alpha1.PolicyRole{}
+	if in == nil || in.Grant == nil {
+		return o
+	}
+	for _, r := range in.Grant.Roles {
+		o = append(o, v1alpha1.PolicyRole{RoleID: reference.FromPtrValue(r.RoleID)})
+	}
+	return o
+}
+
+// GenerateCRResource -
+func GenerateCRResource(in *V2PolicyResource) v1alpha1.V2PolicyResource {
+	o := v1alpha1.V2PolicyResource{}
+	if in == nil {
+		return o
+	}
+	for _, attr := range in.Attributes {
+		o.Attributes = append(o.Attributes, v1alpha1.V2PolicyResourceAttribute{
+			Key:      reference.FromPtrValue(attr.Key),
+			Operator: attr.Operator,
+			Value:    stringValue(attr.Value),
+		})
+	}
+	for _, tag := range in.Tags {
+		o.Tags = append(o.Tags, v1alpha1.V2PolicyResourceTag{
+			Key:      reference.FromPtrValue(tag.Key),
+			Value:    reference.FromPtrValue(tag.Value),
+			Operator: tag.Operator,
+		})
+	}
+	return o
+}
+
+// GenerateCRRule -
+func GenerateCRRule(in *V2PolicyRule) *v1alpha1.V2PolicyRule {
+	if in == nil {
+		return nil
+	}
+	o := &v1alpha1.V2PolicyRule{
+		Key:      in.Key,
+		Operator: reference.FromPtrValue(in.Operator),
+	}
+	o.Value, o.Values = ruleValue(in.Value)
+	for _, c := range in.Conditions {
+		item := v1alpha1.V2PolicyRuleCondition{
+			Key:      reference.FromPtrValue(c.Key),
+			Operator: reference.FromPtrValue(c.Operator),
+		}
+		item.Value, item.Values = ruleValue(c.Value)
+		o.Conditions = append(o.Conditions, item)
+	}
+	return o
+}
+
+// GenerateSDKSubject -
+func GenerateSDKSubject(in v1alpha1.V2PolicySubject) *V2PolicySubject {
+	o := &V2PolicySubject{Attributes: []V2PolicySubjectAttribute{}}
+	for _, attr := range in.Attributes {
+		o.Attributes = append(o.Attributes, V2PolicySubjectAttribute{
+			Key:      reference.ToPtrValue(attr.Key),
+			Operator: operatorOrDefault(attr.Operator),
+			Value:    attr.Value,
+		})
+	}
+	return o
+}
+
+// GenerateSDKControl -
+func GenerateSDKControl(in []v1alpha1.PolicyRole) *Control {
+	o := &Control{Grant: &Grant{Roles: []Roles{}}}
+	for _, r := range in {
+		o.Grant.Roles = append(o.Grant.Roles, Roles{RoleID: reference.ToPtrValue(r.RoleID)})
+	}
+	return o
+}
+
+// GenerateSDKResource -
+func GenerateSDKResource(in v1alpha1.V2PolicyResource) *V2PolicyResource {
+	o := &V2PolicyResource{Attributes: []V2PolicyResourceAttribute{}}
+	for _, attr := range in.Attributes {
+		item := V2PolicyResourceAttribute{
+			Key:      reference.ToPtrValue(attr.Key),
+			Operator: operatorOrDefault(attr.Operator),
+		}
+		if attr.Value != nil {
+			item.Value = *attr.Value
+			if *item.Operator == operatorStringExists {
+				if b, err := strconv.ParseBool(*attr.Value); err == nil {
+					item.Value = b
+				}
+			}
+		}
+		o.Attributes = append(o.Attributes, item)
+	}
+	for _, tag := range in.Tags {
+		o.Tags = append(o.Tags, V2PolicyResourceTag{
+			Key:      reference.ToPtrValue(tag.Key),
+			Value:    reference.ToPtrValue(tag.Value),
+			Operator: operatorOrDefault(tag.Operator),
+		})
+	}
+	return o
+}
+
+// GenerateSDKRule -
+func GenerateSDKRule(in *v1alpha1.V2PolicyRule) *V2PolicyRule {
+	if in == nil {
+		return nil
+	}
+	o := &V2PolicyRule{
+		Key:      in.Key,
+		Operator: reference.ToPtrValue(in.Operator),
+		Value:    sdkRuleValue(in.Value, in.Values),
+	}
+	for _, c := range in.Conditions {
+		o.Conditions = append(o.Conditions, RuleAttribute{
+			Key:      reference.ToPtrValue(c.Key),
+			Operator: reference.ToPtrValue(c.Operator),
+			Value:    sdkRuleValue(c.Value, c.Values),
+		})
+	}
+	return o
+}
+
+// operatorOrDefault returns the given operator, or 'stringEquals' when it is not set
+func operatorOrDefault(in *string) *string {
+	if in == nil {
+		return reference.ToPtrValue(operatorStringEquals)
+	}
+	return in
+}
+
+// stringValue converts the value of a resource attribute, which may be a boolean, to a string
+func stringValue(in interface{}) *string {
+	switch v := in.(type) {
+	case nil:
+		return nil
+	case string:
+		return &v
+	case bool:
+		return reference.ToPtrValue(strconv.FormatBool(v))
+	default:
+		return reference.ToPtrValue(fmt.Sprint(v))
+	}
+}
+
+// ruleValue splits the value of a rule or rule condition, which may be a list of strings, into a single value and
+// a list of values
+func ruleValue(in interface{}) (*string, []string) {
+	switch v := in.(type) {
+	case nil:
+		return nil, nil
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, i := range v {
+			values = append(values, fmt.Sprint(i))
+		}
+		return nil, values
+	case []string:
+		return nil, v
+	default:
+		return stringValue(v), nil
+	}
+}
+
+// sdkRuleValue returns the list of values of a rule or rule condition when set, or its single value
+func sdkRuleValue(value *string, values []string) interface{} {
+	if values != nil {
+		return values
+	}
+	if value != nil {
+		return *value
+	}
+	return nil
+}
@@ -0,0 +1,306 @@
+package v2policy
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/iampolicymanagementv1/v1alpha1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+)
+
+var (
+	v2pID             = "12345678-abcd-1a2b-a1b2-1234567890ab"
+	v2pETag           = "1-eb832c7ff8c8016a542974b9f880b55e"
+	v2pType           = "access"
+	v2pDescription    = "weekday access to my postgres"
+	v2pDescription2   = "another description"
+	v2pIamIDKey       = "iam_id"
+	v2pIamID          = "IBMid-123453user"
+	v2pServiceKey     = "serviceName"
+	v2pService        = "databases-for-postgresql"
+	v2pInstanceKey    = "serviceInstance"
+	v2pTagKey         = "env"
+	v2pTagValue       = "prod"
+	v2pStringEquals   = "stringEquals"
+	v2pStringExists   = "stringExists"
+	v2pTrue           = "true"
+	v2pRoleID         = "crn:v1:bluemix:public:iam::::role:Viewer"
+	v2pRoleID2        = "crn:v1:bluemix:public:iam::::role:Editor"
+	v2pPattern        = "time-based-conditions:weekly:custom-hours"
+	v2pRuleAnd        = "and"
+	v2pDayKey         = "{{environment.attributes.day_of_week}}"
+	v2pDayOp          = "dayOfWeekAnyOf"
+	v2pTimeKey        = "{{environment.attributes.current_time}}"
+	v2pTimeOp         = "timeGreaterThanOrEquals"
+	v2pTime           = "09:00:00+00:00"
+	v2pState          = "active"
+	v2pHref           = "https://iam.cloud.ibm.com/v2/policies/" + v2pID
+	v2pCreatedAt, _   = strfmt.ParseDateTime("2020-10-31T02:33:06Z")
+	v2pModifiedAt, _  = strfmt.ParseDateTime("2020-10-31T03:33:06Z")
+	v2pWeekdays       = []string{"1+00:00", "2+00:00", "3+00:00", "4+00:00", "5+00:00"}
+	v2pWeekdaysValues = []interface{}{"1+00:00", "2+00:00", "3+00:00", "4+00:00", "5+00:00"}
+)
+
+func params(m ...func(*v1alpha1.V2PolicyParameters)) *v1alpha1.V2PolicyParameters {
+	p := &v1alpha1.V2PolicyParameters{
+		Type:        v2pType,
+		Description: &v2pDescription,
+		Subject: v1alpha1.V2PolicySubject{
+			Attributes: []v1alpha1.V2PolicySubjectAttribute{
+				{Key: v2pIamIDKey, Operator: &v2pStringEquals, Value: &v2pIamID},
+			},
+		},
+		Roles: []v1alpha1.PolicyRole{{RoleID: v2pRoleID}},
+		Resource: v1alpha1.V2PolicyResource{
+			Attributes: []v1alpha1.V2PolicyResourceAttribute{
+				{Key: v2pServiceKey, Operator: &v2pStringEquals, Value: &v2pService},
+				{Key: v2pInstanceKey, Operator: &v2pStringExists, Value: &v2pTrue},
+			},
+			Tags: []v1alpha1.V2PolicyResourceTag{
+				{Key: v2pTagKey, Value: v2pTagValue, Operator: &v2pStringEquals},
+			},
+		},
+		Pattern: &v2pPattern,
+		Rule: &v1alpha1.V2PolicyRule{
+			Operator: v2pRuleAnd,
+			Conditions: []v1alpha1.V2PolicyRuleCondition{
+				{Key: v2pDayKey, Operator: v2pDayOp, Values: v2pWeekdays},
+				{Key: v2pTimeKey, Operator: v2pTimeOp, Value: &v2pTime},
+			},
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func sdkSubject() *V2PolicySubject {
+	return &V2PolicySubject{Attributes: []V2PolicySubjectAttribute{
+		{Key: &v2pIamIDKey, Operator: &v2pStringEquals, Value: &v2pIamID},
+	}}
+}
+
+func sdkControl(roleIDs ...*string) *Control {
+	c := &Control{Grant: &Grant{Roles: []Roles{}}}
+	for _, r := range roleIDs {
+		c.Grant.Roles = append(c.Grant.Roles, Roles{RoleID: r})
+	}
+	return c
+}
+
+func sdkResource() *V2PolicyResource {
+	return &V2PolicyResource{
+		Attributes: []V2PolicyResourceAttribute{
+			{Key: &v2pServiceKey, Operator: &v2pStringEquals, Value: v2pService},
+			{Key: &v2pInstanceKey, Operator: &v2pStringExists, Value: true},
+		},
+		Tags: []V2PolicyResourceTag{
+			{Key: &v2pTagKey, Value: &v2pTagValue, Operator: &v2pStringEquals},
+		},
+	}
+}
+
+func sdkRule(days interface{}) *V2PolicyRule {
+	return &V2PolicyRule{
+		Operator: &v2pRuleAnd,
+		Conditions: []RuleAttribute{
+			{Key: &v2pDayKey, Operator: &v2pDayOp, Value: days},
+			{Key: &v2pTimeKey, Operator: &v2pTimeOp, Value: v2pTime},
+		},
+	}
+}
+
+// instance returns a v2 policy as decoded from an API response, where list values are []interface{}
+func instance(m ...func(*V2Policy)) *V2Policy {
+	i := &V2Policy{
+		ID:               &v2pID,
+		Type:             &v2pType,
+		Description:      &v2pDescription,
+		Subject:          sdkSubject(),
+		Control:          sdkControl(&v2pRoleID),
+		Resource:         sdkResource(),
+		Pattern:          &v2pPattern,
+		Rule:             sdkRule(v2pWeekdaysValues),
+		Href:             &v2pHref,
+		CreatedAt:        &v2pCreatedAt,
+		CreatedByID:      &v2pIamID,
+		LastModifiedAt:   &v2pModifiedAt,
+		LastModifiedByID: &v2pIamID,
+		State:            &v2pState,
+	}
+	for _, f := range m {
+		f(i)
+	}
+	return i
+}
+
+func TestGenerateCreateV2PolicyOptions(t *testing.T) {
+	cases := map[string]struct {
+		params v1alpha1.V2PolicyParameters
+		want   *CreateV2PolicyOptions
+	}{
+		"FullConversion": {
+			params: *params(),
+			want: &CreateV2PolicyOptions{Type: &v2pType, Description: &v2pDescription, Subject: sdkSubject(),
+				Control: sdkControl(&v2pRoleID), Resource: sdkResource(), Pattern: &v2pPattern, Rule: sdkRule(v2pWeekdays)},
+		},
+		"DefaultOperators": {
+			params: *params(func(p *v1alpha1.V2PolicyParameters) {
+				p.Subject.Attributes[0].Operator = nil
+				p.Resource.Attributes[0].Operator = nil
+				p.Resource.Tags[0].Operator = nil
+			}),
+			want: &CreateV2PolicyOptions{Type: &v2pType, Description: &v2pDescription, Subject: sdkSubject(),
+				Control: sdkControl(&v2pRoleID), Resource: sdkResource(), Pattern: &v2pPattern, Rule: sdkRule(v2pWeekdays)},
+		},
+		"NoRule": {
+			params: *params(func(p *v1alpha1.V2PolicyParameters) {
+				p.Description = nil
+				p.Pattern = nil
+				p.Rule = nil
+			}),
+			want: &CreateV2PolicyOptions{Type: &v2pType, Subject: sdkSubject(), Control: sdkControl(&v2pRoleID),
+				Resource: sdkResource()},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &CreateV2PolicyOptions{}
+			_ = GenerateCreateV2PolicyOptions(tc.params, r)
+			if diff := cmp.Diff(tc.want, r); diff != "" {
+				t.Errorf("GenerateCreateV2PolicyOptions(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateUpdateV2PolicyOptions(t *testing.T) {
+	r := &UpdateV2PolicyOptions{}
+	_ = GenerateUpdateV2PolicyOptions(v2pID, v2pETag, *params(func(p *v1alpha1.V2PolicyParameters) {
+		p.Roles = append(p.Roles, v1alpha1.PolicyRole{RoleID: v2pRoleID2})
+	}), r)
+	want := &UpdateV2PolicyOptions{ID: &v2pID, IfMatch: &v2pETag, Type: &v2pType, Description: &v2pDescription,
+		Subject: sdkSubject(), Control: sdkControl(&v2pRoleID, &v2pRoleID2), Resource: sdkResource(), Pattern: &v2pPattern,
+		Rule: sdkRule(v2pWeekdays)}
+	if diff := cmp.Diff(want, r); diff != "" {
+		t.Errorf("GenerateUpdateV2PolicyOptions(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeSpecs(t *testing.T) {
+	cases := map[string]struct {
+		params   *v1alpha1.V2PolicyParameters
+		instance *V2Policy
+		want     *v1alpha1.V2PolicyParameters
+	}{
+		"SomeFields": {
+			params: params(func(p *v1alpha1.V2PolicyParameters) {
+				p.Description = nil
+				p.Pattern = nil
+				p.Subject.Attributes[0].Operator = nil
+				p.Resource.Attributes[0].Operator = nil
+				p.Resource.Tags[0].Operator = nil
+			}),
+			instance: instance(),
+			want:     params(),
+		},
+		"AllFilledAlready": {
+			params: params(),
+			instance: instance(func(i *V2Policy) {
+				i.Description = &v2pDescription2
+			}),
+			want: params(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_ = LateInitializeSpec(tc.params, tc.instance)
+			if diff := cmp.Diff(tc.want, tc.params); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	o, err := GenerateObservation(instance())
+	if err != nil {
+		t.Errorf("GenerateObservation() unexpected error: %v", err)
+	}
+	want := v1alpha1.V2PolicyObservation{
+		ID:               v2pID,
+		Href:             v2pHref,
+		CreatedAt:        ibmc.DateTimeToMetaV1Time(&v2pCreatedAt),
+		CreatedByID:      v2pIamID,
+		LastModifiedAt:   ibmc.DateTimeToMetaV1Time(&v2pModifiedAt),
+		LastModifiedByID: v2pIamID,
+		State:            v2pState,
+	}
+	if diff := cmp.Diff(want, o); diff != "" {
+		t.Errorf("GenerateObservation() -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	type args struct {
+		params   *v1alpha1.V2PolicyParameters
+		instance *V2Policy
+	}
+	type want struct {
+		upToDate bool
+		isErr    bool
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"IsUpToDate": {
+			args: args{params: params(), instance: instance()},
+			want: want{upToDate: true},
+		},
+		"NeedsUpdate": {
+			args: args{
+				params: params(),
+				instance: instance(func(i *V2Policy) {
+					i.Control = sdkControl(&v2pRoleID2)
+				}),
+			},
+			want: want{upToDate: false},
+		},
+		"RuleNeedsUpdate": {
+			args: args{
+				params: params(),
+				instance: instance(func(i *V2Policy) {
+					i.Rule.Conditions[0].Value = []interface{}{"1+00:00"}
+				}),
+			},
+			want: want{upToDate: false},
+		},
+		"RuleRemoved": {
+			args: args{
+				params: params(func(p *v1alpha1.V2PolicyParameters) {
+					p.Pattern = nil
+					p.Rule = nil
+				}),
+				instance: instance(),
+			},
+			want: want{upToDate: false},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r, err := IsUpToDate(tc.args.params, tc.args.instance, logging.NewNopLogger())
+			if err != nil && !tc.want.isErr {
+				t.Error("IsUpToDate(...) unexpected error")
+			}
+			if diff := cmp.Diff(tc.want.upToDate, r); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
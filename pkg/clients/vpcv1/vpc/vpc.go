@@ -22,11 +22,13 @@ import (
 	ibmVPC "github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/google/go-cmp/cmp"
 
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
 	"github.com/crossplane-contrib/provider-ibm-cloud/apis/vpcv1/v1alpha1"
 	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
 
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 )
 
 // LateInitializeSpec fills optional and unassigned fields with the values in the spec, from the info that comes from the cloud
@@ -233,6 +235,20 @@ func GenerateCreateOptions(in *v1alpha1.VPCParameters) (ibmVPC.CreateVPCOptions,
 	return result, nil
 }
 
+// ApplyProviderConfigDefaults sets the tags of a VPC to the default tags of its ProviderConfig, when not specified.
+// The default resource group is applied upon creation, as VPCs refer to resource groups by ID.
+func ApplyProviderConfigDefaults(pc *v1beta1.ProviderConfigSpec, mg resource.Managed) bool {
+	cr, ok := mg.(*v1alpha1.VPC)
+	if !ok {
+		return false
+	}
+	if tags := ibmc.DefaultTags(pc, cr.Spec.ForProvider.Tags); tags != nil {
+		cr.Spec.ForProvider.Tags = tags
+		return true
+	}
+	return false
+}
+
 // IsUpToDate checks whether the current VPC config (in the cloud) is up-to-date compared to the crossplane one (only
 // the name is checked, as this is the only one that can be updated).
 //
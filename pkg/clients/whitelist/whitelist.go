@@ -11,6 +11,7 @@ import (
 	icdv5 "github.com/IBM/experimental-go-sdk/ibmclouddatabasesv5"
 
 	"github.com/crossplane-contrib/provider-ibm-cloud/apis/ibmclouddatabasesv5/v1alpha1"
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
 )
 
 // MemberGroupID is the default ID for members group
@@ -69,11 +70,11 @@ func IsUpToDate(id string, in *v1alpha1.WhitelistParameters, observed *icdv5.Whi
 		return false, err
 	}
 
-	l.Info(cmp.Diff(desired, actual, cmpopts.IgnoreTypes(&runtimev1alpha1.Reference{}, &runtimev1alpha1.Selector{}, []runtimev1alpha1.Reference{})))
+	l.Info(cmp.Diff(desired, actual, cmpopts.IgnoreTypes(&runtimev1alpha1.Reference{}, &runtimev1alpha1.Selector{}, &v1beta1.GenericReference{}, []runtimev1alpha1.Reference{})))
 
 	return cmp.Equal(desired, actual, cmpopts.EquateEmpty(),
 		cmpopts.IgnoreFields(v1alpha1.WhitelistParameters{}, "IfMatch"),
-		cmpopts.IgnoreTypes(&runtimev1alpha1.Reference{}, &runtimev1alpha1.Selector{}, []runtimev1alpha1.Reference{})), nil
+		cmpopts.IgnoreTypes(&runtimev1alpha1.Reference{}, &runtimev1alpha1.Selector{}, &v1beta1.GenericReference{}, []runtimev1alpha1.Reference{})), nil
 }
 
 // GenerateWhitelistParameters generates white list parameters from whitelist
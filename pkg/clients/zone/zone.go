@@ -0,0 +1,153 @@
+package zone
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/contextbasedrestrictionsv1/v1alpha1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/cbr"
+)
+
+const (
+	// StateActive represents a zone that exists, and can be used by rules. The API does not report the state of a
+	// zone, so this is the state of any zone it returns
+	StateActive = "active"
+)
+
+// LateInitializeSpec fills optional and unassigned fields with the values in *cbr.Zone object.
+func LateInitializeSpec(spec *v1alpha1.ZoneParameters, in *cbr.Zone) error {
+	if spec.Description == nil {
+		spec.Description = in.Description
+	}
+	return nil
+}
+
+// GenerateCreateZoneOptions produces CreateZoneOptions object from ZoneParameters object.
+func GenerateCreateZoneOptions(in v1alpha1.ZoneParameters, o *cbr.CreateZoneOptions) error {
+	o.Name = reference.ToPtrValue(in.Name)
+	o.AccountID = reference.ToPtrValue(in.AccountID)
+	o.Description = in.Description
+	o.Addresses = GenerateSDKAddresses(in.Addresses)
+	if in.Excluded != nil {
+		o.Excluded = GenerateSDKAddresses(in.Excluded)
+	}
+	return nil
+}
+
+// GenerateReplaceZoneOptions produces ReplaceZoneOptions object from ZoneParameters object.
+func GenerateReplaceZoneOptions(id, eTag string, in v1alpha1.ZoneParameters, o *cbr.ReplaceZoneOptions) error {
+	o.ZoneID = reference.ToPtrValue(id)
+	o.IfMatch = reference.ToPtrValue(eTag)
+	o.Name = reference.ToPtrValue(in.Name)
+	o.AccountID = reference.ToPtrValue(in.AccountID)
+	o.Description = in.Description
+	o.Addresses = GenerateSDKAddresses(in.Addresses)
+	if in.Excluded != nil {
+		o.Excluded = GenerateSDKAddresses(in.Excluded)
+	}
+	return nil
+}
+
+// GenerateObservation produces ZoneObservation object from *cbr.Zone object.
+func GenerateObservation(in *cbr.Zone) (v1alpha1.ZoneObservation, error) {
+	o := v1alpha1.ZoneObservation{
+		ID:               reference.FromPtrValue(in.ID),
+		CRN:              reference.FromPtrValue(in.CRN),
+		AddressCount:     count(in.AddressCount),
+		ExcludedCount:    count(in.ExcludedCount),
+		Href:             reference.FromPtrValue(in.Href),
+		CreatedAt:        ibmc.DateTimeToMetaV1Time(in.CreatedAt),
+		CreatedByID:      reference.FromPtrValue(in.CreatedByID),
+		LastModifiedAt:   ibmc.DateTimeToMetaV1Time(in.LastModifiedAt),
+		LastModifiedByID: reference.FromPtrValue(in.LastModifiedByID),
+		State:            StateActive,
+	}
+	return o, nil
+}
+
+// IsUpToDate checks whether current state is up-to-date compared to the given
+// set of parameters.
+func IsUpToDate(in *v1alpha1.ZoneParameters, observed *cbr.Zone, l logging.Logger) (bool, error) {
+	desired := in.DeepCopy()
+	actual, err := GenerateZoneParameters(observed)
+	if err != nil {
+		return false, err
+	}
+
+	l.Info(cmp.Diff(desired, actual, cmpopts.IgnoreTypes(&runtimev1alpha1.Reference{}, &runtimev1alpha1.Selector{})))
+
+	return cmp.Equal(desired, actual, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreTypes(&runtimev1alpha1.Reference{}, &runtimev1alpha1.Selector{})), nil
+}
+
+// GenerateZoneParameters generates zone parameters from a zone
+func GenerateZoneParameters(in *cbr.Zone) (*v1alpha1.ZoneParameters, error) {
+	o := &v1alpha1.ZoneParameters{
+		Name:        reference.FromPtrValue(in.Name),
+		AccountID:   reference.FromPtrValue(in.AccountID),
+		Description: in.Description,
+		Addresses:   GenerateCRAddresses(in.Addresses),
+		Excluded:    GenerateCRAddresses(in.Excluded),
+	}
+	return o, nil
+}
+
+// GenerateSDKAddresses -
+func GenerateSDKAddresses(in []v1alpha1.ZoneAddress) []cbr.Address {
+	o := []cbr.Address{}
+	for _, a := range in {
+		item := cbr.Address{
+			Type:  reference.ToPtrValue(a.Type),
+			Value: a.Value,
+		}
+		if a.Ref != nil {
+			item.Ref = &cbr.ServiceRefValue{
+				AccountID:       reference.ToPtrValue(a.Ref.AccountID),
+				ServiceType:     a.Ref.ServiceType,
+				ServiceName:     a.Ref.ServiceName,
+				ServiceInstance: a.Ref.ServiceInstance,
+				Location:        a.Ref.Location,
+			}
+		}
+		o = append(o, item)
+	}
+	return o
+}
+
+// GenerateCRAddresses -
+func GenerateCRAddresses(in []cbr.Address) []v1alpha1.ZoneAddress {
+	if in == nil {
+		return nil
+	}
+	o := []v1alpha1.ZoneAddress{}
+	for _, a := range in {
+		item := v1alpha1.ZoneAddress{
+			Type:  reference.FromPtrValue(a.Type),
+			Value: a.Value,
+		}
+		if a.Ref != nil {
+			item.Ref = &v1alpha1.ServiceRefValue{
+				AccountID:       reference.FromPtrValue(a.Ref.AccountID),
+				ServiceType:     a.Ref.ServiceType,
+				ServiceName:     a.Ref.ServiceName,
+				ServiceInstance: a.Ref.ServiceInstance,
+				Location:        a.Ref.Location,
+			}
+		}
+		o = append(o, item)
+	}
+	return o
+}
+
+// count returns the value of a count of addresses, or 0 when it is not set
+func count(in *int64) int64 {
+	if in == nil {
+		return 0
+	}
+	return *in
+}
@@ -0,0 +1,247 @@
+package zone
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/go-cmp/cmp"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/contextbasedrestrictionsv1/v1alpha1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/cbr"
+)
+
+var (
+	zID              = "65810ac762004f22ac19f8f8edf70a34"
+	zETag            = "1-eb832c7ff8c8016a542974b9f880b55e"
+	zCRN             = "crn:v1:bluemix:public:context-based-restrictions:global:a/12ab34cd56ef78ab90cd12ef34ab56cd::zone:" + zID
+	zName            = "my-zone"
+	zName2           = "another-zone"
+	zAccountID       = "12ab34cd56ef78ab90cd12ef34ab56cd"
+	zDescription     = "my network zone"
+	zDescription2    = "another description"
+	zIPAddress       = "ipAddress"
+	zIP              = "169.23.56.234"
+	zIP2             = "169.23.56.235"
+	zVPC             = "vpc"
+	zVPCCRN          = "crn:v1:bluemix:public:is:us-south:a/12ab34cd56ef78ab90cd12ef34ab56cd::vpc:r006-1234"
+	zServiceRef      = "serviceRef"
+	zServiceName     = "cloud-object-storage"
+	zAddressCount    = int64(3)
+	zExcludedCount   = int64(1)
+	zHref            = "https://cbr.cloud.ibm.com/v1/zones/" + zID
+	zIamID           = "IBMid-123453user"
+	zCreatedAt, _    = strfmt.ParseDateTime("2020-10-31T02:33:06Z")
+	zModifiedAt, _   = strfmt.ParseDateTime("2020-10-31T03:33:06Z")
+	zExcludedAddress = v1alpha1.ZoneAddress{Type: zIPAddress, Value: &zIP2}
+)
+
+func params(m ...func(*v1alpha1.ZoneParameters)) *v1alpha1.ZoneParameters {
+	p := &v1alpha1.ZoneParameters{
+		Name:        zName,
+		AccountID:   zAccountID,
+		Description: &zDescription,
+		Addresses: []v1alpha1.ZoneAddress{
+			{Type: zIPAddress, Value: &zIP},
+			{Type: zVPC, Value: &zVPCCRN, VPCRef: &runtimev1alpha1.Reference{Name: "my-vpc"}},
+			{Type: zServiceRef, Ref: &v1alpha1.ServiceRefValue{AccountID: zAccountID, ServiceName: &zServiceName}},
+		},
+		Excluded: []v1alpha1.ZoneAddress{zExcludedAddress},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func sdkAddresses() []cbr.Address {
+	return []cbr.Address{
+		{Type: &zIPAddress, Value: &zIP},
+		{Type: &zVPC, Value: &zVPCCRN},
+		{Type: &zServiceRef, Ref: &cbr.ServiceRefValue{AccountID: &zAccountID, ServiceName: &zServiceName}},
+	}
+}
+
+func sdkExcluded() []cbr.Address {
+	return []cbr.Address{{Type: &zIPAddress, Value: &zIP2}}
+}
+
+func instance(m ...func(*cbr.Zone)) *cbr.Zone {
+	i := &cbr.Zone{
+		ID:               &zID,
+		CRN:              &zCRN,
+		AddressCount:     &zAddressCount,
+		ExcludedCount:    &zExcludedCount,
+		Name:             &zName,
+		AccountID:        &zAccountID,
+		Description:      &zDescription,
+		Addresses:        sdkAddresses(),
+		Excluded:         sdkExcluded(),
+		Href:             &zHref,
+		CreatedAt:        &zCreatedAt,
+		CreatedByID:      &zIamID,
+		LastModifiedAt:   &zModifiedAt,
+		LastModifiedByID: &zIamID,
+	}
+	for _, f := range m {
+		f(i)
+	}
+	return i
+}
+
+func TestGenerateCreateZoneOptions(t *testing.T) {
+	cases := map[string]struct {
+		params v1alpha1.ZoneParameters
+		want   *cbr.CreateZoneOptions
+	}{
+		"FullConversion": {
+			params: *params(),
+			want: &cbr.CreateZoneOptions{Name: &zName, AccountID: &zAccountID, Description: &zDescription,
+				Addresses: sdkAddresses(), Excluded: sdkExcluded()},
+		},
+		"NoExcludedAddresses": {
+			params: *params(func(p *v1alpha1.ZoneParameters) {
+				p.Description = nil
+				p.Excluded = nil
+			}),
+			want: &cbr.CreateZoneOptions{Name: &zName, AccountID: &zAccountID, Addresses: sdkAddresses()},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &cbr.CreateZoneOptions{}
+			_ = GenerateCreateZoneOptions(tc.params, r)
+			if diff := cmp.Diff(tc.want, r); diff != "" {
+				t.Errorf("GenerateCreateZoneOptions(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateReplaceZoneOptions(t *testing.T) {
+	r := &cbr.ReplaceZoneOptions{}
+	_ = GenerateReplaceZoneOptions(zID, zETag, *params(func(p *v1alpha1.ZoneParameters) {
+		p.Name = zName2
+	}), r)
+	want := &cbr.ReplaceZoneOptions{ZoneID: &zID, IfMatch: &zETag, Name: &zName2, AccountID: &zAccountID,
+		Description: &zDescription, Addresses: sdkAddresses(), Excluded: sdkExcluded()}
+	if diff := cmp.Diff(want, r); diff != "" {
+		t.Errorf("GenerateReplaceZoneOptions(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeSpecs(t *testing.T) {
+	cases := map[string]struct {
+		params   *v1alpha1.ZoneParameters
+		instance *cbr.Zone
+		want     *v1alpha1.ZoneParameters
+	}{
+		"SomeFields": {
+			params: params(func(p *v1alpha1.ZoneParameters) {
+				p.Description = nil
+			}),
+			instance: instance(),
+			want:     params(),
+		},
+		"AllFilledAlready": {
+			params: params(),
+			instance: instance(func(i *cbr.Zone) {
+				i.Description = &zDescription2
+			}),
+			want: params(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_ = LateInitializeSpec(tc.params, tc.instance)
+			if diff := cmp.Diff(tc.want, tc.params); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	o, err := GenerateObservation(instance())
+	if err != nil {
+		t.Errorf("GenerateObservation() unexpected error: %v", err)
+	}
+	want := v1alpha1.ZoneObservation{
+		ID:               zID,
+		CRN:              zCRN,
+		AddressCount:     zAddressCount,
+		ExcludedCount:    zExcludedCount,
+		Href:             zHref,
+		CreatedAt:        ibmc.DateTimeToMetaV1Time(&zCreatedAt),
+		CreatedByID:      zIamID,
+		LastModifiedAt:   ibmc.DateTimeToMetaV1Time(&zModifiedAt),
+		LastModifiedByID: zIamID,
+		State:            StateActive,
+	}
+	if diff := cmp.Diff(want, o); diff != "" {
+		t.Errorf("GenerateObservation() -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	type args struct {
+		params   *v1alpha1.ZoneParameters
+		instance *cbr.Zone
+	}
+	type want struct {
+		upToDate bool
+		isErr    bool
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"IsUpToDate": {
+			args: args{params: params(), instance: instance()},
+			want: want{upToDate: true},
+		},
+		"NoExcludedAddresses": {
+			args: args{
+				params: params(func(p *v1alpha1.ZoneParameters) {
+					p.Excluded = nil
+				}),
+				instance: instance(func(i *cbr.Zone) {
+					i.Excluded = []cbr.Address{}
+				}),
+			},
+			want: want{upToDate: true},
+		},
+		"NeedsUpdate": {
+			args: args{
+				params: params(),
+				instance: instance(func(i *cbr.Zone) {
+					i.Name = &zName2
+				}),
+			},
+			want: want{upToDate: false},
+		},
+		"AddressesNeedUpdate": {
+			args: args{
+				params: params(),
+				instance: instance(func(i *cbr.Zone) {
+					i.Addresses = i.Addresses[:1]
+				}),
+			},
+			want: want{upToDate: false},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r, err := IsUpToDate(tc.args.params, tc.args.instance, logging.NewNopLogger())
+			if err != nil && !tc.want.isErr {
+				t.Error("IsUpToDate(...) unexpected error")
+			}
+			if diff := cmp.Diff(tc.want.upToDate, r); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane-contrib/provider-ibm-cloud/apis/container/containerv2/v1alpha1"
@@ -44,6 +45,9 @@ const (
 	errCreateClusterReq  = "could not generate the input params for a cluster"
 	errDeleteCluster     = "could not delete the cluster"
 	errGetClusterFailed  = "error getting the cluster"
+	errUpdateCluster     = "could not update the cluster"
+	errEstimateCluster   = "could not estimate the monthly cost of the cluster"
+	errUpdateTagCluster  = "error updating the tags of the cluster"
 )
 
 // SetupCluster adds a controller that reconciles Cluster objects
@@ -53,12 +57,14 @@ func SetupCluster(mgr ctrl.Manager, l logging.Logger) error {
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.ClusterGroupVersionKind),
-		managed.WithExternalConnecter(&clusterConnector{
+		managed.WithExternalConnecter(ibmc.NewAuditConnecter(&clusterConnector{
 			kube:     mgr.GetClient(),
 			usage:    resource.NewProviderConfigUsageTracker(mgr.GetClient(), &v1beta1.ProviderConfigUsage{}),
 			clientFn: ibmc.NewClient,
-			logger:   log}),
-		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			logger:   log}, event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()),
+			ibmc.NewExpiration(mgr.GetClient(), event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+			ibmc.NewProviderConfigDefaults(mgr.GetClient(), crossplaneClient.ApplyProviderConfigDefaults)),
 		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 		managed.WithLogger(log),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
@@ -66,7 +72,7 @@ func SetupCluster(mgr ctrl.Manager, l logging.Logger) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		For(&v1alpha1.Cluster{}).
-		Complete(r)
+		Complete(ibmc.NewExpirationReconciler(mgr, resource.ManagedKind(v1alpha1.ClusterGroupVersionKind), r))
 }
 
 // Expected to produce an object of type managed.ExternalClient when its Connect method
@@ -122,16 +128,36 @@ func (c *clusterExternal) Observe(ctx context.Context, mg resource.Managed) (man
 		}
 
 		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ibmc.IsResourceNotFound, err), errGetClusterFailed)
-	} else if ibmClusterInfo != nil {
+	}
+
+	upToDate := true
+	if ibmClusterInfo != nil {
 		crossplaneCluster.Status.AtProvider, err = crossplaneClient.GenerateCrossplaneClusterInfo(ibmClusterInfo)
 		if err != nil {
 			return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrGenObservation)
 		}
+
+		if pc := c.client.ProviderConfig(); ibmc.CostEstimationEnabled(pc) {
+			// an estimate is informational only, so failing to compute one does not fail the observation
+			cost, err := crossplaneClient.EstimateMonthlyCost(c.client, &crossplaneCluster.Spec.ForProvider)
+			if err != nil {
+				c.logger.Info(errEstimateCluster, "error", err)
+			} else {
+				crossplaneCluster.Status.AtProvider.EstimatedMonthlyCost = ibmc.FormatCost(pc, cost)
+			}
+		}
+
+		if crossplaneCluster.Status.AtProvider.CRN != "" {
+			upToDate, err = ibmc.TagsUpToDate(c.client, crossplaneCluster.Status.AtProvider.CRN, crossplaneCluster.Spec.ForProvider.Tags, crossplaneCluster.Spec.ForProvider.AccessTags)
+			if err != nil {
+				return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrCheckUpToDate)
+			}
+		}
 	}
 
 	return managed.ExternalObservation{
 		ResourceExists:    ibmClusterInfo != nil,
-		ResourceUpToDate:  true,
+		ResourceUpToDate:  upToDate,
 		ConnectionDetails: nil,
 	}, nil
 }
@@ -143,6 +169,10 @@ func (c *clusterExternal) Create(ctx context.Context, mg resource.Managed) (mana
 		return managed.ExternalCreation{}, errors.New(errThisIsNotACluster)
 	}
 
+	if err := c.enforcePolicies(crossplaneCluster, c.checkMonthlyBudget(crossplaneCluster)); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateCluster)
+	}
+
 	crossplaneCluster.SetConditions(runtimev1alpha1.Creating())
 
 	createRequest := ibmContainerV2.ClusterCreateRequest{}
@@ -150,7 +180,14 @@ func (c *clusterExternal) Create(ctx context.Context, mg resource.Managed) (mana
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreateClusterReq)
 	}
 
-	_, err := c.client.ClusterClientV2().Create(createRequest, ibmContainerV2.ClusterTargetHeader{})
+	target := ibmContainerV2.ClusterTargetHeader{}
+	rgID, err := ibmc.DefaultResourceGroupID(c.client)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateClusterReq)
+	}
+	target.ResourceGroup = reference.FromPtrValue(rgID)
+
+	_, err = c.client.ClusterClientV2().Create(createRequest, target)
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreateCluster)
 	}
@@ -160,11 +197,63 @@ func (c *clusterExternal) Create(ctx context.Context, mg resource.Managed) (mana
 	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
 }
 
-// (Not) called by crossplane - as the bucket cannot be changed once created... Here only to satisfy the compiler
+// Called by crossplane - only the tags of a cluster can be changed once created
 func (c *clusterExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	crossplaneCluster, ok := mg.(*v1alpha1.Cluster)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errThisIsNotACluster)
+	}
+
+	if err := c.enforcePolicies(crossplaneCluster); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateCluster)
+	}
+
+	if err := ibmc.UpdateManagedTags(c.client, crossplaneCluster.Status.AtProvider.CRN, crossplaneCluster.Spec.ForProvider.Tags, crossplaneCluster.Spec.ForProvider.AccessTags); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTagCluster)
+	}
+
 	return managed.ExternalUpdate{}, nil
 }
 
+// enforcePolicies checks the cluster against the naming policy and guardrails of its ProviderConfig, as well as
+// against any additional checks. The region of the cluster is the one of its zones, or the observed one once it
+// exists.
+func (c *clusterExternal) enforcePolicies(crossplaneCluster *v1alpha1.Cluster, checks ...error) error {
+	pc := c.client.ProviderConfig()
+	p := crossplaneCluster.Spec.ForProvider
+	zones := []string{}
+	for _, z := range p.WorkerPools.Zones {
+		if z.ID != nil {
+			zones = append(zones, *z.ID)
+		}
+	}
+	regions := []string{}
+	if r := crossplaneCluster.Status.AtProvider.Region; r != "" {
+		regions = append(regions, r)
+	}
+	return ibmc.EnforcePolicies(crossplaneCluster, pc, append([]error{
+		ibmc.CheckNamingPolicy(pc, &p.Name),
+		ibmc.CheckGuardrails(pc, ibmc.GuardedValues{
+			Regions:        regions,
+			Zones:          zones,
+			ClusterFlavors: []string{p.WorkerPools.Flavor},
+		})}, checks...)...)
+}
+
+// checkMonthlyBudget checks the estimated monthly cost of the cluster against the budget of its ProviderConfig,
+// if there is one
+func (c *clusterExternal) checkMonthlyBudget(crossplaneCluster *v1alpha1.Cluster) error {
+	pc := c.client.ProviderConfig()
+	if !ibmc.CostEstimationEnabled(pc) || pc.CostEstimation.MonthlyBudget == nil {
+		return nil
+	}
+	cost, err := crossplaneClient.EstimateMonthlyCost(c.client, &crossplaneCluster.Spec.ForProvider)
+	if err != nil {
+		return errors.Wrap(err, errEstimateCluster)
+	}
+	return ibmc.CheckMonthlyBudget(pc, cost)
+}
+
 // Called by crossplane
 func (c *clusterExternal) Delete(ctx context.Context, mg resource.Managed) error {
 	crossplaneCluster, ok := mg.(*v1alpha1.Cluster)
@@ -172,6 +261,10 @@ func (c *clusterExternal) Delete(ctx context.Context, mg resource.Managed) error
 		return errors.New(errThisIsNotACluster)
 	}
 
+	if err := ibmc.CheckDeletionProtection(v1alpha1.ClusterKind, crossplaneCluster); err != nil {
+		return errors.Wrap(err, errDeleteCluster)
+	}
+
 	crossplaneCluster.SetConditions(runtimev1alpha1.Deleting())
 
 	err := c.client.ClusterClientV2().Delete(crossplaneCluster.Spec.ForProvider.Name, ibmContainerV2.ClusterTargetHeader{})
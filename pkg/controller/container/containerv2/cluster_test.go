@@ -32,6 +32,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/google/go-cmp/cmp"
@@ -39,6 +40,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	crossplaneApi "github.com/crossplane-contrib/provider-ibm-cloud/apis/container/containerv2/v1alpha1"
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
 	crossplaneClient "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/container/containerv2"
 
 	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/controller/tstutil"
@@ -218,6 +221,34 @@ func TestCreate(t *testing.T) {
 }
 
 // Tests the cluster "Delete" method
+func TestCreateGuardrails(t *testing.T) {
+	pc := &v1beta1.ProviderConfigSpec{Guardrails: &v1beta1.Guardrails{Regions: &v1beta1.AllowDenyList{Denied: []string{"jp-tok"}}}}
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	mClient, err := ibmc.GetTestClientWithProviderConfig(server.URL, pc)
+	if err != nil {
+		t.Fatalf("Create(...): problem setting up the test client %s", err)
+	}
+	e := &clusterExternal{client: mClient, logger: logging.NewNopLogger()}
+
+	inTokyo := func(c *crossplaneApi.Cluster) {
+		c.Spec.ForProvider.WorkerPools.Zones = []crossplaneApi.Zone{{ID: reference.ToPtrValue("jp-tok-1")}}
+	}
+	mg := createCrossplaneClusterSansStatus(inTokyo)
+	cre, err := e.Create(context.Background(), mg)
+	violation := ibmc.CheckGuardrails(pc, ibmc.GuardedValues{Regions: []string{"jp-tok"}})
+	if diff := cmp.Diff(errors.Wrap(violation, errCreateCluster).Error(), err.Error()); diff != "" {
+		t.Errorf("Create(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(managed.ExternalCreation{}, cre); diff != "" {
+		t.Errorf("Create(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(createCrossplaneClusterSansStatus(inTokyo, withConditions(ibmc.NonCompliant(violation))), mg); diff != "" {
+		t.Errorf("Create(...): -want, +got:\n%s", diff)
+	}
+}
+
 func TestDelete(t *testing.T) {
 	type want struct {
 		mg  resource.Managed
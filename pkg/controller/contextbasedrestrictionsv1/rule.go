@@ -0,0 +1,217 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package contextbasedrestrictionsv1
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cpv1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/contextbasedrestrictionsv1/v1alpha1"
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/cbr"
+	ibmcr "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/rule"
+)
+
+const (
+	errNotRule        = "managed resource is not a Rule custom resource"
+	errCreateRule     = "could not create rule"
+	errDeleteRule     = "could not delete rule"
+	errGetRuleFailed  = "error getting rule"
+	errCreateRuleOpts = "error creating rule options"
+	errUpdRule        = "error updating rule"
+)
+
+// SetupRule adds a controller that reconciles Rule managed resources.
+func SetupRule(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.RuleGroupKind)
+	log := l.WithValues("Rule-controller", name)
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.RuleGroupVersionKind),
+		managed.WithExternalConnecter(ibmc.NewAuditConnecter(&ruleConnector{
+			kube:     mgr.GetClient(),
+			usage:    resource.NewProviderConfigUsageTracker(mgr.GetClient(), &v1beta1.ProviderConfigUsage{}),
+			clientFn: ibmc.NewClient,
+			logger:   log}, event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()),
+			ibmc.NewExpiration(mgr.GetClient(), event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(log),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Rule{}).
+		Complete(ibmc.NewExpirationReconciler(mgr, resource.ManagedKind(v1alpha1.RuleGroupVersionKind), r))
+}
+
+// A ruleConnector is expected to produce an ExternalClient when its Connect method
+// is called.
+type ruleConnector struct {
+	kube     client.Client
+	usage    resource.Tracker
+	clientFn func(optd ibmc.ClientOptions) (ibmc.ClientSession, error)
+	logger   logging.Logger
+}
+
+// Connect produces an ExternalClient for IBM Cloud API
+func (c *ruleConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	opts, err := ibmc.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, ibmc.ErrGetAuth)
+	}
+
+	service, err := c.clientFn(opts)
+	if err != nil {
+		return nil, errors.Wrap(err, ibmc.ErrNewClient)
+	}
+
+	return &ruleExternal{client: service.ContextBasedRestrictionsV1(), kube: c.kube, logger: c.logger}, nil
+}
+
+// A ruleExternal observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type ruleExternal struct {
+	client *cbr.ContextBasedRestrictionsV1
+	kube   client.Client
+	logger logging.Logger
+}
+
+func (c *ruleExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Rule)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRule)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	instance, resp, err := c.client.GetRule(ctx, &cbr.GetRuleOptions{RuleID: reference.ToPtrValue(meta.GetExternalName(cr))})
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ibmc.IsResourceNotFound, err), errGetRuleFailed)
+	}
+	ibmc.SetEtagAnnotation(cr, ibmc.GetEtag(resp.Headers))
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	if err = ibmcr.LateInitializeSpec(&cr.Spec.ForProvider, instance); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
+	}
+	lateInitSpec := cr.Spec.ForProvider.DeepCopy()
+	if err = ibmc.RestrictLateInitialization(cr, currentSpec, &cr.Spec.ForProvider); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
+	}
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := c.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
+		}
+	}
+
+	cr.Status.AtProvider, err = ibmcr.GenerateObservation(instance)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrGenObservation)
+	}
+
+	switch cr.Status.AtProvider.State {
+	case ibmcr.StateActive:
+		cr.Status.SetConditions(cpv1alpha1.Available())
+	default:
+		cr.Status.SetConditions(cpv1alpha1.Unavailable())
+	}
+
+	upToDate, err := ibmcr.IsUpToDate(lateInitSpec, instance, c.logger)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrCheckUpToDate)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: nil,
+	}, nil
+}
+
+func (c *ruleExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Rule)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotRule)
+	}
+
+	cr.SetConditions(cpv1alpha1.Creating())
+	createOpts := &cbr.CreateRuleOptions{}
+	if err := ibmcr.GenerateCreateRuleOptions(cr.Spec.ForProvider, createOpts); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateRuleOpts)
+	}
+
+	instance, _, err := c.client.CreateRule(ctx, createOpts)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateRule)
+	}
+
+	meta.SetExternalName(cr, reference.FromPtrValue(instance.ID))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (c *ruleExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Rule)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotRule)
+	}
+
+	replaceOpts := &cbr.ReplaceRuleOptions{}
+	if err := ibmcr.GenerateReplaceRuleOptions(meta.GetExternalName(cr), ibmc.GetEtagAnnotation(cr), cr.Spec.ForProvider, replaceOpts); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdRule)
+	}
+
+	_, _, err := c.client.ReplaceRule(ctx, replaceOpts)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdRule)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *ruleExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Rule)
+	if !ok {
+		return errors.New(errNotRule)
+	}
+
+	cr.SetConditions(cpv1alpha1.Deleting())
+
+	_, err := c.client.DeleteRule(ctx, &cbr.DeleteRuleOptions{RuleID: reference.ToPtrValue(meta.GetExternalName(cr))})
+	if err != nil {
+		return errors.Wrap(resource.Ignore(ibmc.IsResourceGone, err), errDeleteRule)
+	}
+	return nil
+}
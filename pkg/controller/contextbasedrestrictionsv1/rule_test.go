@@ -0,0 +1,716 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package contextbasedrestrictionsv1
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cpv1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/contextbasedrestrictionsv1/v1alpha1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/cbr"
+	ibmcr "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/rule"
+	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/controller/tstutil"
+)
+
+const (
+	errRuleBadRequest = "error getting rule: Bad Request"
+)
+
+var (
+	ruleName         = "myRule"
+	ruleID           = "6c4f8d2a3b2e4c1d9a7f5e6b8c0d1e2f"
+	ruleCRN          = "crn:v1:bluemix:public:context-based-restrictions:global:a/" + accountID + "::rule:" + ruleID
+	ruleDescription  = "restrict my bucket to my zone"
+	ruleZoneKey      = "networkZoneId"
+	ruleAccountKey   = "accountId"
+	ruleServiceKey   = "serviceName"
+	ruleService      = "cloud-object-storage"
+	ruleInstanceKey  = "serviceInstance"
+	ruleSvcInstance  = "a1b2c3d4-e5f6-1234-5678-90abcdef1234"
+	ruleStringEquals = "stringEquals"
+	ruleEnabled      = "enabled"
+	ruleReport       = "report"
+	ruleHref         = "https://cbr.cloud.ibm.com/v1/rules/" + ruleID
+)
+
+var _ managed.ExternalConnecter = &ruleConnector{}
+var _ managed.ExternalClient = &ruleExternal{}
+
+type ruleModifier func(*v1alpha1.Rule)
+
+func rule(im ...ruleModifier) *v1alpha1.Rule {
+	i := &v1alpha1.Rule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       ruleName,
+			Finalizers: []string{},
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: ruleID,
+			},
+		},
+		Spec: v1alpha1.RuleSpec{
+			ForProvider: v1alpha1.RuleParameters{},
+		},
+	}
+	for _, m := range im {
+		m(i)
+	}
+	return i
+}
+
+func ruleWithExternalNameAnnotation(externalName string) ruleModifier {
+	return func(i *v1alpha1.Rule) {
+		if i.ObjectMeta.Annotations == nil {
+			i.ObjectMeta.Annotations = make(map[string]string)
+		}
+		i.ObjectMeta.Annotations[meta.AnnotationKeyExternalName] = externalName
+	}
+}
+
+func ruleWithEtagAnnotation(eTag string) ruleModifier {
+	return func(i *v1alpha1.Rule) {
+		if i.ObjectMeta.Annotations == nil {
+			i.ObjectMeta.Annotations = make(map[string]string)
+		}
+		i.ObjectMeta.Annotations[ibmc.ETagAnnotation] = eTag
+	}
+}
+
+func ruleWithSpec(p v1alpha1.RuleParameters) ruleModifier {
+	return func(r *v1alpha1.Rule) { r.Spec.ForProvider = p }
+}
+
+func ruleWithConditions(c ...cpv1alpha1.Condition) ruleModifier {
+	return func(i *v1alpha1.Rule) { i.Status.SetConditions(c...) }
+}
+
+func ruleWithStatus(p v1alpha1.RuleObservation) ruleModifier {
+	return func(r *v1alpha1.Rule) { r.Status.AtProvider = p }
+}
+
+func ruleParams(m ...func(*v1alpha1.RuleParameters)) *v1alpha1.RuleParameters {
+	p := &v1alpha1.RuleParameters{
+		Description: &ruleDescription,
+		Contexts: []v1alpha1.RuleContext{{
+			Attributes: []v1alpha1.RuleContextAttribute{
+				{Name: ruleZoneKey, Value: &zoneID, ZoneIDRef: &cpv1alpha1.Reference{Name: zoneName}},
+			},
+		}},
+		Resources: []v1alpha1.RuleResource{{
+			Attributes: []v1alpha1.RuleResourceAttribute{
+				{Name: ruleAccountKey, Value: &accountID, Operator: &ruleStringEquals},
+				{Name: ruleServiceKey, Value: &ruleService, Operator: &ruleStringEquals},
+				{Name: ruleInstanceKey, Value: &ruleSvcInstance, Operator: &ruleStringEquals,
+					ServiceInstanceRef: &cpv1alpha1.Reference{Name: "mycos"}},
+			},
+		}},
+		EnforcementMode: &ruleEnabled,
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func ruleObservation(m ...func(*v1alpha1.RuleObservation)) *v1alpha1.RuleObservation {
+	o := &v1alpha1.RuleObservation{
+		ID:               ruleID,
+		CRN:              ruleCRN,
+		Href:             ruleHref,
+		CreatedAt:        ibmc.DateTimeToMetaV1Time(&createdAt),
+		CreatedByID:      createdByID,
+		LastModifiedAt:   ibmc.DateTimeToMetaV1Time(&lastModifiedAt),
+		LastModifiedByID: createdByID,
+		State:            ibmcr.StateActive,
+	}
+	for _, f := range m {
+		f(o)
+	}
+	return o
+}
+
+func ruleInstance(m ...func(*cbr.Rule)) *cbr.Rule {
+	i := &cbr.Rule{
+		ID:          &ruleID,
+		CRN:         &ruleCRN,
+		Description: &ruleDescription,
+		Contexts: []cbr.RuleContext{{
+			Attributes: []cbr.RuleContextAttribute{{Name: &ruleZoneKey, Value: &zoneID}},
+		}},
+		Resources: []cbr.Resource{{
+			Attributes: []cbr.ResourceAttribute{
+				{Name: &ruleAccountKey, Value: &accountID, Operator: &ruleStringEquals},
+				{Name: &ruleServiceKey, Value: &ruleService, Operator: &ruleStringEquals},
+				{Name: &ruleInstanceKey, Value: &ruleSvcInstance, Operator: &ruleStringEquals},
+			},
+		}},
+		EnforcementMode:  &ruleEnabled,
+		Href:             &ruleHref,
+		CreatedAt:        &createdAt,
+		CreatedByID:      &createdByID,
+		LastModifiedAt:   &lastModifiedAt,
+		LastModifiedByID: &createdByID,
+	}
+	for _, f := range m {
+		f(i)
+	}
+	return i
+}
+
+// Sets up a unit test http server, and creates an external rule structure appropriate for unit test.
+func setupServerAndGetUnitTestExternalRule(testingObj *testing.T, handlers *[]tstutil.Handler, kube *client.Client) (*ruleExternal, *httptest.Server, error) {
+	mClient, tstServer, err := tstutil.SetupTestServerClient(testingObj, handlers)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &ruleExternal{
+			kube:   *kube,
+			client: (*mClient).ContextBasedRestrictionsV1(),
+			logger: logging.NewNopLogger(),
+		},
+		tstServer,
+		nil
+}
+
+func TestRuleObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"NotFound": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusNotFound)
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: rule(),
+			},
+			want: want{
+				mg:  rule(),
+				err: nil,
+			},
+		},
+		"GetFailed": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusBadRequest)
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: rule(),
+			},
+			want: want{
+				mg:  rule(),
+				err: errors.New(errRuleBadRequest),
+			},
+		},
+		"UpToDate": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff("/v1/rules/"+ruleID, r.URL.Path); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.Header().Set("ETag", eTag)
+						err := json.NewEncoder(w).Encode(ruleInstance())
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: tstutil.Args{
+				Managed: rule(
+					ruleWithExternalNameAnnotation(ruleID),
+					ruleWithSpec(*ruleParams()),
+				),
+			},
+			want: want{
+				mg: rule(ruleWithSpec(*ruleParams()),
+					ruleWithEtagAnnotation(eTag),
+					ruleWithConditions(cpv1alpha1.Available()),
+					ruleWithStatus(*ruleObservation())),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: nil,
+				},
+			},
+		},
+		"LateInitOperators": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						w.Header().Set("Content-Type", "application/json")
+						w.Header().Set("ETag", eTag)
+						err := json.NewEncoder(w).Encode(ruleInstance())
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: tstutil.Args{
+				Managed: rule(
+					ruleWithExternalNameAnnotation(ruleID),
+					ruleWithSpec(*ruleParams(func(p *v1alpha1.RuleParameters) {
+						p.EnforcementMode = nil
+						p.Resources[0].Attributes[0].Operator = nil
+					})),
+				),
+			},
+			want: want{
+				mg: rule(ruleWithSpec(*ruleParams()),
+					ruleWithEtagAnnotation(eTag),
+					ruleWithConditions(cpv1alpha1.Available()),
+					ruleWithStatus(*ruleObservation())),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: nil,
+				},
+			},
+		},
+		"NotUpToDate": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						w.Header().Set("Content-Type", "application/json")
+						w.Header().Set("ETag", eTag)
+						err := json.NewEncoder(w).Encode(ruleInstance(func(i *cbr.Rule) {
+							i.EnforcementMode = &ruleReport
+						}))
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: tstutil.Args{
+				Managed: rule(
+					ruleWithExternalNameAnnotation(ruleID),
+					ruleWithSpec(*ruleParams()),
+				),
+			},
+			want: want{
+				mg: rule(ruleWithSpec(*ruleParams()),
+					ruleWithEtagAnnotation(eTag),
+					ruleWithConditions(cpv1alpha1.Available()),
+					ruleWithStatus(*ruleObservation())),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: nil,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, errCr := setupServerAndGetUnitTestExternalRule(t, &tc.handlers, &tc.kube)
+			if errCr != nil {
+				t.Errorf("Observe(...): problem setting up the test server %s", errCr)
+			}
+
+			defer server.Close()
+
+			obs, err := e.Observe(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				// the case where our mock server returns error.
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Observe(...): want error string != got error string:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Observe(...): want error != got error:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRuleCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		cre managed.ExternalCreation
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"Successful": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff("/v1/rules", r.URL.Path); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						b, _ := io.ReadAll(r.Body)
+						_ = r.Body.Close()
+						got := &cbr.Rule{}
+						if err := json.Unmarshal(b, got); err != nil {
+							t.Errorf("r: cannot unmarshal request body: %s", err)
+						}
+						want := ruleInstance(func(i *cbr.Rule) {
+							i.ID, i.CRN, i.Href, i.CreatedAt, i.CreatedByID, i.LastModifiedAt, i.LastModifiedByID = nil, nil, nil, nil, nil, nil, nil
+						})
+						if diff := cmp.Diff(want, got); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusCreated)
+						err := json.NewEncoder(w).Encode(ruleInstance())
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: rule(ruleWithSpec(*ruleParams())),
+			},
+			want: want{
+				mg: rule(ruleWithSpec(*ruleParams()),
+					ruleWithConditions(cpv1alpha1.Creating()),
+					ruleWithExternalNameAnnotation(ruleID)),
+				cre: managed.ExternalCreation{ExternalNameAssigned: true},
+				err: nil,
+			},
+		},
+		"BadRequest": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusBadRequest)
+						_ = r.Body.Close()
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: rule(ruleWithSpec(*ruleParams())),
+			},
+			want: want{
+				mg: rule(ruleWithSpec(*ruleParams()),
+					ruleWithConditions(cpv1alpha1.Creating())),
+				cre: managed.ExternalCreation{ExternalNameAssigned: false},
+				err: errors.Wrap(errors.New(http.StatusText(http.StatusBadRequest)), errCreateRule),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, errCr := setupServerAndGetUnitTestExternalRule(t, &tc.handlers, &tc.kube)
+			if errCr != nil {
+				t.Errorf("Create(...): problem setting up the test server %s", errCr)
+			}
+
+			defer server.Close()
+
+			cre, err := e.Create(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				// the case where our mock server returns error.
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Create(...): -want, +got:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Create(...): -want, +got:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.cre, cre); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRuleDelete(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"Successful": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff("/v1/rules/"+ruleID, r.URL.Path); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusNoContent)
+						_ = r.Body.Close()
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: rule(ruleWithStatus(*ruleObservation())),
+			},
+			want: want{
+				mg:  rule(ruleWithStatus(*ruleObservation()), ruleWithConditions(cpv1alpha1.Deleting())),
+				err: nil,
+			},
+		},
+		"AlreadyGone": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusNotFound)
+						_ = r.Body.Close()
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: rule(ruleWithStatus(*ruleObservation())),
+			},
+			want: want{
+				mg:  rule(ruleWithStatus(*ruleObservation()), ruleWithConditions(cpv1alpha1.Deleting())),
+				err: nil,
+			},
+		},
+		"Failed": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusInternalServerError)
+						_ = r.Body.Close()
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: rule(ruleWithStatus(*ruleObservation())),
+			},
+			want: want{
+				mg:  rule(ruleWithStatus(*ruleObservation()), ruleWithConditions(cpv1alpha1.Deleting())),
+				err: errors.Wrap(errors.New(http.StatusText(http.StatusInternalServerError)), errDeleteRule),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, errCr := setupServerAndGetUnitTestExternalRule(t, &tc.handlers, &tc.kube)
+			if errCr != nil {
+				t.Errorf("Delete(...): problem setting up the test server %s", errCr)
+			}
+
+			defer server.Close()
+
+			err := e.Delete(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				// the case where our mock server returns error.
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Delete(...): -want, +got:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Delete(...): -want, +got:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRuleUpdate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		upd managed.ExternalUpdate
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"Successful": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodPut, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff("/v1/rules/"+ruleID, r.URL.Path); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff(eTag, r.Header.Get("If-Match")); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusOK)
+						_ = r.Body.Close()
+						err := json.NewEncoder(w).Encode(ruleInstance())
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: rule(ruleWithSpec(*ruleParams()), ruleWithEtagAnnotation(eTag), ruleWithStatus(*ruleObservation())),
+			},
+			want: want{
+				mg:  rule(ruleWithSpec(*ruleParams()), ruleWithEtagAnnotation(eTag), ruleWithStatus(*ruleObservation())),
+				upd: managed.ExternalUpdate{},
+				err: nil,
+			},
+		},
+		"PreconditionFailed": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusPreconditionFailed)
+						_ = r.Body.Close()
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: rule(ruleWithSpec(*ruleParams()), ruleWithEtagAnnotation(eTag), ruleWithStatus(*ruleObservation())),
+			},
+			want: want{
+				mg:  rule(ruleWithSpec(*ruleParams()), ruleWithEtagAnnotation(eTag), ruleWithStatus(*ruleObservation())),
+				err: errors.Wrap(errors.New(http.StatusText(http.StatusPreconditionFailed)), errUpdRule),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, errCr := setupServerAndGetUnitTestExternalRule(t, &tc.handlers, &tc.kube)
+			if errCr != nil {
+				t.Errorf("Update(...): problem setting up the test server %s", errCr)
+			}
+
+			defer server.Close()
+
+			upd, err := e.Update(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				// the case where our mock server returns error.
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Update(...): -want, +got:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Update(...): -want, +got:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.upd, upd); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,240 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package contextbasedrestrictionsv1
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cpv1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/contextbasedrestrictionsv1/v1alpha1"
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/cbr"
+	ibmcz "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/zone"
+)
+
+const (
+	errNotZone        = "managed resource is not a Zone custom resource"
+	errCreateZone     = "could not create zone"
+	errDeleteZone     = "could not delete zone"
+	errGetZoneFailed  = "error getting zone"
+	errCreateZoneOpts = "error creating zone options"
+	errUpdZone        = "error updating zone"
+)
+
+// zoneDependents are the managed resources that reference zones, and must be deleted before them (as the API does
+// not delete a zone used by a rule)
+var zoneDependents = []ibmc.Dependent{
+	{
+		Kind: v1alpha1.RuleKind,
+		List: func() resource.ManagedList { return &v1alpha1.RuleList{} },
+		DependsOn: func(mg resource.Managed, name string) bool {
+			for _, c := range mg.(*v1alpha1.Rule).Spec.ForProvider.Contexts {
+				for _, attr := range c.Attributes {
+					if ibmc.IsReferenceTo(attr.ZoneIDRef, name) {
+						return true
+					}
+				}
+			}
+			return false
+		},
+	},
+}
+
+// SetupZone adds a controller that reconciles Zone managed resources.
+func SetupZone(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.ZoneGroupKind)
+	log := l.WithValues("Zone-controller", name)
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ZoneGroupVersionKind),
+		managed.WithExternalConnecter(ibmc.NewAuditConnecter(&zoneConnector{
+			kube:     mgr.GetClient(),
+			usage:    resource.NewProviderConfigUsageTracker(mgr.GetClient(), &v1beta1.ProviderConfigUsage{}),
+			clientFn: ibmc.NewClient,
+			logger:   log}, event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()),
+			ibmc.NewExpiration(mgr.GetClient(), event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(log),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Zone{}).
+		Complete(ibmc.NewExpirationReconciler(mgr, resource.ManagedKind(v1alpha1.ZoneGroupVersionKind), r))
+}
+
+// A zoneConnector is expected to produce an ExternalClient when its Connect method
+// is called.
+type zoneConnector struct {
+	kube     client.Client
+	usage    resource.Tracker
+	clientFn func(optd ibmc.ClientOptions) (ibmc.ClientSession, error)
+	logger   logging.Logger
+}
+
+// Connect produces an ExternalClient for IBM Cloud API
+func (c *zoneConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	opts, err := ibmc.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, ibmc.ErrGetAuth)
+	}
+
+	service, err := c.clientFn(opts)
+	if err != nil {
+		return nil, errors.Wrap(err, ibmc.ErrNewClient)
+	}
+
+	return &zoneExternal{client: service.ContextBasedRestrictionsV1(), kube: c.kube, logger: c.logger}, nil
+}
+
+// A zoneExternal observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type zoneExternal struct {
+	client *cbr.ContextBasedRestrictionsV1
+	kube   client.Client
+	logger logging.Logger
+}
+
+func (c *zoneExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Zone)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotZone)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	instance, resp, err := c.client.GetZone(ctx, &cbr.GetZoneOptions{ZoneID: reference.ToPtrValue(meta.GetExternalName(cr))})
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ibmc.IsResourceNotFound, err), errGetZoneFailed)
+	}
+	ibmc.SetEtagAnnotation(cr, ibmc.GetEtag(resp.Headers))
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	if err = ibmcz.LateInitializeSpec(&cr.Spec.ForProvider, instance); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
+	}
+	lateInitSpec := cr.Spec.ForProvider.DeepCopy()
+	if err = ibmc.RestrictLateInitialization(cr, currentSpec, &cr.Spec.ForProvider); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
+	}
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := c.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
+		}
+	}
+
+	cr.Status.AtProvider, err = ibmcz.GenerateObservation(instance)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrGenObservation)
+	}
+
+	switch cr.Status.AtProvider.State {
+	case ibmcz.StateActive:
+		cr.Status.SetConditions(cpv1alpha1.Available())
+	default:
+		cr.Status.SetConditions(cpv1alpha1.Unavailable())
+	}
+
+	upToDate, err := ibmcz.IsUpToDate(lateInitSpec, instance, c.logger)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrCheckUpToDate)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: nil,
+	}, nil
+}
+
+func (c *zoneExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Zone)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotZone)
+	}
+
+	cr.SetConditions(cpv1alpha1.Creating())
+	createOpts := &cbr.CreateZoneOptions{}
+	if err := ibmcz.GenerateCreateZoneOptions(cr.Spec.ForProvider, createOpts); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateZoneOpts)
+	}
+
+	instance, _, err := c.client.CreateZone(ctx, createOpts)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateZone)
+	}
+
+	meta.SetExternalName(cr, reference.FromPtrValue(instance.ID))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (c *zoneExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Zone)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotZone)
+	}
+
+	replaceOpts := &cbr.ReplaceZoneOptions{}
+	if err := ibmcz.GenerateReplaceZoneOptions(meta.GetExternalName(cr), ibmc.GetEtagAnnotation(cr), cr.Spec.ForProvider, replaceOpts); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdZone)
+	}
+
+	_, _, err := c.client.ReplaceZone(ctx, replaceOpts)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdZone)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *zoneExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Zone)
+	if !ok {
+		return errors.New(errNotZone)
+	}
+
+	if err := ibmc.CheckDependents(ctx, c.kube, cr, zoneDependents...); err != nil {
+		return errors.Wrap(err, errDeleteZone)
+	}
+
+	cr.SetConditions(cpv1alpha1.Deleting())
+
+	_, err := c.client.DeleteZone(ctx, &cbr.DeleteZoneOptions{ZoneID: reference.ToPtrValue(meta.GetExternalName(cr))})
+	if err != nil {
+		return errors.Wrap(resource.Ignore(ibmc.IsResourceGone, err), errDeleteZone)
+	}
+	return nil
+}
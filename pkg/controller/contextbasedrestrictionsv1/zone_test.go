@@ -0,0 +1,753 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package contextbasedrestrictionsv1
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cpv1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/contextbasedrestrictionsv1/v1alpha1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/cbr"
+	ibmcz "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/zone"
+	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/controller/tstutil"
+)
+
+const (
+	errZoneBadRequest = "error getting zone: Bad Request"
+)
+
+var (
+	zoneName           = "myZone"
+	zoneID             = "65810ac762004f22ac19f8f8edf70a34"
+	zoneCRN            = "crn:v1:bluemix:public:context-based-restrictions:global:a/" + accountID + "::zone:" + zoneID
+	zoneDescription    = "my network zone"
+	zoneIPAddress      = "ipAddress"
+	zoneIP             = "169.23.56.234"
+	zoneIP2            = "169.23.56.235"
+	zoneVPC            = "vpc"
+	zoneVPCCRN         = "crn:v1:bluemix:public:is:us-south:a/" + accountID + "::vpc:r006-1234"
+	zoneAddressCount   = int64(2)
+	zoneExcludedCount  = int64(0)
+	zoneHref           = "https://cbr.cloud.ibm.com/v1/zones/" + zoneID
+	accountID          = "12ab34cd56ef78ab90cd12ef34ab56cd"
+	eTag               = "1-eb832c7ff8c8016a542974b9f880b55e"
+	createdByID        = "IBMid-123453user"
+	createdAt, _       = strfmt.ParseDateTime("2020-10-31T02:33:06Z")
+	lastModifiedAt, _  = strfmt.ParseDateTime("2020-10-31T03:33:06Z")
+	zoneDependentError = "waiting for the deletion of the managed resources that depend on the resource: Rule/myrule"
+)
+
+var _ managed.ExternalConnecter = &zoneConnector{}
+var _ managed.ExternalClient = &zoneExternal{}
+
+type zoneModifier func(*v1alpha1.Zone)
+
+func zone(im ...zoneModifier) *v1alpha1.Zone {
+	i := &v1alpha1.Zone{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       zoneName,
+			Finalizers: []string{},
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: zoneID,
+			},
+		},
+		Spec: v1alpha1.ZoneSpec{
+			ForProvider: v1alpha1.ZoneParameters{},
+		},
+	}
+	for _, m := range im {
+		m(i)
+	}
+	return i
+}
+
+func zoneWithExternalNameAnnotation(externalName string) zoneModifier {
+	return func(i *v1alpha1.Zone) {
+		if i.ObjectMeta.Annotations == nil {
+			i.ObjectMeta.Annotations = make(map[string]string)
+		}
+		i.ObjectMeta.Annotations[meta.AnnotationKeyExternalName] = externalName
+	}
+}
+
+func zoneWithEtagAnnotation(eTag string) zoneModifier {
+	return func(i *v1alpha1.Zone) {
+		if i.ObjectMeta.Annotations == nil {
+			i.ObjectMeta.Annotations = make(map[string]string)
+		}
+		i.ObjectMeta.Annotations[ibmc.ETagAnnotation] = eTag
+	}
+}
+
+func zoneWithSpec(p v1alpha1.ZoneParameters) zoneModifier {
+	return func(r *v1alpha1.Zone) { r.Spec.ForProvider = p }
+}
+
+func zoneWithConditions(c ...cpv1alpha1.Condition) zoneModifier {
+	return func(i *v1alpha1.Zone) { i.Status.SetConditions(c...) }
+}
+
+func zoneWithStatus(p v1alpha1.ZoneObservation) zoneModifier {
+	return func(r *v1alpha1.Zone) { r.Status.AtProvider = p }
+}
+
+func zoneParams(m ...func(*v1alpha1.ZoneParameters)) *v1alpha1.ZoneParameters {
+	p := &v1alpha1.ZoneParameters{
+		Name:        zoneName,
+		AccountID:   accountID,
+		Description: &zoneDescription,
+		Addresses: []v1alpha1.ZoneAddress{
+			{Type: zoneIPAddress, Value: &zoneIP},
+			{Type: zoneVPC, Value: &zoneVPCCRN, VPCRef: &cpv1alpha1.Reference{Name: "myvpc"}},
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func zoneObservation(m ...func(*v1alpha1.ZoneObservation)) *v1alpha1.ZoneObservation {
+	o := &v1alpha1.ZoneObservation{
+		ID:               zoneID,
+		CRN:              zoneCRN,
+		AddressCount:     zoneAddressCount,
+		ExcludedCount:    zoneExcludedCount,
+		Href:             zoneHref,
+		CreatedAt:        ibmc.DateTimeToMetaV1Time(&createdAt),
+		CreatedByID:      createdByID,
+		LastModifiedAt:   ibmc.DateTimeToMetaV1Time(&lastModifiedAt),
+		LastModifiedByID: createdByID,
+		State:            ibmcz.StateActive,
+	}
+	for _, f := range m {
+		f(o)
+	}
+	return o
+}
+
+func zoneInstance(m ...func(*cbr.Zone)) *cbr.Zone {
+	i := &cbr.Zone{
+		ID:            &zoneID,
+		CRN:           &zoneCRN,
+		AddressCount:  &zoneAddressCount,
+		ExcludedCount: &zoneExcludedCount,
+		Name:          &zoneName,
+		AccountID:     &accountID,
+		Description:   &zoneDescription,
+		Addresses: []cbr.Address{
+			{Type: &zoneIPAddress, Value: &zoneIP},
+			{Type: &zoneVPC, Value: &zoneVPCCRN},
+		},
+		Href:             &zoneHref,
+		CreatedAt:        &createdAt,
+		CreatedByID:      &createdByID,
+		LastModifiedAt:   &lastModifiedAt,
+		LastModifiedByID: &createdByID,
+	}
+	for _, f := range m {
+		f(i)
+	}
+	return i
+}
+
+// Sets up a unit test http server, and creates an external zone structure appropriate for unit test.
+func setupServerAndGetUnitTestExternalZone(testingObj *testing.T, handlers *[]tstutil.Handler, kube *client.Client) (*zoneExternal, *httptest.Server, error) {
+	mClient, tstServer, err := tstutil.SetupTestServerClient(testingObj, handlers)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &zoneExternal{
+			kube:   *kube,
+			client: (*mClient).ContextBasedRestrictionsV1(),
+			logger: logging.NewNopLogger(),
+		},
+		tstServer,
+		nil
+}
+
+func TestZoneObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"NotFound": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusNotFound)
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: zone(),
+			},
+			want: want{
+				mg:  zone(),
+				err: nil,
+			},
+		},
+		"GetFailed": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusBadRequest)
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: zone(),
+			},
+			want: want{
+				mg:  zone(),
+				err: errors.New(errZoneBadRequest),
+			},
+		},
+		"UpToDate": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff("/v1/zones/"+zoneID, r.URL.Path); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.Header().Set("ETag", eTag)
+						err := json.NewEncoder(w).Encode(zoneInstance())
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: tstutil.Args{
+				Managed: zone(
+					zoneWithExternalNameAnnotation(zoneID),
+					zoneWithSpec(*zoneParams()),
+				),
+			},
+			want: want{
+				mg: zone(zoneWithSpec(*zoneParams()),
+					zoneWithEtagAnnotation(eTag),
+					zoneWithConditions(cpv1alpha1.Available()),
+					zoneWithStatus(*zoneObservation())),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: nil,
+				},
+			},
+		},
+		"LateInitDescription": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						w.Header().Set("Content-Type", "application/json")
+						w.Header().Set("ETag", eTag)
+						err := json.NewEncoder(w).Encode(zoneInstance())
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: tstutil.Args{
+				Managed: zone(
+					zoneWithExternalNameAnnotation(zoneID),
+					zoneWithSpec(*zoneParams(func(p *v1alpha1.ZoneParameters) {
+						p.Description = nil
+					})),
+				),
+			},
+			want: want{
+				mg: zone(zoneWithSpec(*zoneParams()),
+					zoneWithEtagAnnotation(eTag),
+					zoneWithConditions(cpv1alpha1.Available()),
+					zoneWithStatus(*zoneObservation())),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: nil,
+				},
+			},
+		},
+		"NotUpToDate": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						w.Header().Set("Content-Type", "application/json")
+						w.Header().Set("ETag", eTag)
+						err := json.NewEncoder(w).Encode(zoneInstance(func(i *cbr.Zone) {
+							i.Addresses[0].Value = &zoneIP2
+						}))
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: tstutil.Args{
+				Managed: zone(
+					zoneWithExternalNameAnnotation(zoneID),
+					zoneWithSpec(*zoneParams()),
+				),
+			},
+			want: want{
+				mg: zone(zoneWithSpec(*zoneParams()),
+					zoneWithEtagAnnotation(eTag),
+					zoneWithConditions(cpv1alpha1.Available()),
+					zoneWithStatus(*zoneObservation())),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: nil,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, errCr := setupServerAndGetUnitTestExternalZone(t, &tc.handlers, &tc.kube)
+			if errCr != nil {
+				t.Errorf("Observe(...): problem setting up the test server %s", errCr)
+			}
+
+			defer server.Close()
+
+			obs, err := e.Observe(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				// the case where our mock server returns error.
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Observe(...): want error string != got error string:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Observe(...): want error != got error:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestZoneCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		cre managed.ExternalCreation
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"Successful": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff("/v1/zones", r.URL.Path); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						b, _ := io.ReadAll(r.Body)
+						_ = r.Body.Close()
+						got := &cbr.Zone{}
+						if err := json.Unmarshal(b, got); err != nil {
+							t.Errorf("r: cannot unmarshal request body: %s", err)
+						}
+						want := zoneInstance(func(i *cbr.Zone) {
+							i.ID, i.CRN, i.AddressCount, i.ExcludedCount, i.Href = nil, nil, nil, nil, nil
+							i.CreatedAt, i.CreatedByID, i.LastModifiedAt, i.LastModifiedByID = nil, nil, nil, nil
+						})
+						if diff := cmp.Diff(want, got); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusCreated)
+						err := json.NewEncoder(w).Encode(zoneInstance())
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: zone(zoneWithSpec(*zoneParams())),
+			},
+			want: want{
+				mg: zone(zoneWithSpec(*zoneParams()),
+					zoneWithConditions(cpv1alpha1.Creating()),
+					zoneWithExternalNameAnnotation(zoneID)),
+				cre: managed.ExternalCreation{ExternalNameAssigned: true},
+				err: nil,
+			},
+		},
+		"BadRequest": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusBadRequest)
+						_ = r.Body.Close()
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: zone(zoneWithSpec(*zoneParams())),
+			},
+			want: want{
+				mg: zone(zoneWithSpec(*zoneParams()),
+					zoneWithConditions(cpv1alpha1.Creating())),
+				cre: managed.ExternalCreation{ExternalNameAssigned: false},
+				err: errors.Wrap(errors.New(http.StatusText(http.StatusBadRequest)), errCreateZone),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, errCr := setupServerAndGetUnitTestExternalZone(t, &tc.handlers, &tc.kube)
+			if errCr != nil {
+				t.Errorf("Create(...): problem setting up the test server %s", errCr)
+			}
+
+			defer server.Close()
+
+			cre, err := e.Create(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				// the case where our mock server returns error.
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Create(...): -want, +got:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Create(...): -want, +got:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.cre, cre); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestZoneDelete(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"Successful": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff("/v1/zones/"+zoneID, r.URL.Path); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusNoContent)
+						_ = r.Body.Close()
+					},
+				},
+			},
+			kube: &test.MockClient{MockList: test.NewMockListFn(nil)},
+			args: tstutil.Args{
+				Managed: zone(zoneWithStatus(*zoneObservation())),
+			},
+			want: want{
+				mg:  zone(zoneWithStatus(*zoneObservation()), zoneWithConditions(cpv1alpha1.Deleting())),
+				err: nil,
+			},
+		},
+		"AlreadyGone": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusNotFound)
+						_ = r.Body.Close()
+					},
+				},
+			},
+			kube: &test.MockClient{MockList: test.NewMockListFn(nil)},
+			args: tstutil.Args{
+				Managed: zone(zoneWithStatus(*zoneObservation())),
+			},
+			want: want{
+				mg:  zone(zoneWithStatus(*zoneObservation()), zoneWithConditions(cpv1alpha1.Deleting())),
+				err: nil,
+			},
+		},
+		"Failed": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusInternalServerError)
+						_ = r.Body.Close()
+					},
+				},
+			},
+			kube: &test.MockClient{MockList: test.NewMockListFn(nil)},
+			args: tstutil.Args{
+				Managed: zone(zoneWithStatus(*zoneObservation())),
+			},
+			want: want{
+				mg:  zone(zoneWithStatus(*zoneObservation()), zoneWithConditions(cpv1alpha1.Deleting())),
+				err: errors.Wrap(errors.New(http.StatusText(http.StatusInternalServerError)), errDeleteZone),
+			},
+		},
+		"DependentsExist": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						t.Errorf("r: unexpected %s request for a zone with dependents", r.Method)
+					},
+				},
+			},
+			kube: &test.MockClient{MockList: test.NewMockListFn(nil, func(obj runtime.Object) error {
+				if l, ok := obj.(*v1alpha1.RuleList); ok {
+					r := v1alpha1.Rule{}
+					r.SetName("myrule")
+					r.Spec.ForProvider.Contexts = []v1alpha1.RuleContext{{
+						Attributes: []v1alpha1.RuleContextAttribute{
+							{Name: "networkZoneId", ZoneIDRef: &cpv1alpha1.Reference{Name: zoneName}},
+						},
+					}}
+					l.Items = []v1alpha1.Rule{r}
+				}
+				return nil
+			})},
+			args: tstutil.Args{
+				Managed: zone(zoneWithStatus(*zoneObservation())),
+			},
+			want: want{
+				mg: zone(zoneWithStatus(*zoneObservation()),
+					zoneWithConditions(ibmc.DeletionBlocked(errors.New(zoneDependentError)))),
+				err: errors.Wrap(errors.New(zoneDependentError), errDeleteZone),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, errCr := setupServerAndGetUnitTestExternalZone(t, &tc.handlers, &tc.kube)
+			if errCr != nil {
+				t.Errorf("Delete(...): problem setting up the test server %s", errCr)
+			}
+
+			defer server.Close()
+
+			err := e.Delete(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				// the case where our mock server returns error.
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Delete(...): -want, +got:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Delete(...): -want, +got:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestZoneUpdate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		upd managed.ExternalUpdate
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"Successful": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodPut, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff("/v1/zones/"+zoneID, r.URL.Path); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff(eTag, r.Header.Get("If-Match")); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusOK)
+						_ = r.Body.Close()
+						err := json.NewEncoder(w).Encode(zoneInstance())
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: zone(zoneWithSpec(*zoneParams()), zoneWithEtagAnnotation(eTag), zoneWithStatus(*zoneObservation())),
+			},
+			want: want{
+				mg:  zone(zoneWithSpec(*zoneParams()), zoneWithEtagAnnotation(eTag), zoneWithStatus(*zoneObservation())),
+				upd: managed.ExternalUpdate{},
+				err: nil,
+			},
+		},
+		"PreconditionFailed": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusPreconditionFailed)
+						_ = r.Body.Close()
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: zone(zoneWithSpec(*zoneParams()), zoneWithEtagAnnotation(eTag), zoneWithStatus(*zoneObservation())),
+			},
+			want: want{
+				mg:  zone(zoneWithSpec(*zoneParams()), zoneWithEtagAnnotation(eTag), zoneWithStatus(*zoneObservation())),
+				err: errors.Wrap(errors.New(http.StatusText(http.StatusPreconditionFailed)), errUpdZone),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, errCr := setupServerAndGetUnitTestExternalZone(t, &tc.handlers, &tc.kube)
+			if errCr != nil {
+				t.Errorf("Update(...): problem setting up the test server %s", errCr)
+			}
+
+			defer server.Close()
+
+			upd, err := e.Update(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				// the case where our mock server returns error.
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Update(...): -want, +got:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Update(...): -want, +got:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.upd, upd); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
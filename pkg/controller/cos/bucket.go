@@ -30,6 +30,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane-contrib/provider-ibm-cloud/apis/cos/v1alpha1"
@@ -45,6 +46,9 @@ const (
 	errCreateBucketInp  = "could not generate the input params for a bucket"
 	errDeleteBucket     = "could not delete the bucket"
 	errGetBucketFailed  = "error getting the bucket"
+	errGetBucketCRN     = "error getting the CRN of the bucket"
+	errUpdateTagBucket  = "error updating the tags of the bucket"
+	errUpdateBucket     = "could not update the bucket"
 )
 
 // SetupBucket adds a controller that reconciles Bucket objects
@@ -54,12 +58,13 @@ func SetupBucket(mgr ctrl.Manager, l logging.Logger) error {
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.BucketGroupVersionKind),
-		managed.WithExternalConnecter(&bucketConnector{
+		managed.WithExternalConnecter(ibmc.NewAuditConnecter(&bucketConnector{
 			kube:     mgr.GetClient(),
 			usage:    resource.NewProviderConfigUsageTracker(mgr.GetClient(), &v1beta1.ProviderConfigUsage{}),
 			clientFn: ibmc.NewClient,
-			logger:   log}),
-		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			logger:   log}, event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()),
+			ibmc.NewExpiration(mgr.GetClient(), event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
 		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 		managed.WithLogger(log),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
@@ -67,7 +72,7 @@ func SetupBucket(mgr ctrl.Manager, l logging.Logger) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		For(&v1alpha1.Bucket{}).
-		Complete(r)
+		Complete(ibmc.NewExpirationReconciler(mgr, resource.ManagedKind(v1alpha1.BucketGroupVersionKind), r))
 }
 
 // Expected to produce an object of type managed.ExternalClient when its Connect method
@@ -153,16 +158,34 @@ func (c *bucketExternal) Observe(ctx context.Context, mg resource.Managed) (mana
 	s3Bucket, err := c.retrieveBucket(crossplaneBucket)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ibmc.IsResourceNotFound, err), errGetBucketFailed)
-	} else if s3Bucket != nil {
+	}
+
+	upToDate := true
+	if s3Bucket != nil {
 		crossplaneBucket.Status.AtProvider, err = crossplaneClient.GenerateBucketObservation(s3Bucket)
 		if err != nil {
 			return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrGenObservation)
 		}
+
+		forProvider := &crossplaneBucket.Spec.ForProvider
+		if forProvider.Tags != nil || forProvider.AccessTags != nil {
+			configClient := c.client.BucketConfigClient()
+			bucketConfig, _, err := configClient.GetBucketConfig(configClient.NewGetBucketConfigOptions(forProvider.Name))
+			if err != nil {
+				return managed.ExternalObservation{}, errors.Wrap(err, errGetBucketCRN)
+			}
+			crossplaneBucket.Status.AtProvider.CRN = reference.FromPtrValue(bucketConfig.Crn)
+
+			upToDate, err = ibmc.TagsUpToDate(c.client, crossplaneBucket.Status.AtProvider.CRN, forProvider.Tags, forProvider.AccessTags)
+			if err != nil {
+				return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrCheckUpToDate)
+			}
+		}
 	}
 
 	return managed.ExternalObservation{
 		ResourceExists:    s3Bucket != nil,
-		ResourceUpToDate:  true,
+		ResourceUpToDate:  upToDate,
 		ConnectionDetails: nil,
 	}, nil
 }
@@ -174,6 +197,10 @@ func (c *bucketExternal) Create(ctx context.Context, mg resource.Managed) (manag
 		return managed.ExternalCreation{}, errors.New(errCreateBucket)
 	}
 
+	if err := enforceBucketPolicies(c.client.ProviderConfig(), crossplaneBucket); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateBucket)
+	}
+
 	crossplaneBucket.SetConditions(runtimev1alpha1.Creating())
 
 	s3BucketInp := s3.CreateBucketInput{}
@@ -192,8 +219,21 @@ func (c *bucketExternal) Create(ctx context.Context, mg resource.Managed) (manag
 	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
 }
 
-// (Not) called by crossplane - as the bucket cannot be changed once created... Here only to satisfy the compiler
+// Called by crossplane - only the tags of a bucket can be changed once created
 func (c *bucketExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	crossplaneBucket, ok := mg.(*v1alpha1.Bucket)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errThisIsNotABucket)
+	}
+
+	if err := enforceBucketPolicies(c.client.ProviderConfig(), crossplaneBucket); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateBucket)
+	}
+
+	if err := ibmc.UpdateManagedTags(c.client, crossplaneBucket.Status.AtProvider.CRN, crossplaneBucket.Spec.ForProvider.Tags, crossplaneBucket.Spec.ForProvider.AccessTags); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTagBucket)
+	}
+
 	return managed.ExternalUpdate{}, nil
 }
 
@@ -204,6 +244,10 @@ func (c *bucketExternal) Delete(ctx context.Context, mg resource.Managed) error
 		return errors.New(errThisIsNotABucket)
 	}
 
+	if err := ibmc.CheckDeletionProtection(v1alpha1.BucketKind, crossplaneBucket); err != nil {
+		return errors.Wrap(err, errDeleteBucket)
+	}
+
 	crossplaneBucket.SetConditions(runtimev1alpha1.Deleting())
 
 	s3Client := c.generateClient()
@@ -228,3 +272,12 @@ func (c *bucketExternal) generateClient() *s3.S3 {
 
 	return result
 }
+
+// enforceBucketPolicies checks the name and the region of the bucket against the naming policy and guardrails of its
+// ProviderConfig
+func enforceBucketPolicies(pc *v1beta1.ProviderConfigSpec, crossplaneBucket *v1alpha1.Bucket) error {
+	p := crossplaneBucket.Spec.ForProvider
+	return ibmc.EnforcePolicies(crossplaneBucket, pc,
+		ibmc.CheckNamingPolicy(pc, &p.Name),
+		ibmc.CheckGuardrails(pc, ibmc.GuardedValues{Regions: []string{crossplaneClient.LocationRegion(p.LocationConstraint)}}))
+}
@@ -53,12 +53,13 @@ func SetupBucketConfig(mgr ctrl.Manager, l logging.Logger) error {
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.BucketConfigGroupVersionKind),
-		managed.WithExternalConnecter(&bucketConfigConnector{
+		managed.WithExternalConnecter(ibmc.NewAuditConnecter(&bucketConfigConnector{
 			kube:     mgr.GetClient(),
 			usage:    resource.NewProviderConfigUsageTracker(mgr.GetClient(), &v1beta1.ProviderConfigUsage{}),
 			clientFn: ibmc.NewClient,
-			logger:   log}),
-		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			logger:   log}, event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()),
+			ibmc.NewExpiration(mgr.GetClient(), event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
 		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 		managed.WithLogger(log),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
@@ -66,7 +67,7 @@ func SetupBucketConfig(mgr ctrl.Manager, l logging.Logger) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		For(&v1alpha1.BucketConfig{}).
-		Complete(r)
+		Complete(ibmc.NewExpirationReconciler(mgr, resource.ManagedKind(v1alpha1.BucketConfigGroupVersionKind), r))
 }
 
 // Expected to produce an object of type managed.ExternalClient when its Connect method
@@ -143,6 +144,13 @@ func (c *bucketConfigExternal) Observe(ctx context.Context, mg resource.Managed)
 			ResourceLateInitialized: wasLateInitialized,
 		}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
 	}
+	lateInitSpec := crossplaneBucketConfig.Spec.ForProvider.DeepCopy()
+	if err = ibmc.RestrictLateInitialization(crossplaneBucketConfig, currentSpecCopy, &crossplaneBucketConfig.Spec.ForProvider); err != nil {
+		return managed.ExternalObservation{
+			ResourceExists: true,
+		}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
+	}
+	wasLateInitialized = wasLateInitialized && !cmp.Equal(currentSpecCopy, &crossplaneBucketConfig.Spec.ForProvider)
 
 	if !cmp.Equal(currentSpecCopy, &crossplaneBucketConfig.Spec.ForProvider) {
 		if err := c.kube.Update(ctx, crossplaneBucketConfig); err != nil {
@@ -161,7 +169,7 @@ func (c *bucketConfigExternal) Observe(ctx context.Context, mg resource.Managed)
 		}, errors.Wrap(err, ibmc.ErrGenObservation)
 	}
 
-	upToDate, err := crossplaneClient.IsUpToDate(&crossplaneBucketConfig.Spec.ForProvider, ibmBucketConfig, c.logger)
+	upToDate, err := crossplaneClient.IsUpToDate(lateInitSpec, ibmBucketConfig, c.logger)
 	if err != nil {
 		return managed.ExternalObservation{
 			ResourceExists:          true,
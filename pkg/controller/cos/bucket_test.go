@@ -40,6 +40,8 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane-contrib/provider-ibm-cloud/apis/cos/v1alpha1"
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
 	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/controller/tstutil"
 )
 
@@ -305,6 +307,34 @@ func TestBucketCreate(t *testing.T) {
 	}
 }
 
+func TestBucketCreateGuardrails(t *testing.T) {
+	pc := &v1beta1.ProviderConfigSpec{Guardrails: &v1beta1.Guardrails{Regions: &v1beta1.AllowDenyList{Allowed: []string{"eu-de"}}}}
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	mClient, err := ibmc.GetTestClientWithProviderConfig(server.URL, pc)
+	if err != nil {
+		t.Fatalf("Create(...): problem setting up the test client %s", err)
+	}
+	e := &bucketExternal{client: mClient, logger: logging.NewNopLogger()}
+
+	params := forBucketProvider()
+	params.LocationConstraint = "us-south-smart"
+	mg := createCrossplaneBucket(withBucketForProvider(params))
+	cre, err := e.Create(context.Background(), mg)
+	violation := ibmc.CheckGuardrails(pc, ibmc.GuardedValues{Regions: []string{"us-south"}})
+	if diff := cmp.Diff(errors.Wrap(violation, errCreateBucket).Error(), err.Error()); diff != "" {
+		t.Errorf("Create(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(managed.ExternalCreation{}, cre); diff != "" {
+		t.Errorf("Create(...): -want, +got:\n%s", diff)
+	}
+	want := createCrossplaneBucket(withBucketForProvider(params), withConditions(ibmc.NonCompliant(violation)))
+	if diff := cmp.Diff(want, mg); diff != "" {
+		t.Errorf("Create(...): -want, +got:\n%s", diff)
+	}
+}
+
 func TestBucketDelete(t *testing.T) {
 	type want struct {
 		mg  resource.Managed
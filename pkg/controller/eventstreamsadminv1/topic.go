@@ -56,19 +56,20 @@ func SetupTopic(mgr ctrl.Manager, l logging.Logger) error {
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.TopicGroupVersionKind),
-		managed.WithExternalConnecter(&topicConnector{
+		managed.WithExternalConnecter(ibmc.NewAuditConnecter(&topicConnector{
 			kube:     mgr.GetClient(),
 			usage:    resource.NewProviderConfigUsageTracker(mgr.GetClient(), &v1beta1.ProviderConfigUsage{}),
 			clientFn: ibmc.NewClient,
-			logger:   log}),
-		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			logger:   log}, event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()),
+			ibmc.NewExpiration(mgr.GetClient(), event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
 		managed.WithLogger(log),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		For(&v1alpha1.Topic{}).
-		Complete(r)
+		Complete(ibmc.NewExpirationReconciler(mgr, resource.ManagedKind(v1alpha1.TopicGroupVersionKind), r))
 }
 
 // A topicConnector is expected to produce an ExternalClient when its Connect method
@@ -130,6 +131,10 @@ func (c *topicExternal) Observe(ctx context.Context, mg resource.Managed) (manag
 	if err = ibmct.LateInitializeSpec(&cr.Spec.ForProvider, instance); err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
 	}
+	lateInitSpec := cr.Spec.ForProvider.DeepCopy()
+	if err = ibmc.RestrictLateInitialization(cr, currentSpec, &cr.Spec.ForProvider); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
+	}
 	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
 		if err := c.kube.Update(ctx, cr); err != nil {
 			return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
@@ -151,7 +156,7 @@ func (c *topicExternal) Observe(ctx context.Context, mg resource.Managed) (manag
 	}
 	cr.Status.SetConditions(runtimev1alpha1.Available())
 
-	upToDate, err := ibmct.IsUpToDate(&cr.Spec.ForProvider, instance, c.logger)
+	upToDate, err := ibmct.IsUpToDate(lateInitSpec, instance, c.logger)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrCheckUpToDate)
 	}
@@ -62,12 +62,13 @@ func SetupAccessGroup(mgr ctrl.Manager, l logging.Logger) error {
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.AccessGroupGroupVersionKind),
-		managed.WithExternalConnecter(&agConnector{
+		managed.WithExternalConnecter(ibmc.NewAuditConnecter(&agConnector{
 			kube:     mgr.GetClient(),
 			usage:    resource.NewProviderConfigUsageTracker(mgr.GetClient(), &v1beta1.ProviderConfigUsage{}),
 			clientFn: ibmc.NewClient,
-			logger:   log}),
-		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			logger:   log}, event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()),
+			ibmc.NewExpiration(mgr.GetClient(), event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
 		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 		managed.WithLogger(log),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
@@ -75,7 +76,7 @@ func SetupAccessGroup(mgr ctrl.Manager, l logging.Logger) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		For(&v1alpha1.AccessGroup{}).
-		Complete(r)
+		Complete(ibmc.NewExpirationReconciler(mgr, resource.ManagedKind(v1alpha1.AccessGroupGroupVersionKind), r))
 }
 
 // A agConnector is expected to produce an ExternalClient when its Connect method
@@ -132,6 +133,10 @@ func (c *agExternal) Observe(ctx context.Context, mg resource.Managed) (managed.
 	if err = ibmcag.LateInitializeSpec(&cr.Spec.ForProvider, instance); err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errManagedUpdateFailed)
 	}
+	lateInitSpec := cr.Spec.ForProvider.DeepCopy()
+	if err = ibmc.RestrictLateInitialization(cr, currentSpec, &cr.Spec.ForProvider); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
+	}
 	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
 		if err := c.kube.Update(ctx, cr); err != nil {
 			return managed.ExternalObservation{}, errors.Wrap(err, errManagedUpdateFailed)
@@ -146,7 +151,7 @@ func (c *agExternal) Observe(ctx context.Context, mg resource.Managed) (managed.
 	cr.Status.SetConditions(cpv1alpha1.Available())
 	cr.Status.AtProvider.State = ibmcag.StateActive
 
-	upToDate, err := ibmcag.IsUpToDate(&cr.Spec.ForProvider, instance, c.logger)
+	upToDate, err := ibmcag.IsUpToDate(lateInitSpec, instance, c.logger)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errCheckUpToDate)
 	}
@@ -57,12 +57,13 @@ func SetupAccessGroupRule(mgr ctrl.Manager, l logging.Logger) error {
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.AccessGroupRuleGroupVersionKind),
-		managed.WithExternalConnecter(&agrConnector{
+		managed.WithExternalConnecter(ibmc.NewAuditConnecter(&agrConnector{
 			kube:     mgr.GetClient(),
 			usage:    resource.NewProviderConfigUsageTracker(mgr.GetClient(), &v1beta1.ProviderConfigUsage{}),
 			clientFn: ibmc.NewClient,
-			logger:   log}),
-		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			logger:   log}, event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()),
+			ibmc.NewExpiration(mgr.GetClient(), event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
 		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 		managed.WithLogger(log),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
@@ -70,7 +71,7 @@ func SetupAccessGroupRule(mgr ctrl.Manager, l logging.Logger) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		For(&v1alpha1.AccessGroupRule{}).
-		Complete(r)
+		Complete(ibmc.NewExpirationReconciler(mgr, resource.ManagedKind(v1alpha1.AccessGroupRuleGroupVersionKind), r))
 }
 
 // A agrConnector is expected to produce an ExternalClient when its Connect method
@@ -130,6 +131,10 @@ func (c *agrExternal) Observe(ctx context.Context, mg resource.Managed) (managed
 	if err = ibmcagr.LateInitializeSpec(&cr.Spec.ForProvider, instance); err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errManagedUpdateFailed)
 	}
+	lateInitSpec := cr.Spec.ForProvider.DeepCopy()
+	if err = ibmc.RestrictLateInitialization(cr, currentSpec, &cr.Spec.ForProvider); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
+	}
 	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
 		if err := c.kube.Update(ctx, cr); err != nil {
 			return managed.ExternalObservation{}, errors.Wrap(err, errManagedUpdateFailed)
@@ -144,7 +149,7 @@ func (c *agrExternal) Observe(ctx context.Context, mg resource.Managed) (managed
 	cr.Status.SetConditions(cpv1alpha1.Available())
 	cr.Status.AtProvider.State = ibmcagr.StateActive
 
-	upToDate, err := ibmcagr.IsUpToDate(&cr.Spec.ForProvider, instance, c.logger)
+	upToDate, err := ibmcagr.IsUpToDate(lateInitSpec, instance, c.logger)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errCheckUpToDate)
 	}
@@ -57,12 +57,13 @@ func SetupGroupMembership(mgr ctrl.Manager, l logging.Logger) error {
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.GroupMembershipGroupVersionKind),
-		managed.WithExternalConnecter(&gmConnector{
+		managed.WithExternalConnecter(ibmc.NewAuditConnecter(&gmConnector{
 			kube:     mgr.GetClient(),
 			usage:    resource.NewProviderConfigUsageTracker(mgr.GetClient(), &v1beta1.ProviderConfigUsage{}),
 			clientFn: ibmc.NewClient,
-			logger:   log}),
-		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			logger:   log}, event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()),
+			ibmc.NewExpiration(mgr.GetClient(), event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
 		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 		managed.WithLogger(log),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
@@ -70,7 +71,7 @@ func SetupGroupMembership(mgr ctrl.Manager, l logging.Logger) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		For(&v1alpha1.GroupMembership{}).
-		Complete(r)
+		Complete(ibmc.NewExpirationReconciler(mgr, resource.ManagedKind(v1alpha1.GroupMembershipGroupVersionKind), r))
 }
 
 // A gmConnector is expected to produce an ExternalClient when its Connect method
@@ -123,7 +124,18 @@ func (c *gmExternal) Observe(ctx context.Context, mg resource.Managed) (managed.
 	}
 	ibmc.SetEtagAnnotation(cr, ibmc.GetEtag(resp.Headers))
 
-	if len(instance.Members) == 0 {
+	managedMembers, err := ibmcgm.GetManagedMembers(cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	exclusive := ibmcgm.IsExclusive(cr.Spec.ForProvider)
+	if exclusive && len(instance.Members) == 0 {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+	// the access group of a non exclusive membership outlives it; the membership is gone once its members are
+	if !exclusive && meta.WasDeleted(cr) && !ibmcgm.HasManagedMembers(instance, managedMembers) {
 		return managed.ExternalObservation{
 			ResourceExists: false,
 		}, nil
@@ -133,23 +145,32 @@ func (c *gmExternal) Observe(ctx context.Context, mg resource.Managed) (managed.
 	if err = ibmcgm.LateInitializeSpec(&cr.Spec.ForProvider, instance); err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errManagedUpdateFailed)
 	}
+	lateInitSpec := cr.Spec.ForProvider.DeepCopy()
+	if err = ibmc.RestrictLateInitialization(cr, currentSpec, &cr.Spec.ForProvider); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
+	}
 	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
 		if err := c.kube.Update(ctx, cr); err != nil {
 			return managed.ExternalObservation{}, errors.Wrap(err, errManagedUpdateFailed)
 		}
 	}
 
+	memberResults := cr.Status.AtProvider.MemberResults
 	cr.Status.AtProvider, err = ibmcgm.GenerateObservation(instance)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errGenObservation)
 	}
+	cr.Status.AtProvider.ManagedMembers, cr.Status.AtProvider.MemberResults = managedMembers, memberResults
 
 	cr.Status.SetConditions(cpv1alpha1.Available())
 	cr.Status.AtProvider.State = ibmcgm.StateActive
 
-	upToDate, err := ibmcgm.IsUpToDate(&cr.Spec.ForProvider, instance, c.logger)
-	if err != nil {
-		return managed.ExternalObservation{}, errors.Wrap(err, errCheckUpToDate)
+	upToDate := ibmcgm.IsManagedMembersUpToDate(lateInitSpec, cr.Status.AtProvider)
+	if exclusive {
+		upToDate, err = ibmcgm.IsUpToDate(lateInitSpec, instance, c.logger)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errCheckUpToDate)
+		}
 	}
 
 	return managed.ExternalObservation{
@@ -166,6 +187,12 @@ func (c *gmExternal) Create(ctx context.Context, mg resource.Managed) (managed.E
 	}
 
 	cr.SetConditions(cpv1alpha1.Creating())
+	if !ibmcgm.IsExclusive(cr.Spec.ForProvider) {
+		// the members are added by Update, which tracks them in an annotation (see ibmcgm.AnnotationManagedMembers)
+		meta.SetExternalName(cr, reference.FromPtrValue(cr.Spec.ForProvider.AccessGroupID))
+		return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+	}
+
 	createOptions := &iamagv2.AddMembersToAccessGroupOptions{}
 	if err := ibmcgm.GenerateCreateGroupMembershipOptions(cr.Spec.ForProvider, createOptions); err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreateGroupMembershipOpts)
@@ -186,14 +213,37 @@ func (c *gmExternal) Update(ctx context.Context, mg resource.Managed) (managed.E
 		return managed.ExternalUpdate{}, errors.New(errNotGroupMembership)
 	}
 
-	err := ibmcgm.UpdateAccessGroupMembers(c.client, *cr)
+	if ibmcgm.IsExclusive(cr.Spec.ForProvider) {
+		if err := ibmcgm.UpdateAccessGroupMembers(c.client, cr); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdGroupMembership)
+		}
+		return managed.ExternalUpdate{}, nil
+	}
+
+	// the members added are saved even if some of the changes failed, so that they are removed on deletion
+	before := cr.GetAnnotations()[ibmcgm.AnnotationManagedMembers]
+	err := ibmcgm.UpdateManagedAccessGroupMembers(c.client, cr)
+	if cr.GetAnnotations()[ibmcgm.AnnotationManagedMembers] != before {
+		if uerr := c.updateKeepingStatus(ctx, cr); uerr != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(uerr, errManagedUpdateFailed)
+		}
+	}
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdGroupMembership)
 	}
-
 	return managed.ExternalUpdate{}, nil
 }
 
+// updateKeepingStatus updates the GroupMembership custom resource, without losing its status
+func (c *gmExternal) updateKeepingStatus(ctx context.Context, cr *v1alpha1.GroupMembership) error {
+	status := cr.Status.DeepCopy()
+	if err := c.kube.Update(ctx, cr); err != nil {
+		return err
+	}
+	cr.Status = *status
+	return nil
+}
+
 func (c *gmExternal) Delete(ctx context.Context, mg resource.Managed) error {
 	cr, ok := mg.(*v1alpha1.GroupMembership)
 	if !ok {
@@ -202,9 +252,22 @@ func (c *gmExternal) Delete(ctx context.Context, mg resource.Managed) error {
 
 	cr.SetConditions(cpv1alpha1.Deleting())
 
+	members := ibmcgm.GenerateSDKRemoveroupMembersRequestMembersItems(cr.Spec.ForProvider.Members)
+	if !ibmcgm.IsExclusive(cr.Spec.ForProvider) {
+		// only the members added by this resource are removed
+		managedMembers, err := ibmcgm.GetManagedMembers(cr)
+		if err != nil {
+			return errors.Wrap(err, errDeleteGroupMembership)
+		}
+		members = managedMembers
+		if len(members) == 0 {
+			return nil
+		}
+	}
+
 	_, _, err := c.client.IamAccessGroupsV2().RemoveMembersFromAccessGroup(&iamagv2.RemoveMembersFromAccessGroupOptions{
 		AccessGroupID: reference.ToPtrValue(meta.GetExternalName(cr)),
-		Members:       ibmcgm.GenerateSDKRemoveroupMembersRequestMembersItems(cr.Spec.ForProvider.Members),
+		Members:       members,
 	})
 	if err != nil {
 		return errors.Wrap(resource.Ignore(ibmc.IsResourceGone, err), errDeleteGroupMembership)
@@ -29,6 +29,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/klog"
 
 	cpv1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
@@ -105,6 +106,12 @@ func gmWithEtagAnnotation(eTag string) gmModifier {
 	}
 }
 
+func gmWithManagedMembersAnnotation(members string) gmModifier {
+	return func(i *v1alpha1.GroupMembership) {
+		meta.AddAnnotations(i, map[string]string{ibmcgm.AnnotationManagedMembers: members})
+	}
+}
+
 func gmWithSpec(p v1alpha1.GroupMembershipParameters) gmModifier {
 	return func(r *v1alpha1.GroupMembership) { r.Spec.ForProvider = p }
 }
@@ -138,6 +145,11 @@ func gmParams(m ...func(*v1alpha1.GroupMembershipParameters)) *v1alpha1.GroupMem
 	return p
 }
 
+func gmNotExclusive(gmp *v1alpha1.GroupMembershipParameters) {
+	exclusive := false
+	gmp.Exclusive = &exclusive
+}
+
 func gmObservation(m ...func(*v1alpha1.GroupMembershipObservation)) *v1alpha1.GroupMembershipObservation {
 	o := &v1alpha1.GroupMembershipObservation{
 		Members: []v1alpha1.ListGroupMembersResponseMember{
@@ -404,6 +416,95 @@ func TestGroupMembershipObserve(t *testing.T) {
 				},
 			},
 		},
+		"NotExclusiveUpToDate": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.Header().Set("ETag", eTag)
+						err := json.NewEncoder(w).Encode(gmInstance())
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: tstutil.Args{
+				Managed: gm(
+					gmWithExternalNameAnnotation(agID),
+					gmWithSpec(*gmParams(gmNotExclusive, func(gmp *v1alpha1.GroupMembershipParameters) {
+						gmp.Members = gmp.Members[:1]
+					})),
+					gmWithStatus(v1alpha1.GroupMembershipObservation{ManagedMembers: []string{memberIamID1}}),
+				),
+			},
+			want: want{
+				mg: gm(gmWithSpec(*gmParams(gmNotExclusive, func(gmp *v1alpha1.GroupMembershipParameters) {
+					gmp.Members = gmp.Members[:1]
+				})),
+					gmWithConditions(cpv1alpha1.Available()),
+					gmWithStatus(*gmObservation(func(cro *v1alpha1.GroupMembershipObservation) {
+						cro.State = ibmcgm.StateActive
+						cro.ManagedMembers = []string{memberIamID1}
+					})),
+					gmWithEtagAnnotation(eTag)),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: nil,
+				},
+			},
+		},
+		"NotExclusiveEmptyGroup": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.Header().Set("ETag", eTag)
+						err := json.NewEncoder(w).Encode(&iamagv2.GroupMembersList{})
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: tstutil.Args{
+				Managed: gm(
+					gmWithExternalNameAnnotation(agID),
+					gmWithSpec(*gmParams(gmNotExclusive)),
+				),
+			},
+			want: want{
+				mg: gm(gmWithSpec(*gmParams(gmNotExclusive)),
+					gmWithConditions(cpv1alpha1.Available()),
+					gmWithStatus(v1alpha1.GroupMembershipObservation{
+						Members: []v1alpha1.ListGroupMembersResponseMember{},
+						State:   ibmcgm.StateActive,
+					}),
+					gmWithEtagAnnotation(eTag)),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: nil,
+				},
+			},
+		},
 		"NotUpToDate": {
 			handlers: []tstutil.Handler{
 				{
@@ -523,6 +624,27 @@ func TestGroupMembershipCreate(t *testing.T) {
 				err: nil,
 			},
 		},
+		"NotExclusive": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						t.Errorf("r: unexpected %s request for a non exclusive group membership", r.Method)
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: gm(gmWithSpec(*gmParams(gmNotExclusive))),
+			},
+			want: want{
+				mg: gm(gmWithSpec(*gmParams(gmNotExclusive)),
+					gmWithConditions(cpv1alpha1.Creating()),
+					gmWithExternalNameAnnotation(agID)),
+				cre: managed.ExternalCreation{ExternalNameAssigned: true},
+				err: nil,
+			},
+		},
 		"BadRequest": {
 			handlers: []tstutil.Handler{
 				{
@@ -679,6 +801,60 @@ func TestGroupMembershipDelete(t *testing.T) {
 				err: nil,
 			},
 		},
+		"NotExclusiveRemovesManagedMembersOnly": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						body := map[string][]string{}
+						if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+							t.Errorf("r: cannot decode request body: %s", err)
+						}
+						_ = r.Body.Close()
+						if diff := cmp.Diff([]string{memberIamID1}, body["members"]); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						err := json.NewEncoder(w).Encode(iamagv2.DeleteGroupBulkMembersResponse{})
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: gm(gmWithSpec(*gmParams(gmNotExclusive)),
+					gmWithStatus(v1alpha1.GroupMembershipObservation{ManagedMembers: []string{memberIamID1}}),
+					gmWithExternalNameAnnotation(accessGroupID)),
+			},
+			want: want{
+				mg: gm(gmWithSpec(*gmParams(gmNotExclusive)),
+					gmWithStatus(v1alpha1.GroupMembershipObservation{ManagedMembers: []string{memberIamID1}}),
+					gmWithConditions(cpv1alpha1.Deleting())),
+				err: nil,
+			},
+		},
+		"NotExclusiveNoManagedMembers": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						t.Errorf("r: unexpected %s request for a group membership without managed members", r.Method)
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: gm(gmWithSpec(*gmParams(gmNotExclusive)), gmWithExternalNameAnnotation(accessGroupID)),
+			},
+			want: want{
+				mg:  gm(gmWithSpec(*gmParams(gmNotExclusive)), gmWithConditions(cpv1alpha1.Deleting())),
+				err: nil,
+			},
+		},
 		"BadRequest": {
 			handlers: []tstutil.Handler{
 				{
@@ -774,6 +950,118 @@ func TestGroupMembershipDelete(t *testing.T) {
 	}
 }
 
+func TestGroupMembershipNotExclusiveDeleteThenObserve(t *testing.T) {
+	handlers := []tstutil.Handler{{Path: "/", HandlerFunc: iamMembersHandler}}
+	var kube client.Client = &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)}
+	e, server, errServer := setupServerAndGetUnitTestExternalGM(t, &handlers, &kube)
+	if errServer != nil {
+		t.Errorf("Delete(...): problem setting up the test server %s", errServer)
+	}
+
+	defer server.Close()
+
+	membersCache = map[string]iamagv2.ListGroupMembersResponseMember{
+		memberIamID1: {
+			IamID: &memberIamID1,
+			Type:  reference.ToPtrValue(ibmcgm.MemberTypeUser),
+		},
+		memberIamID2: {
+			IamID: &memberIamID2,
+			Type:  reference.ToPtrValue(ibmcgm.MemberTypeUser),
+		},
+	}
+
+	cr := gm(gmWithSpec(*gmParams(gmNotExclusive)),
+		gmWithStatus(v1alpha1.GroupMembershipObservation{ManagedMembers: []string{memberIamID1}}),
+		gmWithExternalNameAnnotation(accessGroupID))
+	deletedAt := metav1.Unix(1, 0)
+	cr.SetDeletionTimestamp(&deletedAt)
+
+	obs, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Errorf("Observe(...): unexpected error: %s", err)
+	}
+	if !obs.ResourceExists {
+		t.Error("Observe(...): want the membership to exist while its members are in the group")
+	}
+
+	if err := e.Delete(context.Background(), cr); err != nil {
+		t.Errorf("Delete(...): unexpected error: %s", err)
+	}
+
+	obs, err = e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Errorf("Observe(...): unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(managed.ExternalObservation{ResourceExists: false}, obs); diff != "" {
+		t.Errorf("Observe(...): -want, +got:\n%s", diff)
+	}
+	if _, ok := membersCache[memberIamID2]; !ok {
+		t.Error("Delete(...): want the members not added by the membership to be kept")
+	}
+}
+
+func TestGroupMembershipNotExclusiveStatusLost(t *testing.T) {
+	handlers := []tstutil.Handler{{Path: "/", HandlerFunc: iamMembersHandler}}
+	saved := map[string]string{}
+	var kube client.Client = &test.MockClient{MockUpdate: func(_ context.Context, obj runtime.Object, _ ...client.UpdateOption) error {
+		saved = obj.(*v1alpha1.GroupMembership).GetAnnotations()
+		return nil
+	}}
+	e, server, errServer := setupServerAndGetUnitTestExternalGM(t, &handlers, &kube)
+	if errServer != nil {
+		t.Errorf("Update(...): problem setting up the test server %s", errServer)
+	}
+
+	defer server.Close()
+
+	membersCache = map[string]iamagv2.ListGroupMembersResponseMember{
+		memberIamID1: {
+			IamID: &memberIamID1,
+			Type:  reference.ToPtrValue(ibmcgm.MemberTypeUser),
+		},
+		memberIamID2: {
+			IamID: &memberIamID2,
+			Type:  reference.ToPtrValue(ibmcgm.MemberTypeUser),
+		},
+	}
+
+	cr := gm(gmWithSpec(*gmParams(gmNotExclusive, func(gmp *v1alpha1.GroupMembershipParameters) {
+		gmp.Members = []v1alpha1.AddGroupMembersRequestMembersItem{
+			{IamID: memberIamID1, Type: ibmcgm.MemberTypeUser},
+			{IamID: memberIamID3, Type: ibmcgm.MemberTypeUser},
+		}
+	})), gmWithStatus(*gmObservation()), gmWithExternalNameAnnotation(accessGroupID))
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Errorf("Update(...): unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(`["`+memberIamID3+`"]`, saved[ibmcgm.AnnotationManagedMembers]); diff != "" {
+		t.Errorf("Update(...): -want saved managed members, +got:\n%s", diff)
+	}
+
+	// the status written after the update is lost, then the membership is deleted
+	cr.Status = v1alpha1.GroupMembershipStatus{}
+	deletedAt := metav1.Unix(1, 0)
+	cr.SetDeletionTimestamp(&deletedAt)
+
+	obs, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Errorf("Observe(...): unexpected error: %s", err)
+	}
+	if !obs.ResourceExists {
+		t.Error("Observe(...): want the membership to exist while the members it added are in the group")
+	}
+	if err := e.Delete(context.Background(), cr); err != nil {
+		t.Errorf("Delete(...): unexpected error: %s", err)
+	}
+	if _, ok := membersCache[memberIamID3]; ok {
+		t.Error("Delete(...): want the member added by the membership to be removed")
+	}
+	if _, ok := membersCache[memberIamID1]; !ok {
+		t.Error("Delete(...): want the members not added by the membership to be kept")
+	}
+}
+
 func TestGroupMembershipUpdate(t *testing.T) {
 	type want struct {
 		mg  resource.Managed
@@ -809,7 +1097,76 @@ func TestGroupMembershipUpdate(t *testing.T) {
 						IamID: memberIamID3,
 						Type:  ibmcgm.MemberTypeUser,
 					})
-				})), gmWithStatus(*gmObservation()), gmWithEtagAnnotation(eTag)),
+				})), gmWithStatus(*gmObservation(func(o *v1alpha1.GroupMembershipObservation) {
+					o.MemberResults = []v1alpha1.MemberResult{
+						{IamID: memberIamID3, Operation: ibmcgm.OperationAdd, StatusCode: http.StatusOK},
+					}
+				})), gmWithEtagAnnotation(eTag)),
+				upd: managed.ExternalUpdate{},
+				err: nil,
+			},
+		},
+		"NotExclusiveKeepsOtherMembers": {
+			handlers: []tstutil.Handler{
+				{
+					Path:        "/",
+					HandlerFunc: iamMembersHandler,
+				},
+			},
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: tstutil.Args{
+				Managed: gm(gmWithSpec(*gmParams(gmNotExclusive, func(gmp *v1alpha1.GroupMembershipParameters) {
+					gmp.Members = []v1alpha1.AddGroupMembersRequestMembersItem{
+						{IamID: memberIamID1, Type: ibmcgm.MemberTypeUser},
+						{IamID: memberIamID3, Type: ibmcgm.MemberTypeUser},
+					}
+				})), gmWithStatus(*gmObservation(func(o *v1alpha1.GroupMembershipObservation) {
+					o.ManagedMembers = []string{memberIamID1}
+				}))),
+			},
+			want: want{
+				mg: gm(gmWithSpec(*gmParams(gmNotExclusive, func(gmp *v1alpha1.GroupMembershipParameters) {
+					gmp.Members = []v1alpha1.AddGroupMembersRequestMembersItem{
+						{IamID: memberIamID1, Type: ibmcgm.MemberTypeUser},
+						{IamID: memberIamID3, Type: ibmcgm.MemberTypeUser},
+					}
+				})), gmWithStatus(*gmObservation(func(o *v1alpha1.GroupMembershipObservation) {
+					o.ManagedMembers = []string{memberIamID1, memberIamID3}
+					o.MemberResults = []v1alpha1.MemberResult{
+						{IamID: memberIamID3, Operation: ibmcgm.OperationAdd, StatusCode: http.StatusOK},
+					}
+				})), gmWithManagedMembersAnnotation(`["`+memberIamID1+`","`+memberIamID3+`"]`)),
+				upd: managed.ExternalUpdate{},
+				err: nil,
+			},
+		},
+		"NotExclusiveRemovesManagedMembers": {
+			handlers: []tstutil.Handler{
+				{
+					Path:        "/",
+					HandlerFunc: iamMembersHandler,
+				},
+			},
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: tstutil.Args{
+				Managed: gm(gmWithSpec(*gmParams(gmNotExclusive, func(gmp *v1alpha1.GroupMembershipParameters) {
+					gmp.Members = []v1alpha1.AddGroupMembersRequestMembersItem{}
+				})), gmWithStatus(*gmObservation(func(o *v1alpha1.GroupMembershipObservation) {
+					o.ManagedMembers = []string{memberIamID1, memberIamID3}
+				}))),
+			},
+			want: want{
+				mg: gm(gmWithSpec(*gmParams(gmNotExclusive, func(gmp *v1alpha1.GroupMembershipParameters) {
+					gmp.Members = []v1alpha1.AddGroupMembersRequestMembersItem{}
+				})), gmWithStatus(*gmObservation(func(o *v1alpha1.GroupMembershipObservation) {
+					o.MemberResults = []v1alpha1.MemberResult{
+						{IamID: memberIamID1, Operation: ibmcgm.OperationRemove, StatusCode: http.StatusOK},
+					}
+				})), gmWithManagedMembersAnnotation("[]")),
 				upd: managed.ExternalUpdate{},
 				err: nil,
 			},
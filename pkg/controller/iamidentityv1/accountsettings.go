@@ -0,0 +1,211 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iamidentityv1
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cpv1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	iamidv1 "github.com/IBM/platform-services-go-sdk/iamidentityv1"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/iamidentityv1/v1alpha1"
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+	ibmcas "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/accountsettings"
+)
+
+const (
+	errNotAccountSettings        = "managed resource is not an AccountSettings custom resource"
+	errGetAccountSettingsFailed  = "error getting account settings"
+	errUpdAccountSettings        = "error updating account settings"
+	errAccountSettingsNoAccount  = "the account ID of the account settings is not set"
+	errAccountSettingsUpdateFail = "cannot update AccountSettings custom resource"
+)
+
+// SetupAccountSettings adds a controller that reconciles AccountSettings managed resources.
+func SetupAccountSettings(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.AccountSettingsGroupKind)
+	log := l.WithValues("AccountSettings-controller", name)
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.AccountSettingsGroupVersionKind),
+		managed.WithExternalConnecter(ibmc.NewAuditConnecter(&asConnector{
+			kube:     mgr.GetClient(),
+			usage:    resource.NewProviderConfigUsageTracker(mgr.GetClient(), &v1beta1.ProviderConfigUsage{}),
+			clientFn: ibmc.NewClient,
+			logger:   log}, event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()),
+			ibmc.NewExpiration(mgr.GetClient(), event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(log),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.AccountSettings{}).
+		Complete(ibmc.NewExpirationReconciler(mgr, resource.ManagedKind(v1alpha1.AccountSettingsGroupVersionKind), r))
+}
+
+// An asConnector is expected to produce an ExternalClient when its Connect method
+// is called.
+type asConnector struct {
+	kube     client.Client
+	usage    resource.Tracker
+	clientFn func(optd ibmc.ClientOptions) (ibmc.ClientSession, error)
+	logger   logging.Logger
+}
+
+// Connect produces an ExternalClient for IBM Cloud API
+func (c *asConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	opts, err := ibmc.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetAuth)
+	}
+
+	service, err := c.clientFn(opts)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &asExternal{client: service, kube: c.kube, logger: c.logger}, nil
+}
+
+// An asExternal observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type asExternal struct {
+	client ibmc.ClientSession
+	kube   client.Client
+	logger logging.Logger
+}
+
+func (c *asExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.AccountSettings)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotAccountSettings)
+	}
+
+	// the settings of an account cannot be deleted, so they are no longer considered once the resource is deleted
+	if meta.GetExternalName(cr) == "" || meta.WasDeleted(cr) {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	instance, _, err := c.client.IamIdentityV1().GetAccountSettings(&iamidv1.GetAccountSettingsOptions{AccountID: reference.ToPtrValue(meta.GetExternalName(cr))})
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ibmc.IsResourceNotFound, err), errGetAccountSettingsFailed)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	if err = ibmcas.LateInitializeSpec(&cr.Spec.ForProvider, instance); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errAccountSettingsUpdateFail)
+	}
+	lateInitSpec := cr.Spec.ForProvider.DeepCopy()
+	if err = ibmc.RestrictLateInitialization(cr, currentSpec, &cr.Spec.ForProvider); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
+	}
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := c.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errAccountSettingsUpdateFail)
+		}
+	}
+
+	cr.Status.AtProvider, err = ibmcas.GenerateObservation(instance)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGenObservation)
+	}
+
+	cr.Status.SetConditions(cpv1alpha1.Available())
+	cr.Status.AtProvider.State = ibmcas.StateActive
+
+	upToDate, err := ibmcas.IsUpToDate(lateInitSpec, instance, c.logger)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCheckUpToDate)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: nil,
+	}, nil
+}
+
+func (c *asExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.AccountSettings)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotAccountSettings)
+	}
+
+	cr.SetConditions(cpv1alpha1.Creating())
+	if cr.Spec.ForProvider.AccountID == "" {
+		return managed.ExternalCreation{}, errors.New(errAccountSettingsNoAccount)
+	}
+
+	// an account has a single set of settings, so it is managed by a single resource. This check is best-effort, as
+	// two resources of the same account created together can both pass it: the account settings webhook (see
+	// pkg/webhook) denies the second resource before it is stored
+	if err := ibmcas.CheckNotManaged(ctx, c.kube, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	// the settings of an account always exist: they are bound to the resource here, then Observe late initializes
+	// them and Update applies the desired ones (with the entity tag of the settings)
+	meta.SetExternalName(cr, cr.Spec.ForProvider.AccountID)
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (c *asExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.AccountSettings)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAccountSettings)
+	}
+
+	updOpts := &iamidv1.UpdateAccountSettingsOptions{}
+	if err := ibmcas.GenerateUpdateAccountSettingsOptions(meta.GetExternalName(cr), cr.Status.AtProvider.EntityTag, cr.Spec.ForProvider, updOpts); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdAccountSettings)
+	}
+
+	if _, _, err := c.client.IamIdentityV1().UpdateAccountSettings(updOpts); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdAccountSettings)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *asExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.AccountSettings)
+	if !ok {
+		return errors.New(errNotAccountSettings)
+	}
+
+	// the settings of an account cannot be deleted, they are left as they are (Observe no longer finds them)
+	cr.SetConditions(cpv1alpha1.Deleting())
+	return nil
+}
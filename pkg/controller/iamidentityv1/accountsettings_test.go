@@ -0,0 +1,582 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iamidentityv1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
+
+	cpv1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	iamidv1 "github.com/IBM/platform-services-go-sdk/iamidentityv1"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/iamidentityv1/v1alpha1"
+	ibmcas "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/accountsettings"
+	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/controller/tstutil"
+)
+
+const (
+	errAsBadRequest = "error getting account settings: Bad Request"
+	errAsForbidden  = "error getting account settings: Forbidden"
+)
+
+var (
+	asName              = "account-settings"
+	asRestricted        = "RESTRICTED"
+	asAllowedIPs        = "192.168.0.0/16"
+	asMfa               = "TOTP4ALL"
+	asMfaNone           = "NONE"
+	asSessionExpiration = "3600"
+	asNotSet            = "NOT_SET"
+)
+
+var _ managed.ExternalConnecter = &asConnector{}
+var _ managed.ExternalClient = &asExternal{}
+
+type asModifier func(*v1alpha1.AccountSettings)
+
+func as(im ...asModifier) *v1alpha1.AccountSettings {
+	i := &v1alpha1.AccountSettings{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       asName,
+			Finalizers: []string{},
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: accountID,
+			},
+		},
+		Spec: v1alpha1.AccountSettingsSpec{
+			ForProvider: v1alpha1.AccountSettingsParameters{},
+		},
+	}
+	for _, m := range im {
+		m(i)
+	}
+	return i
+}
+
+func asWithExternalNameAnnotation(externalName string) asModifier {
+	return func(i *v1alpha1.AccountSettings) {
+		if i.ObjectMeta.Annotations == nil {
+			i.ObjectMeta.Annotations = make(map[string]string)
+		}
+		i.ObjectMeta.Annotations[meta.AnnotationKeyExternalName] = externalName
+	}
+}
+
+func asWithDeletionTimestamp() asModifier {
+	return func(i *v1alpha1.AccountSettings) {
+		t := metav1.Unix(0, 0)
+		i.ObjectMeta.DeletionTimestamp = &t
+	}
+}
+
+func asWithSpec(p v1alpha1.AccountSettingsParameters) asModifier {
+	return func(r *v1alpha1.AccountSettings) { r.Spec.ForProvider = p }
+}
+
+func asWithConditions(c ...cpv1alpha1.Condition) asModifier {
+	return func(i *v1alpha1.AccountSettings) { i.Status.SetConditions(c...) }
+}
+
+func asWithStatus(p v1alpha1.AccountSettingsObservation) asModifier {
+	return func(r *v1alpha1.AccountSettings) { r.Status.AtProvider = p }
+}
+
+func asParams(m ...func(*v1alpha1.AccountSettingsParameters)) *v1alpha1.AccountSettingsParameters {
+	p := &v1alpha1.AccountSettingsParameters{
+		AccountID:                    accountID,
+		RestrictCreateServiceID:      &asRestricted,
+		RestrictCreatePlatformApikey: &asRestricted,
+		AllowedIPAddresses:           &asAllowedIPs,
+		Mfa:                          &asMfa,
+		SessionExpirationInSeconds:   &asSessionExpiration,
+		SessionInvalidationInSeconds: &asNotSet,
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func asInstance(m ...func(*iamidv1.AccountSettingsResponse)) *iamidv1.AccountSettingsResponse {
+	i := &iamidv1.AccountSettingsResponse{
+		AccountID:                    &accountID,
+		RestrictCreateServiceID:      &asRestricted,
+		RestrictCreatePlatformApikey: &asRestricted,
+		AllowedIPAddresses:           &asAllowedIPs,
+		EntityTag:                    &eTag,
+		Mfa:                          &asMfa,
+		SessionExpirationInSeconds:   &asSessionExpiration,
+		SessionInvalidationInSeconds: &asNotSet,
+	}
+	for _, f := range m {
+		f(i)
+	}
+	return i
+}
+
+// Sets up a unit test http server, and creates an external account settings structure appropriate for unit test.
+func setupServerAndGetUnitTestExternalAS(testingObj *testing.T, handlers *[]tstutil.Handler, kube *client.Client) (*asExternal, *httptest.Server, error) {
+	mClient, tstServer, err := tstutil.SetupTestServerClient(testingObj, handlers)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &asExternal{
+			kube:   *kube,
+			client: *mClient,
+			logger: logging.NewNopLogger(),
+		},
+		tstServer,
+		nil
+}
+
+func TestAccountSettingsObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"GetFailed": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusBadRequest)
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: as(),
+			},
+			want: want{
+				mg:  as(),
+				err: errors.New(errAsBadRequest),
+			},
+		},
+		"GetForbidden": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusForbidden)
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: as(),
+			},
+			want: want{
+				mg:  as(),
+				err: errors.New(errAsForbidden),
+			},
+		},
+		"LateInitialized": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						err := json.NewEncoder(w).Encode(asInstance())
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: tstutil.Args{
+				Managed: as(asWithSpec(v1alpha1.AccountSettingsParameters{AccountID: accountID, Mfa: &asMfa})),
+			},
+			want: want{
+				mg: as(asWithSpec(*asParams()),
+					asWithConditions(cpv1alpha1.Available()),
+					asWithStatus(v1alpha1.AccountSettingsObservation{EntityTag: eTag, State: ibmcas.StateActive})),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: nil,
+				},
+			},
+		},
+		"NotUpToDate": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						err := json.NewEncoder(w).Encode(asInstance(func(i *iamidv1.AccountSettingsResponse) {
+							i.Mfa = &asMfaNone
+						}))
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: tstutil.Args{
+				Managed: as(asWithSpec(*asParams())),
+			},
+			want: want{
+				mg: as(asWithSpec(*asParams()),
+					asWithConditions(cpv1alpha1.Available()),
+					asWithStatus(v1alpha1.AccountSettingsObservation{EntityTag: eTag, State: ibmcas.StateActive})),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: nil,
+				},
+			},
+		},
+		"Deleted": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						t.Errorf("r: unexpected %s request for deleted account settings", r.Method)
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: as(asWithSpec(*asParams()), asWithDeletionTimestamp()),
+			},
+			want: want{
+				mg:  as(asWithSpec(*asParams()), asWithDeletionTimestamp()),
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, errCr := setupServerAndGetUnitTestExternalAS(t, &tc.handlers, &tc.kube)
+			if errCr != nil {
+				t.Errorf("Observe(...): problem setting up the test server %s", errCr)
+			}
+
+			defer server.Close()
+
+			obs, err := e.Observe(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				// the case where our mock server returns error.
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Observe(...): want error string != got error string:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Observe(...): want error != got error:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAccountSettingsCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		cre managed.ExternalCreation
+		err error
+	}
+	cases := map[string]struct {
+		kube client.Client
+		args tstutil.Args
+		want want
+	}{
+		"Successful": {
+			kube: &test.MockClient{MockList: test.NewMockListFn(nil, func(obj runtime.Object) error {
+				obj.(*v1alpha1.AccountSettingsList).Items = []v1alpha1.AccountSettings{*as(asWithSpec(*asParams()))}
+				return nil
+			})},
+			args: tstutil.Args{
+				Managed: as(asWithExternalNameAnnotation(""), asWithSpec(*asParams())),
+			},
+			want: want{
+				mg: as(asWithSpec(*asParams()),
+					asWithConditions(cpv1alpha1.Creating()),
+					asWithExternalNameAnnotation(accountID)),
+				cre: managed.ExternalCreation{ExternalNameAssigned: true},
+				err: nil,
+			},
+		},
+		"AlreadyManaged": {
+			kube: &test.MockClient{MockList: test.NewMockListFn(nil, func(obj runtime.Object) error {
+				other := as(asWithSpec(*asParams()))
+				other.SetName("other-settings")
+				obj.(*v1alpha1.AccountSettingsList).Items = []v1alpha1.AccountSettings{*other}
+				return nil
+			})},
+			args: tstutil.Args{
+				Managed: as(asWithExternalNameAnnotation(""), asWithSpec(*asParams())),
+			},
+			want: want{
+				mg: as(asWithSpec(*asParams()),
+					asWithConditions(cpv1alpha1.Creating()),
+					asWithExternalNameAnnotation("")),
+				cre: managed.ExternalCreation{},
+				err: errors.Errorf("the settings of account %s are already managed by AccountSettings other-settings", accountID),
+			},
+		},
+		"ListFailed": {
+			kube: &test.MockClient{MockList: test.NewMockListFn(errors.New("boom"))},
+			args: tstutil.Args{
+				Managed: as(asWithExternalNameAnnotation(""), asWithSpec(*asParams())),
+			},
+			want: want{
+				mg: as(asWithSpec(*asParams()),
+					asWithConditions(cpv1alpha1.Creating()),
+					asWithExternalNameAnnotation("")),
+				cre: managed.ExternalCreation{},
+				err: errors.Wrap(errors.New("boom"), "cannot list AccountSettings custom resources"),
+			},
+		},
+		"NoAccount": {
+			args: tstutil.Args{
+				Managed: as(asWithExternalNameAnnotation("")),
+			},
+			want: want{
+				mg: as(asWithExternalNameAnnotation(""),
+					asWithConditions(cpv1alpha1.Creating())),
+				cre: managed.ExternalCreation{},
+				err: errors.New(errAccountSettingsNoAccount),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			handlers := []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						t.Errorf("r: unexpected %s request when creating account settings", r.Method)
+					},
+				},
+			}
+			e, server, errCr := setupServerAndGetUnitTestExternalAS(t, &handlers, &tc.kube)
+			if errCr != nil {
+				t.Errorf("Create(...): problem setting up the test server %s", errCr)
+			}
+
+			defer server.Close()
+
+			cre, err := e.Create(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Create(...): -want, +got:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Create(...): -want, +got:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.cre, cre); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAccountSettingsDelete(t *testing.T) {
+	handlers := []tstutil.Handler{
+		{
+			Path: "/",
+			HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				t.Errorf("r: unexpected %s request when deleting account settings", r.Method)
+			},
+		},
+	}
+	var kube client.Client
+	e, server, errCr := setupServerAndGetUnitTestExternalAS(t, &handlers, &kube)
+	if errCr != nil {
+		t.Errorf("Delete(...): problem setting up the test server %s", errCr)
+	}
+
+	defer server.Close()
+
+	mg := as(asWithSpec(*asParams()))
+	if err := e.Delete(context.Background(), mg); err != nil {
+		t.Errorf("Delete(...): unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(as(asWithSpec(*asParams()), asWithConditions(cpv1alpha1.Deleting())), mg); diff != "" {
+		t.Errorf("Delete(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestAccountSettingsUpdate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		upd managed.ExternalUpdate
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"Successful": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodPut, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff("/v1/accounts/"+accountID+"/settings/identity", r.URL.Path); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff(eTag, r.Header.Get("If-Match")); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						body := map[string]string{}
+						if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+							t.Errorf("r: cannot decode request body: %s", err)
+						}
+						_ = r.Body.Close()
+						if diff := cmp.Diff(asMfa, body["mfa"]); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusOK)
+						err := json.NewEncoder(w).Encode(asInstance())
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: as(asWithSpec(*asParams()), asWithStatus(v1alpha1.AccountSettingsObservation{EntityTag: eTag})),
+			},
+			want: want{
+				mg:  as(asWithSpec(*asParams()), asWithStatus(v1alpha1.AccountSettingsObservation{EntityTag: eTag})),
+				upd: managed.ExternalUpdate{},
+				err: nil,
+			},
+		},
+		"Conflict": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodPut, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusConflict)
+						_ = r.Body.Close()
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: as(asWithSpec(*asParams()), asWithStatus(v1alpha1.AccountSettingsObservation{EntityTag: eTag})),
+			},
+			want: want{
+				mg:  as(asWithSpec(*asParams()), asWithStatus(v1alpha1.AccountSettingsObservation{EntityTag: eTag})),
+				err: errors.Wrap(errors.New(http.StatusText(http.StatusConflict)), errUpdAccountSettings),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, errCr := setupServerAndGetUnitTestExternalAS(t, &tc.handlers, &tc.kube)
+			if errCr != nil {
+				t.Errorf("Update(...): problem setting up the test server %s", errCr)
+			}
+
+			defer server.Close()
+
+			upd, err := e.Update(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				// the case where our mock server returns error.
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Update(...): -want, +got:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Update(...): -want, +got:\n%s", diff)
+				}
+			}
+			if tc.want.err == nil {
+				if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+					t.Errorf("Update(...): -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(tc.want.upd, upd); diff != "" {
+					t.Errorf("Update(...): -want, +got:\n%s", diff)
+				}
+			}
+		})
+	}
+}
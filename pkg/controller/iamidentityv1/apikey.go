@@ -0,0 +1,246 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iamidentityv1
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cpv1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	iamidv1 "github.com/IBM/platform-services-go-sdk/iamidentityv1"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/iamidentityv1/v1alpha1"
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+	ibmcak "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/apikey"
+)
+
+const (
+	errNotAPIKey        = "managed resource is not a APIKey custom resource"
+	errCreateAPIKey     = "could not create API key"
+	errDeleteAPIKey     = "could not delete API key"
+	errGetAPIKeyFailed  = "error getting API key"
+	errCreateAPIKeyOpts = "error creating API key options"
+	errUpdAPIKey        = "error updating API key"
+	errLockAPIKey       = "could not lock API key"
+	errUnlockAPIKey     = "could not unlock API key"
+)
+
+// SetupAPIKey adds a controller that reconciles APIKey managed resources.
+func SetupAPIKey(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.APIKeyGroupKind)
+	log := l.WithValues("APIKey-controller", name)
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.APIKeyGroupVersionKind),
+		managed.WithExternalConnecter(ibmc.NewAuditConnecter(&akConnector{
+			kube:     mgr.GetClient(),
+			usage:    resource.NewProviderConfigUsageTracker(mgr.GetClient(), &v1beta1.ProviderConfigUsage{}),
+			clientFn: ibmc.NewClient,
+			logger:   log}, event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()),
+			ibmc.NewExpiration(mgr.GetClient(), event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(log),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.APIKey{}).
+		Complete(ibmc.NewExpirationReconciler(mgr, resource.ManagedKind(v1alpha1.APIKeyGroupVersionKind), r))
+}
+
+// An akConnector is expected to produce an ExternalClient when its Connect method
+// is called.
+type akConnector struct {
+	kube     client.Client
+	usage    resource.Tracker
+	clientFn func(optd ibmc.ClientOptions) (ibmc.ClientSession, error)
+	logger   logging.Logger
+}
+
+// Connect produces an ExternalClient for IBM Cloud API
+func (c *akConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	opts, err := ibmc.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetAuth)
+	}
+
+	service, err := c.clientFn(opts)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &akExternal{client: service, kube: c.kube, logger: c.logger}, nil
+}
+
+// An akExternal observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type akExternal struct {
+	client ibmc.ClientSession
+	kube   client.Client
+	logger logging.Logger
+}
+
+func (c *akExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.APIKey)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotAPIKey)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	instance, _, err := c.client.IamIdentityV1().GetAPIKey(&iamidv1.GetAPIKeyOptions{ID: reference.ToPtrValue(meta.GetExternalName(cr))})
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ibmc.IsResourceNotFound, err), errGetAPIKeyFailed)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	if err = ibmcak.LateInitializeSpec(&cr.Spec.ForProvider, instance); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errManagedUpdateFailed)
+	}
+	lateInitSpec := cr.Spec.ForProvider.DeepCopy()
+	if err = ibmc.RestrictLateInitialization(cr, currentSpec, &cr.Spec.ForProvider); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
+	}
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := c.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errManagedUpdateFailed)
+		}
+	}
+
+	cr.Status.AtProvider, err = ibmcak.GenerateObservation(instance)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGenObservation)
+	}
+
+	cr.Status.SetConditions(cpv1alpha1.Available())
+	cr.Status.AtProvider.State = ibmcak.StateActive
+
+	upToDate, err := ibmcak.IsUpToDate(lateInitSpec, instance, c.logger)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCheckUpToDate)
+	}
+
+	// the value of the API key is only returned when it is stored, otherwise the connection details published on
+	// creation are kept
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: ibmcak.GetConnectionDetails(instance),
+	}, nil
+}
+
+func (c *akExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.APIKey)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotAPIKey)
+	}
+
+	cr.SetConditions(cpv1alpha1.Creating())
+	createOptions := &iamidv1.CreateAPIKeyOptions{}
+	if err := ibmcak.GenerateCreateAPIKeyOptions(cr.Spec.ForProvider, createOptions); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateAPIKeyOpts)
+	}
+
+	instance, _, err := c.client.IamIdentityV1().CreateAPIKey(createOptions)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateAPIKey)
+	}
+
+	meta.SetExternalName(cr, reference.FromPtrValue(instance.ID))
+	return managed.ExternalCreation{
+		ExternalNameAssigned: true,
+		ConnectionDetails:    ibmcak.GetConnectionDetails(instance),
+	}, nil
+}
+
+// Update unlocks the API key if it is locked, updates it, and locks it again if it has to be locked
+func (c *akExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.APIKey)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAPIKey)
+	}
+
+	id := cr.Status.AtProvider.ID
+	eTag := cr.Status.AtProvider.EntityTag
+	if cr.Status.AtProvider.Locked {
+		if _, err := c.client.IamIdentityV1().UnlockAPIKey(&iamidv1.UnlockAPIKeyOptions{ID: &id}); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUnlockAPIKey)
+		}
+		// unlocking the API key changes its entity tag
+		instance, _, err := c.client.IamIdentityV1().GetAPIKey(&iamidv1.GetAPIKeyOptions{ID: &id})
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errGetAPIKeyFailed)
+		}
+		eTag = reference.FromPtrValue(instance.EntityTag)
+	}
+
+	updOpts := &iamidv1.UpdateAPIKeyOptions{}
+	if err := ibmcak.GenerateUpdateAPIKeyOptions(id, eTag, cr.Spec.ForProvider, updOpts); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdAPIKey)
+	}
+	if _, _, err := c.client.IamIdentityV1().UpdateAPIKey(updOpts); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdAPIKey)
+	}
+
+	if ibmc.BoolValue(cr.Spec.ForProvider.Locked) {
+		if _, err := c.client.IamIdentityV1().LockAPIKey(&iamidv1.LockAPIKeyOptions{ID: &id}); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errLockAPIKey)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *akExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.APIKey)
+	if !ok {
+		return errors.New(errNotAPIKey)
+	}
+
+	cr.SetConditions(cpv1alpha1.Deleting())
+
+	id := cr.Status.AtProvider.ID
+	if cr.Status.AtProvider.Locked {
+		if _, err := c.client.IamIdentityV1().UnlockAPIKey(&iamidv1.UnlockAPIKeyOptions{ID: &id}); err != nil {
+			return errors.Wrap(resource.Ignore(ibmc.IsResourceGone, err), errUnlockAPIKey)
+		}
+	}
+
+	_, err := c.client.IamIdentityV1().DeleteAPIKey(&iamidv1.DeleteAPIKeyOptions{ID: &id})
+	if err != nil {
+		return errors.Wrap(resource.Ignore(ibmc.IsResourceGone, err), errDeleteAPIKey)
+	}
+	return nil
+}
@@ -0,0 +1,692 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iamidentityv1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	cpv1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	iamidv1 "github.com/IBM/platform-services-go-sdk/iamidentityv1"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/iamidentityv1/v1alpha1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+	ibmcak "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/apikey"
+	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/controller/tstutil"
+)
+
+var (
+	akName        = "myAPIKey"
+	akDescription = "API key for my app"
+	akID          = "ApiKey-12345678-abcd-1a2b-a1b2-1234567890ab"
+	akCrn         = "crn:v1:bluemix:public:iam-identity::a/aa5a00334eaf9eb9339d2ab48f20d7ff::apikey:" + akID
+	akCreatedBy   = "IBMid-123453user"
+	akValue       = "a1b2c3d4e5f6"
+	akLocked      = true
+	akUnlocked    = false
+	akNewETag     = "2-eb832c7ff8c8016a542974b9f880b55e"
+)
+
+var _ managed.ExternalConnecter = &akConnector{}
+var _ managed.ExternalClient = &akExternal{}
+
+type akModifier func(*v1alpha1.APIKey)
+
+func ak(im ...akModifier) *v1alpha1.APIKey {
+	i := &v1alpha1.APIKey{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       akName,
+			Finalizers: []string{},
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: akID,
+			},
+		},
+		Spec: v1alpha1.APIKeySpec{
+			ForProvider: v1alpha1.APIKeyParameters{},
+		},
+	}
+	for _, m := range im {
+		m(i)
+	}
+	return i
+}
+
+func akWithExternalNameAnnotation(externalName string) akModifier {
+	return func(i *v1alpha1.APIKey) {
+		if i.ObjectMeta.Annotations == nil {
+			i.ObjectMeta.Annotations = make(map[string]string)
+		}
+		i.ObjectMeta.Annotations[meta.AnnotationKeyExternalName] = externalName
+	}
+}
+
+func akWithSpec(p v1alpha1.APIKeyParameters) akModifier {
+	return func(r *v1alpha1.APIKey) { r.Spec.ForProvider = p }
+}
+
+func akWithConditions(c ...cpv1alpha1.Condition) akModifier {
+	return func(i *v1alpha1.APIKey) { i.Status.SetConditions(c...) }
+}
+
+func akWithStatus(p v1alpha1.APIKeyObservation) akModifier {
+	return func(r *v1alpha1.APIKey) { r.Status.AtProvider = p }
+}
+
+func akParams(m ...func(*v1alpha1.APIKeyParameters)) *v1alpha1.APIKeyParameters {
+	p := &v1alpha1.APIKeyParameters{
+		Name:        akName,
+		Description: &akDescription,
+		IamID:       &sidIamID,
+		AccountID:   &accountID,
+		Locked:      &akLocked,
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func akObservation(m ...func(*v1alpha1.APIKeyObservation)) *v1alpha1.APIKeyObservation {
+	o := &v1alpha1.APIKeyObservation{
+		ID:         akID,
+		EntityTag:  eTag,
+		CRN:        akCrn,
+		Locked:     akLocked,
+		CreatedAt:  ibmc.DateTimeToMetaV1Time(&createdAt),
+		CreatedBy:  akCreatedBy,
+		ModifiedAt: ibmc.DateTimeToMetaV1Time(&modifiedAt),
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+	return o
+}
+
+func akInstance(m ...func(*iamidv1.APIKey)) *iamidv1.APIKey {
+	i := &iamidv1.APIKey{
+		ID:          &akID,
+		EntityTag:   &eTag,
+		CRN:         &akCrn,
+		Locked:      &akLocked,
+		CreatedAt:   &createdAt,
+		CreatedBy:   &akCreatedBy,
+		ModifiedAt:  &modifiedAt,
+		Name:        &akName,
+		Description: &akDescription,
+		IamID:       &sidIamID,
+		AccountID:   &accountID,
+	}
+
+	for _, f := range m {
+		f(i)
+	}
+	return i
+}
+
+// Sets up a unit test http server, and creates an external API key structure appropriate for unit test.
+func setupServerAndGetUnitTestExternalAK(testingObj *testing.T, handlers *[]tstutil.Handler, kube *client.Client) (*akExternal, *httptest.Server, error) {
+	mClient, tstServer, err := tstutil.SetupTestServerClient(testingObj, handlers)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &akExternal{
+			kube:   *kube,
+			client: *mClient,
+			logger: logging.NewNopLogger(),
+		},
+		tstServer,
+		nil
+}
+
+func TestAPIKeyObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"NotFound": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						// content type should always set before writeHeader()
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusNotFound)
+						err := json.NewEncoder(w).Encode(&iamidv1.APIKey{})
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: ak(),
+			},
+			want: want{
+				mg:  ak(),
+				err: nil,
+			},
+		},
+		"UpToDate": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						err := json.NewEncoder(w).Encode(akInstance())
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: tstutil.Args{
+				Managed: ak(
+					akWithExternalNameAnnotation(akID),
+					akWithSpec(*akParams()),
+				),
+			},
+			want: want{
+				mg: ak(akWithSpec(*akParams()),
+					akWithConditions(cpv1alpha1.Available()),
+					akWithStatus(*akObservation(func(o *v1alpha1.APIKeyObservation) {
+						o.State = ibmcak.StateActive
+					}))),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: nil,
+				},
+			},
+		},
+		"StoredValue": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						err := json.NewEncoder(w).Encode(akInstance(func(i *iamidv1.APIKey) {
+							i.Apikey = &akValue
+						}))
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: tstutil.Args{
+				Managed: ak(
+					akWithExternalNameAnnotation(akID),
+					akWithSpec(*akParams()),
+				),
+			},
+			want: want{
+				mg: ak(akWithSpec(*akParams()),
+					akWithConditions(cpv1alpha1.Available()),
+					akWithStatus(*akObservation(func(o *v1alpha1.APIKeyObservation) {
+						o.State = ibmcak.StateActive
+					}))),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{ibmcak.ConnectionKeyAPIKey: []byte(akValue)},
+				},
+			},
+		},
+		"NotUpToDate": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						err := json.NewEncoder(w).Encode(akInstance(func(i *iamidv1.APIKey) {
+							i.Locked = &akUnlocked
+						}))
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: tstutil.Args{
+				Managed: ak(
+					akWithExternalNameAnnotation(akID),
+					akWithSpec(*akParams()),
+				),
+			},
+			want: want{
+				mg: ak(akWithSpec(*akParams()),
+					akWithConditions(cpv1alpha1.Available()),
+					akWithStatus(*akObservation(func(o *v1alpha1.APIKeyObservation) {
+						o.Locked = false
+						o.State = ibmcak.StateActive
+					}))),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: nil,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, errCr := setupServerAndGetUnitTestExternalAK(t, &tc.handlers, &tc.kube)
+			if errCr != nil {
+				t.Errorf("Observe(...): problem setting up the test server %s", errCr)
+			}
+
+			defer server.Close()
+
+			obs, err := e.Observe(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				// the case where our mock server returns error.
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Observe(...): want error string != got error string:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Observe(...): want error != got error:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAPIKeyCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		cre managed.ExternalCreation
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"Successful": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff("true", r.Header.Get("Entity-Lock")); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusCreated)
+						_ = r.Body.Close()
+						err := json.NewEncoder(w).Encode(akInstance(func(i *iamidv1.APIKey) {
+							i.Apikey = &akValue
+						}))
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: ak(akWithSpec(*akParams())),
+			},
+			want: want{
+				mg: ak(akWithSpec(*akParams()),
+					akWithConditions(cpv1alpha1.Creating()),
+					akWithExternalNameAnnotation(akID)),
+				cre: managed.ExternalCreation{
+					ExternalNameAssigned: true,
+					ConnectionDetails:    managed.ConnectionDetails{ibmcak.ConnectionKeyAPIKey: []byte(akValue)},
+				},
+				err: nil,
+			},
+		},
+		"BadRequest": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusBadRequest)
+						_ = r.Body.Close()
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: ak(akWithSpec(*akParams())),
+			},
+			want: want{
+				mg: ak(akWithSpec(*akParams()),
+					akWithConditions(cpv1alpha1.Creating())),
+				cre: managed.ExternalCreation{ExternalNameAssigned: false},
+				err: errors.Wrap(errors.New(http.StatusText(http.StatusBadRequest)), errCreateAPIKey),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, errCr := setupServerAndGetUnitTestExternalAK(t, &tc.handlers, &tc.kube)
+			if errCr != nil {
+				t.Errorf("Create(...): problem setting up the test server %s", errCr)
+			}
+
+			defer server.Close()
+
+			cre, err := e.Create(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				// the case where our mock server returns error.
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Create(...): -want, +got:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Create(...): -want, +got:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.cre, cre); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAPIKeyDelete(t *testing.T) {
+	type want struct {
+		mg       resource.Managed
+		requests []string
+		err      error
+	}
+	cases := map[string]struct {
+		status int
+		args   tstutil.Args
+		want   want
+	}{
+		"Unlocked": {
+			status: http.StatusNoContent,
+			args: tstutil.Args{
+				Managed: ak(akWithStatus(*akObservation(func(o *v1alpha1.APIKeyObservation) { o.Locked = false }))),
+			},
+			want: want{
+				mg: ak(akWithStatus(*akObservation(func(o *v1alpha1.APIKeyObservation) { o.Locked = false })),
+					akWithConditions(cpv1alpha1.Deleting())),
+				requests: []string{"DELETE /v1/apikeys/" + akID},
+			},
+		},
+		"Locked": {
+			status: http.StatusNoContent,
+			args: tstutil.Args{
+				Managed: ak(akWithStatus(*akObservation())),
+			},
+			want: want{
+				mg: ak(akWithStatus(*akObservation()), akWithConditions(cpv1alpha1.Deleting())),
+				requests: []string{
+					"DELETE /v1/apikeys/" + akID + "/lock",
+					"DELETE /v1/apikeys/" + akID,
+				},
+			},
+		},
+		"Forbidden": {
+			status: http.StatusForbidden,
+			args: tstutil.Args{
+				Managed: ak(akWithStatus(*akObservation(func(o *v1alpha1.APIKeyObservation) { o.Locked = false }))),
+			},
+			want: want{
+				mg: ak(akWithStatus(*akObservation(func(o *v1alpha1.APIKeyObservation) { o.Locked = false })),
+					akWithConditions(cpv1alpha1.Deleting())),
+				requests: []string{"DELETE /v1/apikeys/" + akID},
+				err:      errors.Wrap(errors.New(http.StatusText(http.StatusForbidden)), errDeleteAPIKey),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			requests := []string{}
+			handlers := []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						requests = append(requests, r.Method+" "+r.URL.Path)
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(tc.status)
+						_ = r.Body.Close()
+					},
+				},
+			}
+			var kube client.Client
+			e, server, errCr := setupServerAndGetUnitTestExternalAK(t, &handlers, &kube)
+			if errCr != nil {
+				t.Errorf("Delete(...): problem setting up the test server %s", errCr)
+			}
+
+			defer server.Close()
+
+			err := e.Delete(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				// the case where our mock server returns error.
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Delete(...): -want, +got:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Delete(...): -want, +got:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.requests, requests); diff != "" {
+				t.Errorf("Delete(...): -want requests, +got requests:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAPIKeyUpdate(t *testing.T) {
+	type want struct {
+		mg       resource.Managed
+		requests []string
+		upd      managed.ExternalUpdate
+		err      error
+	}
+	cases := map[string]struct {
+		status int
+		args   tstutil.Args
+		want   want
+	}{
+		"UnlockedToLocked": {
+			status: http.StatusOK,
+			args: tstutil.Args{
+				Managed: ak(akWithSpec(*akParams()),
+					akWithStatus(*akObservation(func(o *v1alpha1.APIKeyObservation) { o.Locked = false }))),
+			},
+			want: want{
+				mg: ak(akWithSpec(*akParams()),
+					akWithStatus(*akObservation(func(o *v1alpha1.APIKeyObservation) { o.Locked = false }))),
+				requests: []string{
+					"PUT /v1/apikeys/" + akID + " " + eTag,
+					"POST /v1/apikeys/" + akID + "/lock",
+				},
+			},
+		},
+		"Locked": {
+			status: http.StatusOK,
+			args: tstutil.Args{
+				Managed: ak(akWithSpec(*akParams()), akWithStatus(*akObservation())),
+			},
+			want: want{
+				mg: ak(akWithSpec(*akParams()), akWithStatus(*akObservation())),
+				requests: []string{
+					"DELETE /v1/apikeys/" + akID + "/lock",
+					"GET /v1/apikeys/" + akID,
+					"PUT /v1/apikeys/" + akID + " " + akNewETag,
+					"POST /v1/apikeys/" + akID + "/lock",
+				},
+			},
+		},
+		"LockedToUnlocked": {
+			status: http.StatusOK,
+			args: tstutil.Args{
+				Managed: ak(akWithSpec(*akParams(func(p *v1alpha1.APIKeyParameters) { p.Locked = &akUnlocked })),
+					akWithStatus(*akObservation())),
+			},
+			want: want{
+				mg: ak(akWithSpec(*akParams(func(p *v1alpha1.APIKeyParameters) { p.Locked = &akUnlocked })),
+					akWithStatus(*akObservation())),
+				requests: []string{
+					"DELETE /v1/apikeys/" + akID + "/lock",
+					"GET /v1/apikeys/" + akID,
+					"PUT /v1/apikeys/" + akID + " " + akNewETag,
+				},
+			},
+		},
+		"Conflict": {
+			status: http.StatusConflict,
+			args: tstutil.Args{
+				Managed: ak(akWithSpec(*akParams()),
+					akWithStatus(*akObservation(func(o *v1alpha1.APIKeyObservation) { o.Locked = false }))),
+			},
+			want: want{
+				requests: []string{
+					"PUT /v1/apikeys/" + akID + " " + eTag,
+				},
+				err: errors.Wrap(errors.New(http.StatusText(http.StatusConflict)), errUpdAPIKey),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			requests := []string{}
+			handlers := []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						req := r.Method + " " + r.URL.Path
+						if r.Method == http.MethodPut {
+							req += " " + r.Header.Get("If-Match")
+						}
+						requests = append(requests, req)
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(tc.status)
+						err := json.NewEncoder(w).Encode(akInstance(func(i *iamidv1.APIKey) {
+							i.EntityTag = &akNewETag
+						}))
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			}
+			var kube client.Client
+			e, server, errCr := setupServerAndGetUnitTestExternalAK(t, &handlers, &kube)
+			if errCr != nil {
+				t.Errorf("Update(...): problem setting up the test server %s", errCr)
+			}
+
+			defer server.Close()
+
+			upd, err := e.Update(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				// the case where our mock server returns error.
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Update(...): -want, +got:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Update(...): -want, +got:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.requests, requests); diff != "" {
+				t.Errorf("Update(...): -want requests, +got requests:\n%s", diff)
+			}
+			if tc.want.err == nil {
+				if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+					t.Errorf("Update(...): -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(tc.want.upd, upd); diff != "" {
+					t.Errorf("Update(...): -want, +got:\n%s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,233 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iamidentityv1
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cpv1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	iamidv1 "github.com/IBM/platform-services-go-sdk/iamidentityv1"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/iamidentityv1/v1alpha1"
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+	ibmcsid "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/serviceid"
+)
+
+const (
+	errNotServiceID        = "managed resource is not a ServiceID custom resource"
+	errNewClient           = "cannot create new Client"
+	errCreateServiceID     = "could not create service ID"
+	errDeleteServiceID     = "could not delete service ID"
+	errGetServiceIDFailed  = "error getting service ID"
+	errCheckUpToDate       = "cannot determine if instance is up to date"
+	errGetAuth             = "error getting auth info"
+	errManagedUpdateFailed = "cannot update ServiceID custom resource"
+	errGenObservation      = "error generating observation"
+	errCreateServiceIDOpts = "error creating service ID options"
+	errUpdServiceID        = "error updating service ID"
+)
+
+// serviceIDDependents are the managed resources that reference service IDs, and must be deleted before them (as the
+// API keys of a service ID are deleted with it)
+var serviceIDDependents = []ibmc.Dependent{
+	{
+		Kind: v1alpha1.APIKeyKind,
+		List: func() resource.ManagedList { return &v1alpha1.APIKeyList{} },
+		DependsOn: func(mg resource.Managed, name string) bool {
+			return ibmc.IsReferenceTo(mg.(*v1alpha1.APIKey).Spec.ForProvider.IamIDRef, name)
+		},
+	},
+}
+
+// SetupServiceID adds a controller that reconciles ServiceID managed resources.
+func SetupServiceID(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.ServiceIDGroupKind)
+	log := l.WithValues("ServiceID-controller", name)
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ServiceIDGroupVersionKind),
+		managed.WithExternalConnecter(ibmc.NewAuditConnecter(&sidConnector{
+			kube:     mgr.GetClient(),
+			usage:    resource.NewProviderConfigUsageTracker(mgr.GetClient(), &v1beta1.ProviderConfigUsage{}),
+			clientFn: ibmc.NewClient,
+			logger:   log}, event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()),
+			ibmc.NewExpiration(mgr.GetClient(), event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(log),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.ServiceID{}).
+		Complete(ibmc.NewExpirationReconciler(mgr, resource.ManagedKind(v1alpha1.ServiceIDGroupVersionKind), r))
+}
+
+// A sidConnector is expected to produce an ExternalClient when its Connect method
+// is called.
+type sidConnector struct {
+	kube     client.Client
+	usage    resource.Tracker
+	clientFn func(optd ibmc.ClientOptions) (ibmc.ClientSession, error)
+	logger   logging.Logger
+}
+
+// Connect produces an ExternalClient for IBM Cloud API
+func (c *sidConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	opts, err := ibmc.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetAuth)
+	}
+
+	service, err := c.clientFn(opts)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &sidExternal{client: service, kube: c.kube, logger: c.logger}, nil
+}
+
+// An sidExternal observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type sidExternal struct {
+	client ibmc.ClientSession
+	kube   client.Client
+	logger logging.Logger
+}
+
+func (c *sidExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ServiceID)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotServiceID)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	instance, _, err := c.client.IamIdentityV1().GetServiceID(&iamidv1.GetServiceIDOptions{ID: reference.ToPtrValue(meta.GetExternalName(cr))})
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ibmc.IsResourceNotFound, err), errGetServiceIDFailed)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	if err = ibmcsid.LateInitializeSpec(&cr.Spec.ForProvider, instance); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errManagedUpdateFailed)
+	}
+	lateInitSpec := cr.Spec.ForProvider.DeepCopy()
+	if err = ibmc.RestrictLateInitialization(cr, currentSpec, &cr.Spec.ForProvider); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
+	}
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := c.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errManagedUpdateFailed)
+		}
+	}
+
+	cr.Status.AtProvider, err = ibmcsid.GenerateObservation(instance)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGenObservation)
+	}
+
+	cr.Status.SetConditions(cpv1alpha1.Available())
+	cr.Status.AtProvider.State = ibmcsid.StateActive
+
+	upToDate, err := ibmcsid.IsUpToDate(lateInitSpec, instance, c.logger)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCheckUpToDate)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: nil,
+	}, nil
+}
+
+func (c *sidExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ServiceID)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotServiceID)
+	}
+
+	cr.SetConditions(cpv1alpha1.Creating())
+	createOptions := &iamidv1.CreateServiceIDOptions{}
+	if err := ibmcsid.GenerateCreateServiceIDOptions(cr.Spec.ForProvider, createOptions); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateServiceIDOpts)
+	}
+
+	instance, _, err := c.client.IamIdentityV1().CreateServiceID(createOptions)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateServiceID)
+	}
+
+	meta.SetExternalName(cr, reference.FromPtrValue(instance.ID))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (c *sidExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ServiceID)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotServiceID)
+	}
+
+	updOpts := &iamidv1.UpdateServiceIDOptions{}
+	if err := ibmcsid.GenerateUpdateServiceIDOptions(cr.Status.AtProvider.ID, cr.Status.AtProvider.EntityTag, cr.Spec.ForProvider, updOpts); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdServiceID)
+	}
+
+	if _, _, err := c.client.IamIdentityV1().UpdateServiceID(updOpts); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdServiceID)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *sidExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ServiceID)
+	if !ok {
+		return errors.New(errNotServiceID)
+	}
+
+	if err := ibmc.CheckDependents(ctx, c.kube, cr, serviceIDDependents...); err != nil {
+		return errors.Wrap(err, errDeleteServiceID)
+	}
+
+	cr.SetConditions(cpv1alpha1.Deleting())
+
+	_, err := c.client.IamIdentityV1().DeleteServiceID(&iamidv1.DeleteServiceIDOptions{ID: &cr.Status.AtProvider.ID})
+	if err != nil {
+		return errors.Wrap(resource.Ignore(ibmc.IsResourceGone, err), errDeleteServiceID)
+	}
+	return nil
+}
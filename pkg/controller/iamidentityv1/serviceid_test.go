@@ -0,0 +1,712 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iamidentityv1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	cpv1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	iamidv1 "github.com/IBM/platform-services-go-sdk/iamidentityv1"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/iamidentityv1/v1alpha1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+	ibmcsid "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/serviceid"
+	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/controller/tstutil"
+)
+
+const (
+	errSidBadRequest = "error getting service ID: Bad Request"
+	errSidForbidden  = "error getting service ID: Forbidden"
+)
+
+var (
+	sidName        = "myServiceID"
+	sidDescription = "service ID for my app"
+	sidOtherName   = "myOtherServiceID"
+	accountID      = "aa5a00334eaf9eb9339d2ab48f20d7ff"
+	sidID          = "ServiceId-12345678-abcd-1a2b-a1b2-1234567890ab"
+	sidIamID       = "iam-ServiceId-12345678-abcd-1a2b-a1b2-1234567890ab"
+	sidCrn         = "crn:v1:bluemix:public:iam-identity::a/aa5a00334eaf9eb9339d2ab48f20d7ff::serviceid:" + sidID
+	sidLocked      = false
+	eTag           = "1-eb832c7ff8c8016a542974b9f880b55e"
+	createdAt, _   = strfmt.ParseDateTime("2020-10-31T02:33:06Z")
+	modifiedAt, _  = strfmt.ParseDateTime("2020-10-31T03:33:06Z")
+)
+
+var _ managed.ExternalConnecter = &sidConnector{}
+var _ managed.ExternalClient = &sidExternal{}
+
+type sidModifier func(*v1alpha1.ServiceID)
+
+func sid(im ...sidModifier) *v1alpha1.ServiceID {
+	i := &v1alpha1.ServiceID{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       sidName,
+			Finalizers: []string{},
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: sidID,
+			},
+		},
+		Spec: v1alpha1.ServiceIDSpec{
+			ForProvider: v1alpha1.ServiceIDParameters{},
+		},
+	}
+	for _, m := range im {
+		m(i)
+	}
+	return i
+}
+
+func sidWithExternalNameAnnotation(externalName string) sidModifier {
+	return func(i *v1alpha1.ServiceID) {
+		if i.ObjectMeta.Annotations == nil {
+			i.ObjectMeta.Annotations = make(map[string]string)
+		}
+		i.ObjectMeta.Annotations[meta.AnnotationKeyExternalName] = externalName
+	}
+}
+
+func sidWithSpec(p v1alpha1.ServiceIDParameters) sidModifier {
+	return func(r *v1alpha1.ServiceID) { r.Spec.ForProvider = p }
+}
+
+func sidWithConditions(c ...cpv1alpha1.Condition) sidModifier {
+	return func(i *v1alpha1.ServiceID) { i.Status.SetConditions(c...) }
+}
+
+func sidWithStatus(p v1alpha1.ServiceIDObservation) sidModifier {
+	return func(r *v1alpha1.ServiceID) { r.Status.AtProvider = p }
+}
+
+func sidParams(m ...func(*v1alpha1.ServiceIDParameters)) *v1alpha1.ServiceIDParameters {
+	p := &v1alpha1.ServiceIDParameters{
+		AccountID:   accountID,
+		Name:        sidName,
+		Description: &sidDescription,
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func sidObservation(m ...func(*v1alpha1.ServiceIDObservation)) *v1alpha1.ServiceIDObservation {
+	o := &v1alpha1.ServiceIDObservation{
+		ID:         sidID,
+		IamID:      sidIamID,
+		EntityTag:  eTag,
+		CRN:        sidCrn,
+		Locked:     sidLocked,
+		CreatedAt:  ibmc.DateTimeToMetaV1Time(&createdAt),
+		ModifiedAt: ibmc.DateTimeToMetaV1Time(&modifiedAt),
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+	return o
+}
+
+func sidInstance(m ...func(*iamidv1.ServiceID)) *iamidv1.ServiceID {
+	i := &iamidv1.ServiceID{
+		ID:          &sidID,
+		IamID:       &sidIamID,
+		EntityTag:   &eTag,
+		CRN:         &sidCrn,
+		Locked:      &sidLocked,
+		CreatedAt:   &createdAt,
+		ModifiedAt:  &modifiedAt,
+		AccountID:   &accountID,
+		Name:        &sidName,
+		Description: &sidDescription,
+	}
+
+	for _, f := range m {
+		f(i)
+	}
+	return i
+}
+
+// Sets up a unit test http server, and creates an external service ID structure appropriate for unit test.
+func setupServerAndGetUnitTestExternalSID(testingObj *testing.T, handlers *[]tstutil.Handler, kube *client.Client) (*sidExternal, *httptest.Server, error) {
+	mClient, tstServer, err := tstutil.SetupTestServerClient(testingObj, handlers)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &sidExternal{
+			kube:   *kube,
+			client: *mClient,
+			logger: logging.NewNopLogger(),
+		},
+		tstServer,
+		nil
+}
+
+func TestServiceIDObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"NotFound": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						// content type should always set before writeHeader()
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusNotFound)
+						err := json.NewEncoder(w).Encode(&iamidv1.ServiceID{})
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: sid(),
+			},
+			want: want{
+				mg:  sid(),
+				err: nil,
+			},
+		},
+		"GetFailed": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusBadRequest)
+						err := json.NewEncoder(w).Encode(&iamidv1.ServiceID{})
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: sid(),
+			},
+			want: want{
+				mg:  sid(),
+				err: errors.New(errSidBadRequest),
+			},
+		},
+		"GetForbidden": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusForbidden)
+						err := json.NewEncoder(w).Encode(&iamidv1.ServiceID{})
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: sid(),
+			},
+			want: want{
+				mg:  sid(),
+				err: errors.New(errSidForbidden),
+			},
+		},
+		"UpToDate": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						err := json.NewEncoder(w).Encode(sidInstance())
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: tstutil.Args{
+				Managed: sid(
+					sidWithExternalNameAnnotation(sidID),
+					sidWithSpec(*sidParams()),
+				),
+			},
+			want: want{
+				mg: sid(sidWithSpec(*sidParams()),
+					sidWithConditions(cpv1alpha1.Available()),
+					sidWithStatus(*sidObservation(func(o *v1alpha1.ServiceIDObservation) {
+						o.State = ibmcsid.StateActive
+					}))),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: nil,
+				},
+			},
+		},
+		"NotUpToDate": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						err := json.NewEncoder(w).Encode(sidInstance(func(i *iamidv1.ServiceID) {
+							i.Name = &sidOtherName
+						}))
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: tstutil.Args{
+				Managed: sid(
+					sidWithExternalNameAnnotation(sidID),
+					sidWithSpec(*sidParams()),
+				),
+			},
+			want: want{
+				mg: sid(sidWithSpec(*sidParams()),
+					sidWithConditions(cpv1alpha1.Available()),
+					sidWithStatus(*sidObservation(func(o *v1alpha1.ServiceIDObservation) {
+						o.State = ibmcsid.StateActive
+					}))),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: nil,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, errCr := setupServerAndGetUnitTestExternalSID(t, &tc.handlers, &tc.kube)
+			if errCr != nil {
+				t.Errorf("Observe(...): problem setting up the test server %s", errCr)
+			}
+
+			defer server.Close()
+
+			obs, err := e.Observe(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				// the case where our mock server returns error.
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Observe(...): want error string != got error string:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Observe(...): want error != got error:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestServiceIDCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		cre managed.ExternalCreation
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"Successful": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusCreated)
+						_ = r.Body.Close()
+						err := json.NewEncoder(w).Encode(sidInstance())
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: sid(sidWithSpec(*sidParams())),
+			},
+			want: want{
+				mg: sid(sidWithSpec(*sidParams()),
+					sidWithConditions(cpv1alpha1.Creating()),
+					sidWithExternalNameAnnotation(sidID)),
+				cre: managed.ExternalCreation{ExternalNameAssigned: true},
+				err: nil,
+			},
+		},
+		"BadRequest": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusBadRequest)
+						_ = r.Body.Close()
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: sid(sidWithSpec(*sidParams())),
+			},
+			want: want{
+				mg: sid(sidWithSpec(*sidParams()),
+					sidWithConditions(cpv1alpha1.Creating())),
+				cre: managed.ExternalCreation{ExternalNameAssigned: false},
+				err: errors.Wrap(errors.New(http.StatusText(http.StatusBadRequest)), errCreateServiceID),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, errCr := setupServerAndGetUnitTestExternalSID(t, &tc.handlers, &tc.kube)
+			if errCr != nil {
+				t.Errorf("Create(...): problem setting up the test server %s", errCr)
+			}
+
+			defer server.Close()
+
+			cre, err := e.Create(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				// the case where our mock server returns error.
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Create(...): -want, +got:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Create(...): -want, +got:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.cre, cre); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestServiceIDDelete(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"Successful": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusNoContent)
+						_ = r.Body.Close()
+					},
+				},
+			},
+			kube: &test.MockClient{MockList: test.NewMockListFn(nil)},
+			args: tstutil.Args{
+				Managed: sid(sidWithStatus(*sidObservation())),
+			},
+			want: want{
+				mg:  sid(sidWithStatus(*sidObservation()), sidWithConditions(cpv1alpha1.Deleting())),
+				err: nil,
+			},
+		},
+		"AlreadyGone": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusNotFound)
+						_ = r.Body.Close()
+					},
+				},
+			},
+			kube: &test.MockClient{MockList: test.NewMockListFn(nil)},
+			args: tstutil.Args{
+				Managed: sid(sidWithStatus(*sidObservation())),
+			},
+			want: want{
+				mg:  sid(sidWithStatus(*sidObservation()), sidWithConditions(cpv1alpha1.Deleting())),
+				err: nil,
+			},
+		},
+		"Forbidden": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusForbidden)
+						_ = r.Body.Close()
+					},
+				},
+			},
+			kube: &test.MockClient{MockList: test.NewMockListFn(nil)},
+			args: tstutil.Args{
+				Managed: sid(sidWithStatus(*sidObservation())),
+			},
+			want: want{
+				mg:  sid(sidWithStatus(*sidObservation()), sidWithConditions(cpv1alpha1.Deleting())),
+				err: errors.Wrap(errors.New(http.StatusText(http.StatusForbidden)), errDeleteServiceID),
+			},
+		},
+		"DependentsExist": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						t.Errorf("r: unexpected %s request for a service ID with dependents", r.Method)
+					},
+				},
+			},
+			kube: &test.MockClient{MockList: test.NewMockListFn(nil, func(obj runtime.Object) error {
+				if l, ok := obj.(*v1alpha1.APIKeyList); ok {
+					k := v1alpha1.APIKey{}
+					k.SetName("mykey")
+					k.Spec.ForProvider.IamIDRef = &cpv1alpha1.Reference{Name: sidName}
+					l.Items = []v1alpha1.APIKey{k}
+				}
+				return nil
+			})},
+			args: tstutil.Args{
+				Managed: sid(sidWithStatus(*sidObservation())),
+			},
+			want: want{
+				mg: sid(sidWithStatus(*sidObservation()), sidWithConditions(ibmc.DeletionBlocked(
+					errors.New("waiting for the deletion of the managed resources that depend on the resource: APIKey/mykey")))),
+				err: errors.Wrap(errors.New("waiting for the deletion of the managed resources that depend on the resource: APIKey/mykey"),
+					errDeleteServiceID),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, errCr := setupServerAndGetUnitTestExternalSID(t, &tc.handlers, &tc.kube)
+			if errCr != nil {
+				t.Errorf("Delete(...): problem setting up the test server %s", errCr)
+			}
+
+			defer server.Close()
+
+			err := e.Delete(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				// the case where our mock server returns error.
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Delete(...): -want, +got:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Delete(...): -want, +got:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestServiceIDUpdate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		upd managed.ExternalUpdate
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"Successful": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodPut, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff(eTag, r.Header.Get("If-Match")); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusOK)
+						_ = r.Body.Close()
+						err := json.NewEncoder(w).Encode(sidInstance())
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: sid(sidWithSpec(*sidParams()), sidWithStatus(*sidObservation())),
+			},
+			want: want{
+				mg:  sid(sidWithSpec(*sidParams()), sidWithStatus(*sidObservation())),
+				upd: managed.ExternalUpdate{},
+				err: nil,
+			},
+		},
+		"Conflict": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodPut, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusConflict)
+						_ = r.Body.Close()
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: sid(sidWithSpec(*sidParams()), sidWithStatus(*sidObservation())),
+			},
+			want: want{
+				mg:  sid(sidWithSpec(*sidParams()), sidWithStatus(*sidObservation())),
+				err: errors.Wrap(errors.New(http.StatusText(http.StatusConflict)), errUpdServiceID),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, errCr := setupServerAndGetUnitTestExternalSID(t, &tc.handlers, &tc.kube)
+			if errCr != nil {
+				t.Errorf("Update(...): problem setting up the test server %s", errCr)
+			}
+
+			defer server.Close()
+
+			upd, err := e.Update(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				// the case where our mock server returns error.
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Update(...): -want, +got:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Update(...): -want, +got:\n%s", diff)
+				}
+			}
+			if tc.want.err == nil {
+				if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+					t.Errorf("Update(...): -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(tc.want.upd, upd); diff != "" {
+					t.Errorf("Update(...): -want, +got:\n%s", diff)
+				}
+			}
+		})
+	}
+}
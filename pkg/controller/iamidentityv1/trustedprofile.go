@@ -0,0 +1,233 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iamidentityv1
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cpv1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/iamidentityv1/v1alpha1"
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+	ibmctp "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/trustedprofile"
+)
+
+const (
+	errNotTrustedProfile        = "managed resource is not a TrustedProfile custom resource"
+	errCreateTrustedProfile     = "could not create trusted profile"
+	errDeleteTrustedProfile     = "could not delete trusted profile"
+	errGetTrustedProfileFailed  = "error getting trusted profile"
+	errCreateTrustedProfileOpts = "error creating trusted profile options"
+	errUpdTrustedProfile        = "error updating trusted profile"
+)
+
+// trustedProfileDependents are the managed resources that reference trusted profiles, and must be deleted before them
+// (as the claim rules and links of a trusted profile are deleted with it)
+var trustedProfileDependents = []ibmc.Dependent{
+	{
+		Kind: v1alpha1.TrustedProfileClaimRuleKind,
+		List: func() resource.ManagedList { return &v1alpha1.TrustedProfileClaimRuleList{} },
+		DependsOn: func(mg resource.Managed, name string) bool {
+			return ibmc.IsReferenceTo(mg.(*v1alpha1.TrustedProfileClaimRule).Spec.ForProvider.ProfileIDRef, name)
+		},
+	},
+	{
+		Kind: v1alpha1.TrustedProfileLinkKind,
+		List: func() resource.ManagedList { return &v1alpha1.TrustedProfileLinkList{} },
+		DependsOn: func(mg resource.Managed, name string) bool {
+			return ibmc.IsReferenceTo(mg.(*v1alpha1.TrustedProfileLink).Spec.ForProvider.ProfileIDRef, name)
+		},
+	},
+}
+
+// SetupTrustedProfile adds a controller that reconciles TrustedProfile managed resources.
+func SetupTrustedProfile(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.TrustedProfileGroupKind)
+	log := l.WithValues("TrustedProfile-controller", name)
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.TrustedProfileGroupVersionKind),
+		managed.WithExternalConnecter(ibmc.NewAuditConnecter(&tpConnector{
+			kube:     mgr.GetClient(),
+			usage:    resource.NewProviderConfigUsageTracker(mgr.GetClient(), &v1beta1.ProviderConfigUsage{}),
+			clientFn: ibmc.NewClient,
+			logger:   log}, event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()),
+			ibmc.NewExpiration(mgr.GetClient(), event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(log),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.TrustedProfile{}).
+		Complete(ibmc.NewExpirationReconciler(mgr, resource.ManagedKind(v1alpha1.TrustedProfileGroupVersionKind), r))
+}
+
+// A tpConnector is expected to produce an ExternalClient when its Connect method
+// is called.
+type tpConnector struct {
+	kube     client.Client
+	usage    resource.Tracker
+	clientFn func(optd ibmc.ClientOptions) (ibmc.ClientSession, error)
+	logger   logging.Logger
+}
+
+// Connect produces an ExternalClient for IBM Cloud API
+func (c *tpConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	opts, err := ibmc.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetAuth)
+	}
+
+	service, err := c.clientFn(opts)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &tpExternal{client: ibmctp.NewClient(service.IamIdentityV1()), kube: c.kube, logger: c.logger}, nil
+}
+
+// A tpExternal observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type tpExternal struct {
+	client *ibmctp.Client
+	kube   client.Client
+	logger logging.Logger
+}
+
+func (c *tpExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.TrustedProfile)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotTrustedProfile)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	instance, _, err := c.client.GetProfile(ctx, &ibmctp.GetProfileOptions{ProfileID: reference.ToPtrValue(meta.GetExternalName(cr))})
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ibmc.IsResourceNotFound, err), errGetTrustedProfileFailed)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	if err = ibmctp.LateInitializeSpec(&cr.Spec.ForProvider, instance); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errManagedUpdateFailed)
+	}
+	lateInitSpec := cr.Spec.ForProvider.DeepCopy()
+	if err = ibmc.RestrictLateInitialization(cr, currentSpec, &cr.Spec.ForProvider); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
+	}
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := c.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errManagedUpdateFailed)
+		}
+	}
+
+	cr.Status.AtProvider, err = ibmctp.GenerateObservation(instance)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGenObservation)
+	}
+
+	cr.Status.SetConditions(cpv1alpha1.Available())
+	cr.Status.AtProvider.State = ibmctp.StateActive
+
+	upToDate, err := ibmctp.IsUpToDate(lateInitSpec, instance, c.logger)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCheckUpToDate)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: nil,
+	}, nil
+}
+
+func (c *tpExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.TrustedProfile)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotTrustedProfile)
+	}
+
+	cr.SetConditions(cpv1alpha1.Creating())
+	createOptions := &ibmctp.CreateProfileOptions{}
+	if err := ibmctp.GenerateCreateProfileOptions(cr.Spec.ForProvider, createOptions); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateTrustedProfileOpts)
+	}
+
+	instance, _, err := c.client.CreateProfile(ctx, createOptions)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateTrustedProfile)
+	}
+
+	meta.SetExternalName(cr, reference.FromPtrValue(instance.ID))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (c *tpExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.TrustedProfile)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotTrustedProfile)
+	}
+
+	updOpts := &ibmctp.UpdateProfileOptions{}
+	if err := ibmctp.GenerateUpdateProfileOptions(cr.Status.AtProvider.ID, cr.Status.AtProvider.EntityTag, cr.Spec.ForProvider, updOpts); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdTrustedProfile)
+	}
+
+	if _, _, err := c.client.UpdateProfile(ctx, updOpts); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdTrustedProfile)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *tpExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.TrustedProfile)
+	if !ok {
+		return errors.New(errNotTrustedProfile)
+	}
+
+	if err := ibmc.CheckDependents(ctx, c.kube, cr, trustedProfileDependents...); err != nil {
+		return errors.Wrap(err, errDeleteTrustedProfile)
+	}
+
+	cr.SetConditions(cpv1alpha1.Deleting())
+
+	_, err := c.client.DeleteProfile(ctx, &ibmctp.DeleteProfileOptions{ProfileID: &cr.Status.AtProvider.ID})
+	if err != nil {
+		return errors.Wrap(resource.Ignore(ibmc.IsResourceGone, err), errDeleteTrustedProfile)
+	}
+	return nil
+}
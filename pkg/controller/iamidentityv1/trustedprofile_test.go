@@ -0,0 +1,646 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iamidentityv1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	cpv1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/iamidentityv1/v1alpha1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+	ibmctp "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/trustedprofile"
+	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/controller/tstutil"
+)
+
+const (
+	errTpBadRequest = "error getting trusted profile: Bad Request"
+)
+
+var (
+	tpName        = "myTrustedProfile"
+	tpDescription = "trusted profile for my cluster"
+	tpOtherName   = "myOtherTrustedProfile"
+	tpID          = "Profile-12345678-abcd-1a2b-a1b2-1234567890ab"
+	tpIamID       = "iam-Profile-12345678-abcd-1a2b-a1b2-1234567890ab"
+	tpCrn         = "crn:v1:bluemix:public:iam-identity::a/aa5a00334eaf9eb9339d2ab48f20d7ff::profile:" + tpID
+)
+
+var _ managed.ExternalConnecter = &tpConnector{}
+var _ managed.ExternalClient = &tpExternal{}
+
+type tpModifier func(*v1alpha1.TrustedProfile)
+
+func tp(im ...tpModifier) *v1alpha1.TrustedProfile {
+	i := &v1alpha1.TrustedProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       tpName,
+			Finalizers: []string{},
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: tpID,
+			},
+		},
+		Spec: v1alpha1.TrustedProfileSpec{
+			ForProvider: v1alpha1.TrustedProfileParameters{},
+		},
+	}
+	for _, m := range im {
+		m(i)
+	}
+	return i
+}
+
+func tpWithExternalNameAnnotation(externalName string) tpModifier {
+	return func(i *v1alpha1.TrustedProfile) {
+		if i.ObjectMeta.Annotations == nil {
+			i.ObjectMeta.Annotations = make(map[string]string)
+		}
+		i.ObjectMeta.Annotations[meta.AnnotationKeyExternalName] = externalName
+	}
+}
+
+func tpWithSpec(p v1alpha1.TrustedProfileParameters) tpModifier {
+	return func(r *v1alpha1.TrustedProfile) { r.Spec.ForProvider = p }
+}
+
+func tpWithConditions(c ...cpv1alpha1.Condition) tpModifier {
+	return func(i *v1alpha1.TrustedProfile) { i.Status.SetConditions(c...) }
+}
+
+func tpWithStatus(p v1alpha1.TrustedProfileObservation) tpModifier {
+	return func(r *v1alpha1.TrustedProfile) { r.Status.AtProvider = p }
+}
+
+func tpParams(m ...func(*v1alpha1.TrustedProfileParameters)) *v1alpha1.TrustedProfileParameters {
+	p := &v1alpha1.TrustedProfileParameters{
+		AccountID:   accountID,
+		Name:        tpName,
+		Description: &tpDescription,
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func tpObservation(m ...func(*v1alpha1.TrustedProfileObservation)) *v1alpha1.TrustedProfileObservation {
+	o := &v1alpha1.TrustedProfileObservation{
+		ID:         tpID,
+		IamID:      tpIamID,
+		EntityTag:  eTag,
+		CRN:        tpCrn,
+		CreatedAt:  ibmc.DateTimeToMetaV1Time(&createdAt),
+		ModifiedAt: ibmc.DateTimeToMetaV1Time(&modifiedAt),
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+	return o
+}
+
+func tpInstance(m ...func(*ibmctp.TrustedProfile)) *ibmctp.TrustedProfile {
+	i := &ibmctp.TrustedProfile{
+		ID:          &tpID,
+		IamID:       &tpIamID,
+		EntityTag:   &eTag,
+		CRN:         &tpCrn,
+		CreatedAt:   &createdAt,
+		ModifiedAt:  &modifiedAt,
+		AccountID:   &accountID,
+		Name:        &tpName,
+		Description: &tpDescription,
+	}
+
+	for _, f := range m {
+		f(i)
+	}
+	return i
+}
+
+// Sets up a unit test http server, and creates an external trusted profile structure appropriate for unit test.
+func setupServerAndGetUnitTestExternalTP(testingObj *testing.T, handlers *[]tstutil.Handler, kube *client.Client) (*tpExternal, *httptest.Server, error) {
+	mClient, tstServer, err := tstutil.SetupTestServerClient(testingObj, handlers)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &tpExternal{
+			kube:   *kube,
+			client: ibmctp.NewClient((*mClient).IamIdentityV1()),
+			logger: logging.NewNopLogger(),
+		},
+		tstServer,
+		nil
+}
+
+func TestTrustedProfileObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"NotFound": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusNotFound)
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: tp(),
+			},
+			want: want{
+				mg:  tp(),
+				err: nil,
+			},
+		},
+		"GetFailed": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusBadRequest)
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: tp(),
+			},
+			want: want{
+				mg:  tp(),
+				err: errors.New(errTpBadRequest),
+			},
+		},
+		"UpToDate": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff("/v1/profiles/"+tpID, r.URL.Path); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						err := json.NewEncoder(w).Encode(tpInstance())
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: tstutil.Args{
+				Managed: tp(
+					tpWithExternalNameAnnotation(tpID),
+					tpWithSpec(*tpParams()),
+				),
+			},
+			want: want{
+				mg: tp(tpWithSpec(*tpParams()),
+					tpWithConditions(cpv1alpha1.Available()),
+					tpWithStatus(*tpObservation(func(o *v1alpha1.TrustedProfileObservation) {
+						o.State = ibmctp.StateActive
+					}))),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: nil,
+				},
+			},
+		},
+		"NotUpToDate": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						err := json.NewEncoder(w).Encode(tpInstance(func(i *ibmctp.TrustedProfile) {
+							i.Name = &tpOtherName
+						}))
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: tstutil.Args{
+				Managed: tp(
+					tpWithExternalNameAnnotation(tpID),
+					tpWithSpec(*tpParams()),
+				),
+			},
+			want: want{
+				mg: tp(tpWithSpec(*tpParams()),
+					tpWithConditions(cpv1alpha1.Available()),
+					tpWithStatus(*tpObservation(func(o *v1alpha1.TrustedProfileObservation) {
+						o.State = ibmctp.StateActive
+					}))),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: nil,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, errCr := setupServerAndGetUnitTestExternalTP(t, &tc.handlers, &tc.kube)
+			if errCr != nil {
+				t.Errorf("Observe(...): problem setting up the test server %s", errCr)
+			}
+
+			defer server.Close()
+
+			obs, err := e.Observe(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				// the case where our mock server returns error.
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Observe(...): want error string != got error string:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Observe(...): want error != got error:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTrustedProfileCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		cre managed.ExternalCreation
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"Successful": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusCreated)
+						_ = r.Body.Close()
+						err := json.NewEncoder(w).Encode(tpInstance())
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: tp(tpWithSpec(*tpParams())),
+			},
+			want: want{
+				mg: tp(tpWithSpec(*tpParams()),
+					tpWithConditions(cpv1alpha1.Creating()),
+					tpWithExternalNameAnnotation(tpID)),
+				cre: managed.ExternalCreation{ExternalNameAssigned: true},
+				err: nil,
+			},
+		},
+		"BadRequest": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusBadRequest)
+						_ = r.Body.Close()
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: tp(tpWithSpec(*tpParams())),
+			},
+			want: want{
+				mg: tp(tpWithSpec(*tpParams()),
+					tpWithConditions(cpv1alpha1.Creating())),
+				cre: managed.ExternalCreation{ExternalNameAssigned: false},
+				err: errors.Wrap(errors.New(http.StatusText(http.StatusBadRequest)), errCreateTrustedProfile),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, errCr := setupServerAndGetUnitTestExternalTP(t, &tc.handlers, &tc.kube)
+			if errCr != nil {
+				t.Errorf("Create(...): problem setting up the test server %s", errCr)
+			}
+
+			defer server.Close()
+
+			cre, err := e.Create(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				// the case where our mock server returns error.
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Create(...): -want, +got:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Create(...): -want, +got:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.cre, cre); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTrustedProfileDelete(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"Successful": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusNoContent)
+						_ = r.Body.Close()
+					},
+				},
+			},
+			kube: &test.MockClient{MockList: test.NewMockListFn(nil)},
+			args: tstutil.Args{
+				Managed: tp(tpWithStatus(*tpObservation())),
+			},
+			want: want{
+				mg:  tp(tpWithStatus(*tpObservation()), tpWithConditions(cpv1alpha1.Deleting())),
+				err: nil,
+			},
+		},
+		"AlreadyGone": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusNotFound)
+						_ = r.Body.Close()
+					},
+				},
+			},
+			kube: &test.MockClient{MockList: test.NewMockListFn(nil)},
+			args: tstutil.Args{
+				Managed: tp(tpWithStatus(*tpObservation())),
+			},
+			want: want{
+				mg:  tp(tpWithStatus(*tpObservation()), tpWithConditions(cpv1alpha1.Deleting())),
+				err: nil,
+			},
+		},
+		"DependentsExist": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						t.Errorf("r: unexpected %s request for a trusted profile with dependents", r.Method)
+					},
+				},
+			},
+			kube: &test.MockClient{MockList: test.NewMockListFn(nil, func(obj runtime.Object) error {
+				if l, ok := obj.(*v1alpha1.TrustedProfileLinkList); ok {
+					k := v1alpha1.TrustedProfileLink{}
+					k.SetName("mylink")
+					k.Spec.ForProvider.ProfileIDRef = &cpv1alpha1.Reference{Name: tpName}
+					l.Items = []v1alpha1.TrustedProfileLink{k}
+				}
+				return nil
+			})},
+			args: tstutil.Args{
+				Managed: tp(tpWithStatus(*tpObservation())),
+			},
+			want: want{
+				mg: tp(tpWithStatus(*tpObservation()), tpWithConditions(ibmc.DeletionBlocked(
+					errors.New("waiting for the deletion of the managed resources that depend on the resource: TrustedProfileLink/mylink")))),
+				err: errors.Wrap(errors.New("waiting for the deletion of the managed resources that depend on the resource: TrustedProfileLink/mylink"),
+					errDeleteTrustedProfile),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, errCr := setupServerAndGetUnitTestExternalTP(t, &tc.handlers, &tc.kube)
+			if errCr != nil {
+				t.Errorf("Delete(...): problem setting up the test server %s", errCr)
+			}
+
+			defer server.Close()
+
+			err := e.Delete(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				// the case where our mock server returns error.
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Delete(...): -want, +got:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Delete(...): -want, +got:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTrustedProfileUpdate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		upd managed.ExternalUpdate
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"Successful": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodPut, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff(eTag, r.Header.Get("If-Match")); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusOK)
+						_ = r.Body.Close()
+						err := json.NewEncoder(w).Encode(tpInstance())
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: tp(tpWithSpec(*tpParams()), tpWithStatus(*tpObservation())),
+			},
+			want: want{
+				mg:  tp(tpWithSpec(*tpParams()), tpWithStatus(*tpObservation())),
+				upd: managed.ExternalUpdate{},
+				err: nil,
+			},
+		},
+		"Conflict": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodPut, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusConflict)
+						_ = r.Body.Close()
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: tp(tpWithSpec(*tpParams()), tpWithStatus(*tpObservation())),
+			},
+			want: want{
+				mg:  tp(tpWithSpec(*tpParams()), tpWithStatus(*tpObservation())),
+				err: errors.Wrap(errors.New(http.StatusText(http.StatusConflict)), errUpdTrustedProfile),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, errCr := setupServerAndGetUnitTestExternalTP(t, &tc.handlers, &tc.kube)
+			if errCr != nil {
+				t.Errorf("Update(...): problem setting up the test server %s", errCr)
+			}
+
+			defer server.Close()
+
+			upd, err := e.Update(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				// the case where our mock server returns error.
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Update(...): -want, +got:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Update(...): -want, +got:\n%s", diff)
+				}
+			}
+			if tc.want.err == nil {
+				if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+					t.Errorf("Update(...): -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(tc.want.upd, upd); diff != "" {
+					t.Errorf("Update(...): -want, +got:\n%s", diff)
+				}
+			}
+		})
+	}
+}
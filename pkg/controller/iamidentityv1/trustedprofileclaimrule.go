@@ -0,0 +1,217 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iamidentityv1
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cpv1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/iamidentityv1/v1alpha1"
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+	ibmctp "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/trustedprofile"
+	ibmctpcr "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/trustedprofileclaimrule"
+)
+
+const (
+	errNotClaimRule        = "managed resource is not a TrustedProfileClaimRule custom resource"
+	errCreateClaimRule     = "could not create claim rule"
+	errDeleteClaimRule     = "could not delete claim rule"
+	errGetClaimRuleFailed  = "error getting claim rule"
+	errCreateClaimRuleOpts = "error creating claim rule options"
+	errUpdClaimRule        = "error updating claim rule"
+)
+
+// SetupTrustedProfileClaimRule adds a controller that reconciles TrustedProfileClaimRule managed resources.
+func SetupTrustedProfileClaimRule(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.TrustedProfileClaimRuleGroupKind)
+	log := l.WithValues("TrustedProfileClaimRule-controller", name)
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.TrustedProfileClaimRuleGroupVersionKind),
+		managed.WithExternalConnecter(ibmc.NewAuditConnecter(&tpcrConnector{
+			kube:     mgr.GetClient(),
+			usage:    resource.NewProviderConfigUsageTracker(mgr.GetClient(), &v1beta1.ProviderConfigUsage{}),
+			clientFn: ibmc.NewClient,
+			logger:   log}, event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()),
+			ibmc.NewExpiration(mgr.GetClient(), event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(log),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.TrustedProfileClaimRule{}).
+		Complete(ibmc.NewExpirationReconciler(mgr, resource.ManagedKind(v1alpha1.TrustedProfileClaimRuleGroupVersionKind), r))
+}
+
+// A tpcrConnector is expected to produce an ExternalClient when its Connect method
+// is called.
+type tpcrConnector struct {
+	kube     client.Client
+	usage    resource.Tracker
+	clientFn func(optd ibmc.ClientOptions) (ibmc.ClientSession, error)
+	logger   logging.Logger
+}
+
+// Connect produces an ExternalClient for IBM Cloud API
+func (c *tpcrConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	opts, err := ibmc.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetAuth)
+	}
+
+	service, err := c.clientFn(opts)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &tpcrExternal{client: ibmctp.NewClient(service.IamIdentityV1()), kube: c.kube, logger: c.logger}, nil
+}
+
+// A tpcrExternal observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type tpcrExternal struct {
+	client *ibmctp.Client
+	kube   client.Client
+	logger logging.Logger
+}
+
+func (c *tpcrExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.TrustedProfileClaimRule)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotClaimRule)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	instance, _, err := c.client.GetClaimRule(ctx, &ibmctp.GetClaimRuleOptions{
+		ProfileID: cr.Spec.ForProvider.ProfileID,
+		RuleID:    reference.ToPtrValue(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ibmc.IsResourceNotFound, err), errGetClaimRuleFailed)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	if err = ibmctpcr.LateInitializeSpec(&cr.Spec.ForProvider, instance); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errManagedUpdateFailed)
+	}
+	lateInitSpec := cr.Spec.ForProvider.DeepCopy()
+	if err = ibmc.RestrictLateInitialization(cr, currentSpec, &cr.Spec.ForProvider); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
+	}
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := c.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errManagedUpdateFailed)
+		}
+	}
+
+	cr.Status.AtProvider, err = ibmctpcr.GenerateObservation(instance)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGenObservation)
+	}
+
+	cr.Status.SetConditions(cpv1alpha1.Available())
+	cr.Status.AtProvider.State = ibmctpcr.StateActive
+
+	upToDate, err := ibmctpcr.IsUpToDate(lateInitSpec, instance, c.logger)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCheckUpToDate)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: nil,
+	}, nil
+}
+
+func (c *tpcrExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.TrustedProfileClaimRule)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotClaimRule)
+	}
+
+	cr.SetConditions(cpv1alpha1.Creating())
+	createOptions := &ibmctp.CreateClaimRuleOptions{}
+	if err := ibmctpcr.GenerateCreateClaimRuleOptions(cr.Spec.ForProvider, createOptions); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateClaimRuleOpts)
+	}
+
+	instance, _, err := c.client.CreateClaimRule(ctx, createOptions)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateClaimRule)
+	}
+
+	meta.SetExternalName(cr, reference.FromPtrValue(instance.ID))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (c *tpcrExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.TrustedProfileClaimRule)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotClaimRule)
+	}
+
+	updOpts := &ibmctp.UpdateClaimRuleOptions{}
+	if err := ibmctpcr.GenerateUpdateClaimRuleOptions(cr.Status.AtProvider.ID, cr.Status.AtProvider.EntityTag, cr.Spec.ForProvider, updOpts); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdClaimRule)
+	}
+
+	if _, _, err := c.client.UpdateClaimRule(ctx, updOpts); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdClaimRule)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *tpcrExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.TrustedProfileClaimRule)
+	if !ok {
+		return errors.New(errNotClaimRule)
+	}
+
+	cr.SetConditions(cpv1alpha1.Deleting())
+
+	_, err := c.client.DeleteClaimRule(ctx, &ibmctp.DeleteClaimRuleOptions{
+		ProfileID: cr.Spec.ForProvider.ProfileID,
+		RuleID:    &cr.Status.AtProvider.ID,
+	})
+	if err != nil {
+		return errors.Wrap(resource.Ignore(ibmc.IsResourceGone, err), errDeleteClaimRule)
+	}
+	return nil
+}
@@ -0,0 +1,624 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iamidentityv1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	cpv1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/iamidentityv1/v1alpha1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+	ibmctp "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/trustedprofile"
+	ibmctpcr "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/trustedprofileclaimrule"
+	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/controller/tstutil"
+)
+
+var (
+	tpcrName       = "myClaimRule"
+	tpcrID         = "ClaimRule-12345678-abcd-1a2b-a1b2-1234567890ab"
+	tpcrType       = "Profile-CR"
+	tpcrCRType     = "IKS_SA"
+	tpcrExpiration = int64(43200)
+	tpcrClaim      = "namespace"
+	tpcrOperator   = "EQUALS"
+	tpcrValue      = "default"
+	tpcrOtherValue = "kube-system"
+)
+
+var _ managed.ExternalConnecter = &tpcrConnector{}
+var _ managed.ExternalClient = &tpcrExternal{}
+
+type tpcrModifier func(*v1alpha1.TrustedProfileClaimRule)
+
+func tpcr(im ...tpcrModifier) *v1alpha1.TrustedProfileClaimRule {
+	i := &v1alpha1.TrustedProfileClaimRule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       tpcrName,
+			Finalizers: []string{},
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: tpcrID,
+			},
+		},
+		Spec: v1alpha1.TrustedProfileClaimRuleSpec{
+			ForProvider: v1alpha1.TrustedProfileClaimRuleParameters{},
+		},
+	}
+	for _, m := range im {
+		m(i)
+	}
+	return i
+}
+
+func tpcrWithExternalNameAnnotation(externalName string) tpcrModifier {
+	return func(i *v1alpha1.TrustedProfileClaimRule) {
+		if i.ObjectMeta.Annotations == nil {
+			i.ObjectMeta.Annotations = make(map[string]string)
+		}
+		i.ObjectMeta.Annotations[meta.AnnotationKeyExternalName] = externalName
+	}
+}
+
+func tpcrWithSpec(p v1alpha1.TrustedProfileClaimRuleParameters) tpcrModifier {
+	return func(r *v1alpha1.TrustedProfileClaimRule) { r.Spec.ForProvider = p }
+}
+
+func tpcrWithConditions(c ...cpv1alpha1.Condition) tpcrModifier {
+	return func(i *v1alpha1.TrustedProfileClaimRule) { i.Status.SetConditions(c...) }
+}
+
+func tpcrWithStatus(p v1alpha1.TrustedProfileClaimRuleObservation) tpcrModifier {
+	return func(r *v1alpha1.TrustedProfileClaimRule) { r.Status.AtProvider = p }
+}
+
+func tpcrParams(m ...func(*v1alpha1.TrustedProfileClaimRuleParameters)) *v1alpha1.TrustedProfileClaimRuleParameters {
+	p := &v1alpha1.TrustedProfileClaimRuleParameters{
+		ProfileID:  &tpID,
+		Type:       tpcrType,
+		Conditions: []v1alpha1.ProfileClaimRuleCondition{{Claim: tpcrClaim, Operator: tpcrOperator, Value: tpcrValue}},
+		Name:       &tpcrName,
+		CRType:     &tpcrCRType,
+		Expiration: &tpcrExpiration,
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func tpcrObservation(m ...func(*v1alpha1.TrustedProfileClaimRuleObservation)) *v1alpha1.TrustedProfileClaimRuleObservation {
+	o := &v1alpha1.TrustedProfileClaimRuleObservation{
+		ID:         tpcrID,
+		EntityTag:  eTag,
+		CreatedAt:  ibmc.DateTimeToMetaV1Time(&createdAt),
+		ModifiedAt: ibmc.DateTimeToMetaV1Time(&modifiedAt),
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+	return o
+}
+
+func tpcrInstance(m ...func(*ibmctp.ProfileClaimRule)) *ibmctp.ProfileClaimRule {
+	i := &ibmctp.ProfileClaimRule{
+		ID:         &tpcrID,
+		EntityTag:  &eTag,
+		CreatedAt:  &createdAt,
+		ModifiedAt: &modifiedAt,
+		Name:       &tpcrName,
+		Type:       &tpcrType,
+		CrType:     &tpcrCRType,
+		Expiration: &tpcrExpiration,
+		Conditions: []ibmctp.ProfileClaimRuleConditions{{Claim: &tpcrClaim, Operator: &tpcrOperator, Value: &tpcrValue}},
+	}
+
+	for _, f := range m {
+		f(i)
+	}
+	return i
+}
+
+// Sets up a unit test http server, and creates an external claim rule structure appropriate for unit test.
+func setupServerAndGetUnitTestExternalTPCR(testingObj *testing.T, handlers *[]tstutil.Handler, kube *client.Client) (*tpcrExternal, *httptest.Server, error) {
+	mClient, tstServer, err := tstutil.SetupTestServerClient(testingObj, handlers)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &tpcrExternal{
+			kube:   *kube,
+			client: ibmctp.NewClient((*mClient).IamIdentityV1()),
+			logger: logging.NewNopLogger(),
+		},
+		tstServer,
+		nil
+}
+
+func TestTrustedProfileClaimRuleObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"NotFound": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusNotFound)
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: tpcr(tpcrWithSpec(*tpcrParams())),
+			},
+			want: want{
+				mg:  tpcr(tpcrWithSpec(*tpcrParams())),
+				err: nil,
+			},
+		},
+		"UpToDate": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff("/v1/profiles/"+tpID+"/rules/"+tpcrID, r.URL.Path); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						err := json.NewEncoder(w).Encode(tpcrInstance())
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: tstutil.Args{
+				Managed: tpcr(
+					tpcrWithExternalNameAnnotation(tpcrID),
+					tpcrWithSpec(*tpcrParams(func(p *v1alpha1.TrustedProfileClaimRuleParameters) {
+						p.Expiration = nil
+					})),
+				),
+			},
+			want: want{
+				mg: tpcr(tpcrWithSpec(*tpcrParams()),
+					tpcrWithConditions(cpv1alpha1.Available()),
+					tpcrWithStatus(*tpcrObservation(func(o *v1alpha1.TrustedProfileClaimRuleObservation) {
+						o.State = ibmctpcr.StateActive
+					}))),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: nil,
+				},
+			},
+		},
+		"NotUpToDate": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						err := json.NewEncoder(w).Encode(tpcrInstance(func(i *ibmctp.ProfileClaimRule) {
+							i.Conditions = []ibmctp.ProfileClaimRuleConditions{{Claim: &tpcrClaim, Operator: &tpcrOperator, Value: &tpcrOtherValue}}
+						}))
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: tstutil.Args{
+				Managed: tpcr(
+					tpcrWithExternalNameAnnotation(tpcrID),
+					tpcrWithSpec(*tpcrParams()),
+				),
+			},
+			want: want{
+				mg: tpcr(tpcrWithSpec(*tpcrParams()),
+					tpcrWithConditions(cpv1alpha1.Available()),
+					tpcrWithStatus(*tpcrObservation(func(o *v1alpha1.TrustedProfileClaimRuleObservation) {
+						o.State = ibmctpcr.StateActive
+					}))),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: nil,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, errCr := setupServerAndGetUnitTestExternalTPCR(t, &tc.handlers, &tc.kube)
+			if errCr != nil {
+				t.Errorf("Observe(...): problem setting up the test server %s", errCr)
+			}
+
+			defer server.Close()
+
+			obs, err := e.Observe(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				// the case where our mock server returns error.
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Observe(...): want error string != got error string:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Observe(...): want error != got error:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTrustedProfileClaimRuleCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		cre managed.ExternalCreation
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"Successful": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff("/v1/profiles/"+tpID+"/rules", r.URL.Path); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusCreated)
+						_ = r.Body.Close()
+						err := json.NewEncoder(w).Encode(tpcrInstance())
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: tpcr(tpcrWithSpec(*tpcrParams())),
+			},
+			want: want{
+				mg: tpcr(tpcrWithSpec(*tpcrParams()),
+					tpcrWithConditions(cpv1alpha1.Creating()),
+					tpcrWithExternalNameAnnotation(tpcrID)),
+				cre: managed.ExternalCreation{ExternalNameAssigned: true},
+				err: nil,
+			},
+		},
+		"BadRequest": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusBadRequest)
+						_ = r.Body.Close()
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: tpcr(tpcrWithSpec(*tpcrParams())),
+			},
+			want: want{
+				mg: tpcr(tpcrWithSpec(*tpcrParams()),
+					tpcrWithConditions(cpv1alpha1.Creating())),
+				cre: managed.ExternalCreation{ExternalNameAssigned: false},
+				err: errors.Wrap(errors.New(http.StatusText(http.StatusBadRequest)), errCreateClaimRule),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, errCr := setupServerAndGetUnitTestExternalTPCR(t, &tc.handlers, &tc.kube)
+			if errCr != nil {
+				t.Errorf("Create(...): problem setting up the test server %s", errCr)
+			}
+
+			defer server.Close()
+
+			cre, err := e.Create(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				// the case where our mock server returns error.
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Create(...): -want, +got:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Create(...): -want, +got:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.cre, cre); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTrustedProfileClaimRuleDelete(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"Successful": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff("/v1/profiles/"+tpID+"/rules/"+tpcrID, r.URL.Path); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusNoContent)
+						_ = r.Body.Close()
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: tpcr(tpcrWithSpec(*tpcrParams()), tpcrWithStatus(*tpcrObservation())),
+			},
+			want: want{
+				mg:  tpcr(tpcrWithSpec(*tpcrParams()), tpcrWithStatus(*tpcrObservation()), tpcrWithConditions(cpv1alpha1.Deleting())),
+				err: nil,
+			},
+		},
+		"AlreadyGone": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusNotFound)
+						_ = r.Body.Close()
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: tpcr(tpcrWithSpec(*tpcrParams()), tpcrWithStatus(*tpcrObservation())),
+			},
+			want: want{
+				mg:  tpcr(tpcrWithSpec(*tpcrParams()), tpcrWithStatus(*tpcrObservation()), tpcrWithConditions(cpv1alpha1.Deleting())),
+				err: nil,
+			},
+		},
+		"Forbidden": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusForbidden)
+						_ = r.Body.Close()
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: tpcr(tpcrWithSpec(*tpcrParams()), tpcrWithStatus(*tpcrObservation())),
+			},
+			want: want{
+				mg:  tpcr(tpcrWithSpec(*tpcrParams()), tpcrWithStatus(*tpcrObservation()), tpcrWithConditions(cpv1alpha1.Deleting())),
+				err: errors.Wrap(errors.New(http.StatusText(http.StatusForbidden)), errDeleteClaimRule),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, errCr := setupServerAndGetUnitTestExternalTPCR(t, &tc.handlers, &tc.kube)
+			if errCr != nil {
+				t.Errorf("Delete(...): problem setting up the test server %s", errCr)
+			}
+
+			defer server.Close()
+
+			err := e.Delete(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				// the case where our mock server returns error.
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Delete(...): -want, +got:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Delete(...): -want, +got:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTrustedProfileClaimRuleUpdate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		upd managed.ExternalUpdate
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"Successful": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodPut, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff(eTag, r.Header.Get("If-Match")); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusOK)
+						_ = r.Body.Close()
+						err := json.NewEncoder(w).Encode(tpcrInstance())
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: tpcr(tpcrWithSpec(*tpcrParams()), tpcrWithStatus(*tpcrObservation())),
+			},
+			want: want{
+				mg:  tpcr(tpcrWithSpec(*tpcrParams()), tpcrWithStatus(*tpcrObservation())),
+				upd: managed.ExternalUpdate{},
+				err: nil,
+			},
+		},
+		"Conflict": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodPut, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusConflict)
+						_ = r.Body.Close()
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: tpcr(tpcrWithSpec(*tpcrParams()), tpcrWithStatus(*tpcrObservation())),
+			},
+			want: want{
+				mg:  tpcr(tpcrWithSpec(*tpcrParams()), tpcrWithStatus(*tpcrObservation())),
+				err: errors.Wrap(errors.New(http.StatusText(http.StatusConflict)), errUpdClaimRule),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, errCr := setupServerAndGetUnitTestExternalTPCR(t, &tc.handlers, &tc.kube)
+			if errCr != nil {
+				t.Errorf("Update(...): problem setting up the test server %s", errCr)
+			}
+
+			defer server.Close()
+
+			upd, err := e.Update(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				// the case where our mock server returns error.
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Update(...): -want, +got:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Update(...): -want, +got:\n%s", diff)
+				}
+			}
+			if tc.want.err == nil {
+				if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+					t.Errorf("Update(...): -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(tc.want.upd, upd); diff != "" {
+					t.Errorf("Update(...): -want, +got:\n%s", diff)
+				}
+			}
+		})
+	}
+}
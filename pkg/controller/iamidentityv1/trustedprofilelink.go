@@ -0,0 +1,201 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iamidentityv1
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cpv1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/iamidentityv1/v1alpha1"
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+	ibmctp "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/trustedprofile"
+	ibmctpl "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/trustedprofilelink"
+)
+
+const (
+	errNotLink        = "managed resource is not a TrustedProfileLink custom resource"
+	errCreateLink     = "could not create trusted profile link"
+	errDeleteLink     = "could not delete trusted profile link"
+	errGetLinkFailed  = "error getting trusted profile link"
+	errCreateLinkOpts = "error creating trusted profile link options"
+)
+
+// SetupTrustedProfileLink adds a controller that reconciles TrustedProfileLink managed resources.
+func SetupTrustedProfileLink(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.TrustedProfileLinkGroupKind)
+	log := l.WithValues("TrustedProfileLink-controller", name)
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.TrustedProfileLinkGroupVersionKind),
+		managed.WithExternalConnecter(ibmc.NewAuditConnecter(&tplConnector{
+			kube:     mgr.GetClient(),
+			usage:    resource.NewProviderConfigUsageTracker(mgr.GetClient(), &v1beta1.ProviderConfigUsage{}),
+			clientFn: ibmc.NewClient,
+			logger:   log}, event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()),
+			ibmc.NewExpiration(mgr.GetClient(), event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(log),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.TrustedProfileLink{}).
+		Complete(ibmc.NewExpirationReconciler(mgr, resource.ManagedKind(v1alpha1.TrustedProfileLinkGroupVersionKind), r))
+}
+
+// A tplConnector is expected to produce an ExternalClient when its Connect method
+// is called.
+type tplConnector struct {
+	kube     client.Client
+	usage    resource.Tracker
+	clientFn func(optd ibmc.ClientOptions) (ibmc.ClientSession, error)
+	logger   logging.Logger
+}
+
+// Connect produces an ExternalClient for IBM Cloud API
+func (c *tplConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	opts, err := ibmc.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetAuth)
+	}
+
+	service, err := c.clientFn(opts)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &tplExternal{client: ibmctp.NewClient(service.IamIdentityV1()), kube: c.kube, logger: c.logger}, nil
+}
+
+// A tplExternal observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type tplExternal struct {
+	client *ibmctp.Client
+	kube   client.Client
+	logger logging.Logger
+}
+
+func (c *tplExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.TrustedProfileLink)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotLink)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	instance, _, err := c.client.GetLink(ctx, &ibmctp.GetLinkOptions{
+		ProfileID: cr.Spec.ForProvider.ProfileID,
+		LinkID:    reference.ToPtrValue(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ibmc.IsResourceNotFound, err), errGetLinkFailed)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	if err = ibmctpl.LateInitializeSpec(&cr.Spec.ForProvider, instance); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errManagedUpdateFailed)
+	}
+	if err = ibmc.RestrictLateInitialization(cr, currentSpec, &cr.Spec.ForProvider); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
+	}
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := c.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errManagedUpdateFailed)
+		}
+	}
+
+	cr.Status.AtProvider, err = ibmctpl.GenerateObservation(instance)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGenObservation)
+	}
+
+	cr.Status.SetConditions(cpv1alpha1.Available())
+	cr.Status.AtProvider.State = ibmctpl.StateActive
+
+	// links cannot be updated, all their parameters are immutable
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  true,
+		ConnectionDetails: nil,
+	}, nil
+}
+
+func (c *tplExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.TrustedProfileLink)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotLink)
+	}
+
+	cr.SetConditions(cpv1alpha1.Creating())
+	createOptions := &ibmctp.CreateLinkOptions{}
+	if err := ibmctpl.GenerateCreateLinkOptions(cr.Spec.ForProvider, createOptions); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateLinkOpts)
+	}
+
+	instance, _, err := c.client.CreateLink(ctx, createOptions)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateLink)
+	}
+
+	meta.SetExternalName(cr, reference.FromPtrValue(instance.ID))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+// Update does nothing, as the parameters of a link cannot be changed
+func (c *tplExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	if _, ok := mg.(*v1alpha1.TrustedProfileLink); !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotLink)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *tplExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.TrustedProfileLink)
+	if !ok {
+		return errors.New(errNotLink)
+	}
+
+	cr.SetConditions(cpv1alpha1.Deleting())
+
+	_, err := c.client.DeleteLink(ctx, &ibmctp.DeleteLinkOptions{
+		ProfileID: cr.Spec.ForProvider.ProfileID,
+		LinkID:    &cr.Status.AtProvider.ID,
+	})
+	if err != nil {
+		return errors.Wrap(resource.Ignore(ibmc.IsResourceGone, err), errDeleteLink)
+	}
+	return nil
+}
@@ -0,0 +1,487 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iamidentityv1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	cpv1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/iamidentityv1/v1alpha1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+	ibmctp "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/trustedprofile"
+	ibmctpl "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/trustedprofilelink"
+	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/controller/tstutil"
+)
+
+var (
+	tplName           = "myLink"
+	tplID             = "ProfileLink-12345678-abcd-1a2b-a1b2-1234567890ab"
+	tplCRType         = "IKS_SA"
+	tplClusterCRN     = "crn:v1:bluemix:public:containers-kubernetes:us-south:a/aa5a00334eaf9eb9339d2ab48f20d7ff:c1234::"
+	tplNamespace      = "default"
+	tplServiceAccount = "my-app"
+)
+
+var _ managed.ExternalConnecter = &tplConnector{}
+var _ managed.ExternalClient = &tplExternal{}
+
+type tplModifier func(*v1alpha1.TrustedProfileLink)
+
+func tpl(im ...tplModifier) *v1alpha1.TrustedProfileLink {
+	i := &v1alpha1.TrustedProfileLink{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       tplName,
+			Finalizers: []string{},
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: tplID,
+			},
+		},
+		Spec: v1alpha1.TrustedProfileLinkSpec{
+			ForProvider: v1alpha1.TrustedProfileLinkParameters{},
+		},
+	}
+	for _, m := range im {
+		m(i)
+	}
+	return i
+}
+
+func tplWithExternalNameAnnotation(externalName string) tplModifier {
+	return func(i *v1alpha1.TrustedProfileLink) {
+		if i.ObjectMeta.Annotations == nil {
+			i.ObjectMeta.Annotations = make(map[string]string)
+		}
+		i.ObjectMeta.Annotations[meta.AnnotationKeyExternalName] = externalName
+	}
+}
+
+func tplWithSpec(p v1alpha1.TrustedProfileLinkParameters) tplModifier {
+	return func(r *v1alpha1.TrustedProfileLink) { r.Spec.ForProvider = p }
+}
+
+func tplWithConditions(c ...cpv1alpha1.Condition) tplModifier {
+	return func(i *v1alpha1.TrustedProfileLink) { i.Status.SetConditions(c...) }
+}
+
+func tplWithStatus(p v1alpha1.TrustedProfileLinkObservation) tplModifier {
+	return func(r *v1alpha1.TrustedProfileLink) { r.Status.AtProvider = p }
+}
+
+func tplParams(m ...func(*v1alpha1.TrustedProfileLinkParameters)) *v1alpha1.TrustedProfileLinkParameters {
+	p := &v1alpha1.TrustedProfileLinkParameters{
+		ProfileID: &tpID,
+		Name:      &tplName,
+		CRType:    tplCRType,
+		Link: v1alpha1.ProfileLinkLink{
+			CRN:            tplClusterCRN,
+			Namespace:      tplNamespace,
+			ServiceAccount: &tplServiceAccount,
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func tplObservation(m ...func(*v1alpha1.TrustedProfileLinkObservation)) *v1alpha1.TrustedProfileLinkObservation {
+	o := &v1alpha1.TrustedProfileLinkObservation{
+		ID:         tplID,
+		EntityTag:  eTag,
+		CreatedAt:  ibmc.DateTimeToMetaV1Time(&createdAt),
+		ModifiedAt: ibmc.DateTimeToMetaV1Time(&modifiedAt),
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+	return o
+}
+
+func tplInstance(m ...func(*ibmctp.ProfileLink)) *ibmctp.ProfileLink {
+	i := &ibmctp.ProfileLink{
+		ID:         &tplID,
+		EntityTag:  &eTag,
+		CreatedAt:  &createdAt,
+		ModifiedAt: &modifiedAt,
+		Name:       &tplName,
+		CrType:     &tplCRType,
+		Link: &ibmctp.ProfileLinkLink{
+			CRN:       &tplClusterCRN,
+			Namespace: &tplNamespace,
+			Name:      &tplServiceAccount,
+		},
+	}
+
+	for _, f := range m {
+		f(i)
+	}
+	return i
+}
+
+// Sets up a unit test http server, and creates an external link structure appropriate for unit test.
+func setupServerAndGetUnitTestExternalTPL(testingObj *testing.T, handlers *[]tstutil.Handler, kube *client.Client) (*tplExternal, *httptest.Server, error) {
+	mClient, tstServer, err := tstutil.SetupTestServerClient(testingObj, handlers)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &tplExternal{
+			kube:   *kube,
+			client: ibmctp.NewClient((*mClient).IamIdentityV1()),
+			logger: logging.NewNopLogger(),
+		},
+		tstServer,
+		nil
+}
+
+func TestTrustedProfileLinkObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"NotFound": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusNotFound)
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: tpl(tplWithSpec(*tplParams())),
+			},
+			want: want{
+				mg:  tpl(tplWithSpec(*tplParams())),
+				err: nil,
+			},
+		},
+		"UpToDate": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff("/v1/profiles/"+tpID+"/links/"+tplID, r.URL.Path); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						err := json.NewEncoder(w).Encode(tplInstance())
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: tstutil.Args{
+				Managed: tpl(
+					tplWithExternalNameAnnotation(tplID),
+					tplWithSpec(*tplParams(func(p *v1alpha1.TrustedProfileLinkParameters) {
+						p.Name = nil
+						p.Link.ServiceAccount = nil
+					})),
+				),
+			},
+			want: want{
+				mg: tpl(tplWithSpec(*tplParams()),
+					tplWithConditions(cpv1alpha1.Available()),
+					tplWithStatus(*tplObservation(func(o *v1alpha1.TrustedProfileLinkObservation) {
+						o.State = ibmctpl.StateActive
+					}))),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: nil,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, errCr := setupServerAndGetUnitTestExternalTPL(t, &tc.handlers, &tc.kube)
+			if errCr != nil {
+				t.Errorf("Observe(...): problem setting up the test server %s", errCr)
+			}
+
+			defer server.Close()
+
+			obs, err := e.Observe(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				// the case where our mock server returns error.
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Observe(...): want error string != got error string:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Observe(...): want error != got error:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTrustedProfileLinkCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		cre managed.ExternalCreation
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"Successful": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff("/v1/profiles/"+tpID+"/links", r.URL.Path); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusCreated)
+						_ = r.Body.Close()
+						err := json.NewEncoder(w).Encode(tplInstance())
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: tpl(tplWithSpec(*tplParams())),
+			},
+			want: want{
+				mg: tpl(tplWithSpec(*tplParams()),
+					tplWithConditions(cpv1alpha1.Creating()),
+					tplWithExternalNameAnnotation(tplID)),
+				cre: managed.ExternalCreation{ExternalNameAssigned: true},
+				err: nil,
+			},
+		},
+		"BadRequest": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusBadRequest)
+						_ = r.Body.Close()
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: tpl(tplWithSpec(*tplParams())),
+			},
+			want: want{
+				mg: tpl(tplWithSpec(*tplParams()),
+					tplWithConditions(cpv1alpha1.Creating())),
+				cre: managed.ExternalCreation{ExternalNameAssigned: false},
+				err: errors.Wrap(errors.New(http.StatusText(http.StatusBadRequest)), errCreateLink),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, errCr := setupServerAndGetUnitTestExternalTPL(t, &tc.handlers, &tc.kube)
+			if errCr != nil {
+				t.Errorf("Create(...): problem setting up the test server %s", errCr)
+			}
+
+			defer server.Close()
+
+			cre, err := e.Create(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				// the case where our mock server returns error.
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Create(...): -want, +got:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Create(...): -want, +got:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.cre, cre); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTrustedProfileLinkDelete(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"Successful": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff("/v1/profiles/"+tpID+"/links/"+tplID, r.URL.Path); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusNoContent)
+						_ = r.Body.Close()
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: tpl(tplWithSpec(*tplParams()), tplWithStatus(*tplObservation())),
+			},
+			want: want{
+				mg:  tpl(tplWithSpec(*tplParams()), tplWithStatus(*tplObservation()), tplWithConditions(cpv1alpha1.Deleting())),
+				err: nil,
+			},
+		},
+		"AlreadyGone": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusNotFound)
+						_ = r.Body.Close()
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: tpl(tplWithSpec(*tplParams()), tplWithStatus(*tplObservation())),
+			},
+			want: want{
+				mg:  tpl(tplWithSpec(*tplParams()), tplWithStatus(*tplObservation()), tplWithConditions(cpv1alpha1.Deleting())),
+				err: nil,
+			},
+		},
+		"Forbidden": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusForbidden)
+						_ = r.Body.Close()
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: tpl(tplWithSpec(*tplParams()), tplWithStatus(*tplObservation())),
+			},
+			want: want{
+				mg:  tpl(tplWithSpec(*tplParams()), tplWithStatus(*tplObservation()), tplWithConditions(cpv1alpha1.Deleting())),
+				err: errors.Wrap(errors.New(http.StatusText(http.StatusForbidden)), errDeleteLink),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, errCr := setupServerAndGetUnitTestExternalTPL(t, &tc.handlers, &tc.kube)
+			if errCr != nil {
+				t.Errorf("Delete(...): problem setting up the test server %s", errCr)
+			}
+
+			defer server.Close()
+
+			err := e.Delete(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				// the case where our mock server returns error.
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Delete(...): -want, +got:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Delete(...): -want, +got:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -57,12 +57,13 @@ func SetupCustomRole(mgr ctrl.Manager, l logging.Logger) error {
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.CustomRoleGroupVersionKind),
-		managed.WithExternalConnecter(&crConnector{
+		managed.WithExternalConnecter(ibmc.NewAuditConnecter(&crConnector{
 			kube:     mgr.GetClient(),
 			usage:    resource.NewProviderConfigUsageTracker(mgr.GetClient(), &v1beta1.ProviderConfigUsage{}),
 			clientFn: ibmc.NewClient,
-			logger:   log}),
-		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			logger:   log}, event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()),
+			ibmc.NewExpiration(mgr.GetClient(), event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
 		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 		managed.WithLogger(log),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
@@ -70,7 +71,7 @@ func SetupCustomRole(mgr ctrl.Manager, l logging.Logger) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		For(&v1alpha1.CustomRole{}).
-		Complete(r)
+		Complete(ibmc.NewExpirationReconciler(mgr, resource.ManagedKind(v1alpha1.CustomRoleGroupVersionKind), r))
 }
 
 // A crConnector is expected to produce an ExternalClient when its Connect method
@@ -127,21 +128,32 @@ func (c *crExternal) Observe(ctx context.Context, mg resource.Managed) (managed.
 	if err = ibmccr.LateInitializeSpec(&cr.Spec.ForProvider, instance); err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errManagedUpdateFailed)
 	}
+	lateInitSpec := cr.Spec.ForProvider.DeepCopy()
+	if err = ibmc.RestrictLateInitialization(cr, currentSpec, &cr.Spec.ForProvider); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
+	}
 	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
 		if err := c.kube.Update(ctx, cr); err != nil {
 			return managed.ExternalObservation{}, errors.Wrap(err, errManagedUpdateFailed)
 		}
 	}
 
+	supersetSystemRoles := cr.Status.AtProvider.SupersetSystemRoles
 	cr.Status.AtProvider, err = ibmccr.GenerateObservation(instance)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errGenObservation)
 	}
+	cr.Status.AtProvider.SupersetSystemRoles = supersetSystemRoles
 
 	cr.Status.SetConditions(cpv1alpha1.Available())
 	cr.Status.AtProvider.State = ibmccr.StateActive
 
-	upToDate, err := ibmccr.IsUpToDate(&cr.Spec.ForProvider, instance, c.logger)
+	// the outcome is reflected in the conditions and status of the role, Create and Update act on it
+	if err := ibmccr.CheckActions(c.client, cr); err != nil {
+		c.logger.Debug("Cannot validate the actions of the role", "error", err)
+	}
+
+	upToDate, err := ibmccr.IsUpToDate(lateInitSpec, instance, c.logger)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errCheckUpToDate)
 	}
@@ -160,6 +172,10 @@ func (c *crExternal) Create(ctx context.Context, mg resource.Managed) (managed.E
 	}
 
 	cr.SetConditions(cpv1alpha1.Creating())
+	if err := ibmccr.CheckActions(c.client, cr); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateCustomRole)
+	}
+
 	resInstanceOptions := &iampmv1.CreateRoleOptions{}
 	if err := ibmccr.GenerateCreateCustomRoleOptions(cr.Spec.ForProvider, resInstanceOptions); err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreateCustomRoleOpts)
@@ -180,6 +196,10 @@ func (c *crExternal) Update(ctx context.Context, mg resource.Managed) (managed.E
 		return managed.ExternalUpdate{}, errors.New(errNotCustomRole)
 	}
 
+	if err := ibmccr.CheckActions(c.client, cr); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdCustomRole)
+	}
+
 	id := cr.Status.AtProvider.ID
 	eTag := ibmc.GetEtagAnnotation(cr)
 	updInstanceOpts := &iampmv1.UpdateRoleOptions{}
@@ -46,8 +46,9 @@ import (
 )
 
 const (
-	errCrBadRequest = "error getting role: Bad Request"
-	errCrForbidden  = "error getting role: Forbidden"
+	errCrBadRequest  = "error getting role: Bad Request"
+	errCrForbidden   = "error getting role: Forbidden"
+	errCrUnsupported = "actions not supported by service \"mypostgres\": iam.policy.delete"
 )
 
 var (
@@ -58,9 +59,13 @@ var (
 	serviceName      = "mypostgres"
 	action1          = "iam.policy.create"
 	action2          = "iam.policy.update"
+	action3          = "iam.policy.delete"
 	cRoleID          = "12345678-abcd-1a2b-a1b2-1234567890ab"
 	crHRef           = "https://iam.cloud.ibm.com/v1/roles/12345678-abcd-1a2b-a1b2-1234567890ab"
 	crCrn            = "crn:v1:bluemix:public:iam::::role:" + roleName
+	crViewerCrn      = "crn:v1:bluemix:public:iam::::role:Viewer"
+	crEditorCrn      = "crn:v1:bluemix:public:iam::::role:Editor"
+	crManagerCrn     = "crn:v1:bluemix:public:iam::::serviceRole:Manager"
 )
 
 var _ managed.ExternalConnecter = &pConnector{}
@@ -172,6 +177,31 @@ func crInstance(m ...func(*iampmv1.CustomRole)) *iampmv1.CustomRole {
 	return i
 }
 
+// crWithListRoles wraps a handler to also list the roles of the service, which support action1 and action2
+func crWithListRoles(t *testing.T, h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/v2/roles" {
+			h(w, r)
+			return
+		}
+		_ = r.Body.Close()
+		if diff := cmp.Diff(serviceName, r.URL.Query().Get("service_name")); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		err := json.NewEncoder(w).Encode(&iampmv1.RoleList{
+			ServiceRoles: []iampmv1.Role{{CRN: &crManagerCrn, Actions: []string{action2}}},
+			SystemRoles: []iampmv1.Role{
+				{CRN: &crViewerCrn, Actions: []string{action1}},
+				{CRN: &crEditorCrn, Actions: []string{action1, action2}},
+			},
+		})
+		if err != nil {
+			klog.Errorf("%s", err)
+		}
+	}
+}
+
 // Sets up a unit test http server, and creates an external client structure appropriate for unit test.
 //
 // Params
@@ -296,7 +326,7 @@ func TestCustomRoleObserve(t *testing.T) {
 			handlers: []tstutil.Handler{
 				{
 					Path: "/",
-					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+					HandlerFunc: crWithListRoles(t, func(w http.ResponseWriter, r *http.Request) {
 						_ = r.Body.Close()
 						if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
 							t.Errorf("r: -want, +got:\n%s", diff)
@@ -308,7 +338,7 @@ func TestCustomRoleObserve(t *testing.T) {
 						if err != nil {
 							klog.Errorf("%s", err)
 						}
-					},
+					}),
 				},
 			},
 			kube: &test.MockClient{
@@ -322,9 +352,10 @@ func TestCustomRoleObserve(t *testing.T) {
 			},
 			want: want{
 				mg: cr(crWithSpec(*crParams()),
-					crWithConditions(cpv1alpha1.Available()),
+					crWithConditions(cpv1alpha1.Available(), ibmccr.ActionsValid()),
 					crWithStatus(*crObservation(func(cro *v1alpha1.CustomRoleObservation) {
 						cro.State = ibmccr.StateActive
+						cro.SupersetSystemRoles = []string{crEditorCrn}
 					})),
 					crWithEtagAnnotation(eTag)),
 				obs: managed.ExternalObservation{
@@ -338,7 +369,7 @@ func TestCustomRoleObserve(t *testing.T) {
 			handlers: []tstutil.Handler{
 				{
 					Path: "/",
-					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+					HandlerFunc: crWithListRoles(t, func(w http.ResponseWriter, r *http.Request) {
 						_ = r.Body.Close()
 						if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
 							t.Errorf("r: -want, +got:\n%s", diff)
@@ -352,7 +383,7 @@ func TestCustomRoleObserve(t *testing.T) {
 						if err != nil {
 							klog.Errorf("%s", err)
 						}
-					},
+					}),
 				},
 			},
 			kube: &test.MockClient{
@@ -367,9 +398,10 @@ func TestCustomRoleObserve(t *testing.T) {
 			want: want{
 				mg: cr(crWithSpec(*crParams()),
 					crWithEtagAnnotation(eTag),
-					crWithConditions(cpv1alpha1.Available()),
+					crWithConditions(cpv1alpha1.Available(), ibmccr.ActionsValid()),
 					crWithStatus(*crObservation(func(cro *v1alpha1.CustomRoleObservation) {
 						cro.State = ibmccr.StateActive
+						cro.SupersetSystemRoles = []string{crEditorCrn}
 					}))),
 				obs: managed.ExternalObservation{
 					ResourceExists:    true,
@@ -426,7 +458,7 @@ func TestCustomRoleCreate(t *testing.T) {
 			handlers: []tstutil.Handler{
 				{
 					Path: "/",
-					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+					HandlerFunc: crWithListRoles(t, func(w http.ResponseWriter, r *http.Request) {
 						if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
 							t.Errorf("r: -want, +got:\n%s", diff)
 						}
@@ -438,7 +470,7 @@ func TestCustomRoleCreate(t *testing.T) {
 						if err != nil {
 							klog.Errorf("%s", err)
 						}
-					},
+					}),
 				},
 			},
 			args: tstutil.Args{
@@ -446,17 +478,43 @@ func TestCustomRoleCreate(t *testing.T) {
 			},
 			want: want{
 				mg: cr(crWithSpec(*crParams()),
-					crWithConditions(cpv1alpha1.Creating()),
+					crWithConditions(cpv1alpha1.Creating(), ibmccr.ActionsValid()),
+					crWithStatus(v1alpha1.CustomRoleObservation{SupersetSystemRoles: []string{crEditorCrn}}),
 					crWithExternalNameAnnotation(policyID)),
 				cre: managed.ExternalCreation{ExternalNameAssigned: true},
 				err: nil,
 			},
 		},
+		"UnsupportedActions": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: crWithListRoles(t, func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						t.Errorf("r: unexpected %s request for a role with unsupported actions", r.Method)
+					}),
+				},
+			},
+			args: tstutil.Args{
+				Managed: cr(crWithSpec(*crParams(func(p *v1alpha1.CustomRoleParameters) {
+					p.Actions = append(p.Actions, action3)
+				}))),
+			},
+			want: want{
+				mg: cr(crWithSpec(*crParams(func(p *v1alpha1.CustomRoleParameters) {
+					p.Actions = append(p.Actions, action3)
+				})),
+					crWithConditions(cpv1alpha1.Creating(), ibmccr.ActionsInvalid(errors.New(errCrUnsupported))),
+					crWithStatus(v1alpha1.CustomRoleObservation{SupersetSystemRoles: []string{}})),
+				cre: managed.ExternalCreation{ExternalNameAssigned: false},
+				err: errors.Wrap(errors.New(errCrUnsupported), errCreateCustomRole),
+			},
+		},
 		"BadRequest": {
 			handlers: []tstutil.Handler{
 				{
 					Path: "/",
-					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+					HandlerFunc: crWithListRoles(t, func(w http.ResponseWriter, r *http.Request) {
 						if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
 							t.Errorf("r: -want, +got:\n%s", diff)
 						}
@@ -468,7 +526,7 @@ func TestCustomRoleCreate(t *testing.T) {
 						if err != nil {
 							klog.Errorf("%s", err)
 						}
-					},
+					}),
 				},
 			},
 			args: tstutil.Args{
@@ -476,7 +534,8 @@ func TestCustomRoleCreate(t *testing.T) {
 			},
 			want: want{
 				mg: cr(crWithSpec(*crParams()),
-					crWithConditions(cpv1alpha1.Creating())),
+					crWithConditions(cpv1alpha1.Creating(), ibmccr.ActionsValid()),
+					crWithStatus(v1alpha1.CustomRoleObservation{SupersetSystemRoles: []string{crEditorCrn}})),
 				cre: managed.ExternalCreation{ExternalNameAssigned: false},
 				err: errors.Wrap(errors.New(http.StatusText(http.StatusBadRequest)), errCreateCustomRole),
 			},
@@ -485,7 +544,7 @@ func TestCustomRoleCreate(t *testing.T) {
 			handlers: []tstutil.Handler{
 				{
 					Path: "/",
-					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+					HandlerFunc: crWithListRoles(t, func(w http.ResponseWriter, r *http.Request) {
 						if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
 							t.Errorf("r: -want, +got:\n%s", diff)
 						}
@@ -497,7 +556,7 @@ func TestCustomRoleCreate(t *testing.T) {
 						if err != nil {
 							klog.Errorf("%s", err)
 						}
-					},
+					}),
 				},
 			},
 			args: tstutil.Args{
@@ -505,7 +564,8 @@ func TestCustomRoleCreate(t *testing.T) {
 			},
 			want: want{
 				mg: cr(crWithSpec(*crParams()),
-					crWithConditions(cpv1alpha1.Creating())),
+					crWithConditions(cpv1alpha1.Creating(), ibmccr.ActionsValid()),
+					crWithStatus(v1alpha1.CustomRoleObservation{SupersetSystemRoles: []string{crEditorCrn}})),
 				cre: managed.ExternalCreation{ExternalNameAssigned: false},
 				err: errors.Wrap(errors.New(http.StatusText(http.StatusConflict)), errCreateCustomRole),
 			},
@@ -514,7 +574,7 @@ func TestCustomRoleCreate(t *testing.T) {
 			handlers: []tstutil.Handler{
 				{
 					Path: "/",
-					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+					HandlerFunc: crWithListRoles(t, func(w http.ResponseWriter, r *http.Request) {
 						if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
 							t.Errorf("r: -want, +got:\n%s", diff)
 						}
@@ -526,7 +586,7 @@ func TestCustomRoleCreate(t *testing.T) {
 						if err != nil {
 							klog.Errorf("%s", err)
 						}
-					},
+					}),
 				},
 			},
 			args: tstutil.Args{
@@ -534,7 +594,8 @@ func TestCustomRoleCreate(t *testing.T) {
 			},
 			want: want{
 				mg: cr(crWithSpec(*crParams()),
-					crWithConditions(cpv1alpha1.Creating())),
+					crWithConditions(cpv1alpha1.Creating(), ibmccr.ActionsValid()),
+					crWithStatus(v1alpha1.CustomRoleObservation{SupersetSystemRoles: []string{crEditorCrn}})),
 				cre: managed.ExternalCreation{ExternalNameAssigned: false},
 				err: errors.Wrap(errors.New(http.StatusText(http.StatusForbidden)), errCreateCustomRole),
 			},
@@ -715,7 +776,7 @@ func TestCustomRoleUpdate(t *testing.T) {
 			handlers: []tstutil.Handler{
 				{
 					Path: "/",
-					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+					HandlerFunc: crWithListRoles(t, func(w http.ResponseWriter, r *http.Request) {
 						if diff := cmp.Diff(http.MethodPut, r.Method); diff != "" {
 							t.Errorf("r: -want, +got:\n%s", diff)
 						}
@@ -727,14 +788,18 @@ func TestCustomRoleUpdate(t *testing.T) {
 						if err != nil {
 							klog.Errorf("%s", err)
 						}
-					},
+					}),
 				},
 			},
 			args: tstutil.Args{
 				Managed: cr(crWithSpec(*crParams()), crWithStatus(*crObservation()), crWithEtagAnnotation(eTag)),
 			},
 			want: want{
-				mg:  cr(crWithSpec(*crParams()), crWithStatus(*crObservation()), crWithEtagAnnotation(eTag)),
+				mg: cr(crWithSpec(*crParams()), crWithEtagAnnotation(eTag),
+					crWithConditions(ibmccr.ActionsValid()),
+					crWithStatus(*crObservation(func(cro *v1alpha1.CustomRoleObservation) {
+						cro.SupersetSystemRoles = []string{crEditorCrn}
+					}))),
 				upd: managed.ExternalUpdate{},
 				err: nil,
 			},
@@ -743,14 +808,14 @@ func TestCustomRoleUpdate(t *testing.T) {
 			handlers: []tstutil.Handler{
 				{
 					Path: "/",
-					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+					HandlerFunc: crWithListRoles(t, func(w http.ResponseWriter, r *http.Request) {
 						if diff := cmp.Diff(http.MethodPut, r.Method); diff != "" {
 							t.Errorf("r: -want, +got:\n%s", diff)
 						}
 						w.Header().Set("Content-Type", "application/json")
 						w.WriteHeader(http.StatusBadRequest)
 						_ = r.Body.Close()
-					},
+					}),
 				},
 			},
 			args: tstutil.Args{
@@ -765,14 +830,14 @@ func TestCustomRoleUpdate(t *testing.T) {
 			handlers: []tstutil.Handler{
 				{
 					Path: "/",
-					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+					HandlerFunc: crWithListRoles(t, func(w http.ResponseWriter, r *http.Request) {
 						if diff := cmp.Diff(http.MethodPut, r.Method); diff != "" {
 							t.Errorf("r: -want, +got:\n%s", diff)
 						}
 						w.Header().Set("Content-Type", "application/json")
 						w.WriteHeader(http.StatusNotFound)
 						_ = r.Body.Close()
-					},
+					}),
 				},
 			},
 			args: tstutil.Args{
@@ -0,0 +1,216 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iampolicymanagementv1
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cpv1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/iampolicymanagementv1/v1alpha1"
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+	ibmcv2p "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/v2policy"
+)
+
+const (
+	errNotV2Policy        = "managed resource is not a V2Policy custom resource"
+	errCreateV2Policy     = "could not create v2 policy"
+	errDeleteV2Policy     = "could not delete v2 policy"
+	errGetV2PolicyFailed  = "error getting v2 policy"
+	errCreateV2PolicyOpts = "error creating v2 policy options"
+	errUpdV2Policy        = "error updating v2 policy"
+)
+
+// SetupV2Policy adds a controller that reconciles V2Policy managed resources.
+func SetupV2Policy(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.V2PolicyGroupKind)
+	log := l.WithValues("V2Policy-controller", name)
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.V2PolicyGroupVersionKind),
+		managed.WithExternalConnecter(ibmc.NewAuditConnecter(&v2pConnector{
+			kube:     mgr.GetClient(),
+			usage:    resource.NewProviderConfigUsageTracker(mgr.GetClient(), &v1beta1.ProviderConfigUsage{}),
+			clientFn: ibmc.NewClient,
+			logger:   log}, event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()),
+			ibmc.NewExpiration(mgr.GetClient(), event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(log),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.V2Policy{}).
+		Complete(ibmc.NewExpirationReconciler(mgr, resource.ManagedKind(v1alpha1.V2PolicyGroupVersionKind), r))
+}
+
+// A v2pConnector is expected to produce an ExternalClient when its Connect method
+// is called.
+type v2pConnector struct {
+	kube     client.Client
+	usage    resource.Tracker
+	clientFn func(optd ibmc.ClientOptions) (ibmc.ClientSession, error)
+	logger   logging.Logger
+}
+
+// Connect produces an ExternalClient for IBM Cloud API
+func (c *v2pConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	opts, err := ibmc.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetAuth)
+	}
+
+	service, err := c.clientFn(opts)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &v2pExternal{client: ibmcv2p.NewClient(service.IamPolicyManagementV1()), kube: c.kube, logger: c.logger}, nil
+}
+
+// A v2pExternal observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type v2pExternal struct {
+	client *ibmcv2p.Client
+	kube   client.Client
+	logger logging.Logger
+}
+
+func (c *v2pExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.V2Policy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotV2Policy)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	instance, resp, err := c.client.GetV2Policy(ctx, &ibmcv2p.GetV2PolicyOptions{ID: reference.ToPtrValue(meta.GetExternalName(cr))})
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ibmc.IsResourceNotFound, err), errGetV2PolicyFailed)
+	}
+	ibmc.SetEtagAnnotation(cr, ibmc.GetEtag(resp.Headers))
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	if err = ibmcv2p.LateInitializeSpec(&cr.Spec.ForProvider, instance); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errManagedUpdateFailed)
+	}
+	lateInitSpec := cr.Spec.ForProvider.DeepCopy()
+	if err = ibmc.RestrictLateInitialization(cr, currentSpec, &cr.Spec.ForProvider); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
+	}
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := c.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errManagedUpdateFailed)
+		}
+	}
+
+	cr.Status.AtProvider, err = ibmcv2p.GenerateObservation(instance)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGenObservation)
+	}
+
+	switch cr.Status.AtProvider.State {
+	case ibmcv2p.StateActive:
+		cr.Status.SetConditions(cpv1alpha1.Available())
+	default:
+		cr.Status.SetConditions(cpv1alpha1.Unavailable())
+	}
+
+	upToDate, err := ibmcv2p.IsUpToDate(lateInitSpec, instance, c.logger)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCheckUpToDate)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: nil,
+	}, nil
+}
+
+func (c *v2pExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.V2Policy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotV2Policy)
+	}
+
+	cr.SetConditions(cpv1alpha1.Creating())
+	createOpts := &ibmcv2p.CreateV2PolicyOptions{}
+	if err := ibmcv2p.GenerateCreateV2PolicyOptions(cr.Spec.ForProvider, createOpts); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateV2PolicyOpts)
+	}
+
+	instance, _, err := c.client.CreateV2Policy(ctx, createOpts)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateV2Policy)
+	}
+
+	meta.SetExternalName(cr, reference.FromPtrValue(instance.ID))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (c *v2pExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.V2Policy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotV2Policy)
+	}
+
+	updOpts := &ibmcv2p.UpdateV2PolicyOptions{}
+	if err := ibmcv2p.GenerateUpdateV2PolicyOptions(meta.GetExternalName(cr), ibmc.GetEtagAnnotation(cr), cr.Spec.ForProvider, updOpts); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdV2Policy)
+	}
+
+	_, _, err := c.client.UpdateV2Policy(ctx, updOpts)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdV2Policy)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *v2pExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.V2Policy)
+	if !ok {
+		return errors.New(errNotV2Policy)
+	}
+
+	cr.SetConditions(cpv1alpha1.Deleting())
+
+	_, err := c.client.DeleteV2Policy(ctx, &ibmcv2p.DeleteV2PolicyOptions{ID: reference.ToPtrValue(meta.GetExternalName(cr))})
+	if err != nil {
+		return errors.Wrap(resource.Ignore(ibmc.IsResourceGone, err), errDeleteV2Policy)
+	}
+	return nil
+}
@@ -0,0 +1,793 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iampolicymanagementv1
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cpv1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/iampolicymanagementv1/v1alpha1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+	ibmcv2p "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/v2policy"
+	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/controller/tstutil"
+)
+
+const (
+	errV2pBadRequest = "error getting v2 policy: Bad Request"
+)
+
+var (
+	v2pName            = "myV2Policy"
+	v2pDescription     = "break-glass access to my postgres"
+	v2pOtherRoleID     = "crn:v1:bluemix:public:iam::::role:Viewer"
+	v2pStringEquals    = "stringEquals"
+	v2pPattern         = "time-based-conditions:once"
+	v2pRuleOperator    = "and"
+	v2pRuleKey         = "{{environment.attributes.current_date_time}}"
+	v2pRuleFromOp      = "dateTimeGreaterThanOrEquals"
+	v2pRuleFrom        = "2026-10-18T09:00:00+00:00"
+	v2pRuleUntilOp     = "dateTimeLessThan"
+	v2pRuleUntil       = "2026-10-19T09:00:00+00:00"
+	v2pSubjectAttrKey  = "iam_id"
+	v2pResourceAttrKey = "serviceName"
+	v2pStateActive     = "active"
+	v2pStateDeleted    = "deleted"
+	v2pHref            = "https://iam.cloud.ibm.com/v2/policies/" + policyID
+)
+
+var _ managed.ExternalConnecter = &v2pConnector{}
+var _ managed.ExternalClient = &v2pExternal{}
+
+type v2pModifier func(*v1alpha1.V2Policy)
+
+func v2p(im ...v2pModifier) *v1alpha1.V2Policy {
+	i := &v1alpha1.V2Policy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       v2pName,
+			Finalizers: []string{},
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: policyID,
+			},
+		},
+		Spec: v1alpha1.V2PolicySpec{
+			ForProvider: v1alpha1.V2PolicyParameters{},
+		},
+	}
+	for _, m := range im {
+		m(i)
+	}
+	return i
+}
+
+func v2pWithExternalNameAnnotation(externalName string) v2pModifier {
+	return func(i *v1alpha1.V2Policy) {
+		if i.ObjectMeta.Annotations == nil {
+			i.ObjectMeta.Annotations = make(map[string]string)
+		}
+		i.ObjectMeta.Annotations[meta.AnnotationKeyExternalName] = externalName
+	}
+}
+
+func v2pWithEtagAnnotation(eTag string) v2pModifier {
+	return func(i *v1alpha1.V2Policy) {
+		if i.ObjectMeta.Annotations == nil {
+			i.ObjectMeta.Annotations = make(map[string]string)
+		}
+		i.ObjectMeta.Annotations[ibmc.ETagAnnotation] = eTag
+	}
+}
+
+func v2pWithSpec(p v1alpha1.V2PolicyParameters) v2pModifier {
+	return func(r *v1alpha1.V2Policy) { r.Spec.ForProvider = p }
+}
+
+func v2pWithConditions(c ...cpv1alpha1.Condition) v2pModifier {
+	return func(i *v1alpha1.V2Policy) { i.Status.SetConditions(c...) }
+}
+
+func v2pWithStatus(p v1alpha1.V2PolicyObservation) v2pModifier {
+	return func(r *v1alpha1.V2Policy) { r.Status.AtProvider = p }
+}
+
+func v2pParams(m ...func(*v1alpha1.V2PolicyParameters)) *v1alpha1.V2PolicyParameters {
+	p := &v1alpha1.V2PolicyParameters{
+		Type:        policyTypeAccess,
+		Description: &v2pDescription,
+		Subject: v1alpha1.V2PolicySubject{
+			Attributes: []v1alpha1.V2PolicySubjectAttribute{
+				{Key: v2pSubjectAttrKey, Operator: &v2pStringEquals, Value: &policyAttributeValue},
+			},
+		},
+		Roles: []v1alpha1.PolicyRole{{RoleID: roleID}},
+		Resource: v1alpha1.V2PolicyResource{
+			Attributes: []v1alpha1.V2PolicyResourceAttribute{
+				{Key: v2pResourceAttrKey, Operator: &v2pStringEquals, Value: &resAttr2Value},
+			},
+		},
+		Pattern: &v2pPattern,
+		Rule: &v1alpha1.V2PolicyRule{
+			Operator: v2pRuleOperator,
+			Conditions: []v1alpha1.V2PolicyRuleCondition{
+				{Key: v2pRuleKey, Operator: v2pRuleFromOp, Value: &v2pRuleFrom},
+				{Key: v2pRuleKey, Operator: v2pRuleUntilOp, Value: &v2pRuleUntil},
+			},
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func v2pObservation(m ...func(*v1alpha1.V2PolicyObservation)) *v1alpha1.V2PolicyObservation {
+	o := &v1alpha1.V2PolicyObservation{
+		ID:               policyID,
+		Href:             v2pHref,
+		CreatedAt:        ibmc.DateTimeToMetaV1Time(&createdAt),
+		CreatedByID:      createdByID,
+		LastModifiedAt:   ibmc.DateTimeToMetaV1Time(&lastModifiedAt),
+		LastModifiedByID: createdByID,
+		State:            v2pStateActive,
+	}
+	for _, f := range m {
+		f(o)
+	}
+	return o
+}
+
+func v2pInstance(m ...func(*ibmcv2p.V2Policy)) *ibmcv2p.V2Policy {
+	i := &ibmcv2p.V2Policy{
+		ID:          &policyID,
+		Type:        &policyTypeAccess,
+		Description: &v2pDescription,
+		Subject: &ibmcv2p.V2PolicySubject{
+			Attributes: []ibmcv2p.V2PolicySubjectAttribute{
+				{Key: &v2pSubjectAttrKey, Operator: &v2pStringEquals, Value: &policyAttributeValue},
+			},
+		},
+		Control: &ibmcv2p.Control{Grant: &ibmcv2p.Grant{Roles: []ibmcv2p.Roles{{RoleID: &roleID}}}},
+		Resource: &ibmcv2p.V2PolicyResource{
+			Attributes: []ibmcv2p.V2PolicyResourceAttribute{
+				{Key: &v2pResourceAttrKey, Operator: &v2pStringEquals, Value: resAttr2Value},
+			},
+		},
+		Pattern: &v2pPattern,
+		Rule: &ibmcv2p.V2PolicyRule{
+			Operator: &v2pRuleOperator,
+			Conditions: []ibmcv2p.RuleAttribute{
+				{Key: &v2pRuleKey, Operator: &v2pRuleFromOp, Value: v2pRuleFrom},
+				{Key: &v2pRuleKey, Operator: &v2pRuleUntilOp, Value: v2pRuleUntil},
+			},
+		},
+		Href:             &v2pHref,
+		CreatedAt:        &createdAt,
+		CreatedByID:      &createdByID,
+		LastModifiedAt:   &lastModifiedAt,
+		LastModifiedByID: &createdByID,
+		State:            &v2pStateActive,
+	}
+	for _, f := range m {
+		f(i)
+	}
+	return i
+}
+
+// Sets up a unit test http server, and creates an external v2 policy structure appropriate for unit test.
+func setupServerAndGetUnitTestExternalV2P(testingObj *testing.T, handlers *[]tstutil.Handler, kube *client.Client) (*v2pExternal, *httptest.Server, error) {
+	mClient, tstServer, err := tstutil.SetupTestServerClient(testingObj, handlers)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &v2pExternal{
+			kube:   *kube,
+			client: ibmcv2p.NewClient((*mClient).IamPolicyManagementV1()),
+			logger: logging.NewNopLogger(),
+		},
+		tstServer,
+		nil
+}
+
+func TestV2PolicyObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"NotFound": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusNotFound)
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: v2p(),
+			},
+			want: want{
+				mg:  v2p(),
+				err: nil,
+			},
+		},
+		"GetFailed": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusBadRequest)
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: v2p(),
+			},
+			want: want{
+				mg:  v2p(),
+				err: errors.New(errV2pBadRequest),
+			},
+		},
+		"UpToDate": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff("/v2/policies/"+policyID, r.URL.Path); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.Header().Set("ETag", eTag)
+						err := json.NewEncoder(w).Encode(v2pInstance())
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: tstutil.Args{
+				Managed: v2p(
+					v2pWithExternalNameAnnotation(policyID),
+					v2pWithSpec(*v2pParams()),
+				),
+			},
+			want: want{
+				mg: v2p(v2pWithSpec(*v2pParams()),
+					v2pWithEtagAnnotation(eTag),
+					v2pWithConditions(cpv1alpha1.Available()),
+					v2pWithStatus(*v2pObservation())),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: nil,
+				},
+			},
+		},
+		"LateInitOperators": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.Header().Set("ETag", eTag)
+						err := json.NewEncoder(w).Encode(v2pInstance())
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: tstutil.Args{
+				Managed: v2p(
+					v2pWithExternalNameAnnotation(policyID),
+					v2pWithSpec(*v2pParams(func(p *v1alpha1.V2PolicyParameters) {
+						p.Subject.Attributes[0].Operator = nil
+						p.Resource.Attributes[0].Operator = nil
+					})),
+				),
+			},
+			want: want{
+				mg: v2p(v2pWithSpec(*v2pParams()),
+					v2pWithEtagAnnotation(eTag),
+					v2pWithConditions(cpv1alpha1.Available()),
+					v2pWithStatus(*v2pObservation())),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: nil,
+				},
+			},
+		},
+		"NotUpToDate": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.Header().Set("ETag", eTag)
+						err := json.NewEncoder(w).Encode(v2pInstance(func(i *ibmcv2p.V2Policy) {
+							i.Control.Grant.Roles[0].RoleID = &v2pOtherRoleID
+						}))
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: tstutil.Args{
+				Managed: v2p(
+					v2pWithExternalNameAnnotation(policyID),
+					v2pWithSpec(*v2pParams()),
+				),
+			},
+			want: want{
+				mg: v2p(v2pWithSpec(*v2pParams()),
+					v2pWithEtagAnnotation(eTag),
+					v2pWithConditions(cpv1alpha1.Available()),
+					v2pWithStatus(*v2pObservation())),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: nil,
+				},
+			},
+		},
+		"NotActive": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						w.Header().Set("Content-Type", "application/json")
+						w.Header().Set("ETag", eTag)
+						err := json.NewEncoder(w).Encode(v2pInstance(func(i *ibmcv2p.V2Policy) {
+							i.State = &v2pStateDeleted
+						}))
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: tstutil.Args{
+				Managed: v2p(
+					v2pWithExternalNameAnnotation(policyID),
+					v2pWithSpec(*v2pParams()),
+				),
+			},
+			want: want{
+				mg: v2p(v2pWithSpec(*v2pParams()),
+					v2pWithEtagAnnotation(eTag),
+					v2pWithConditions(cpv1alpha1.Unavailable()),
+					v2pWithStatus(*v2pObservation(func(o *v1alpha1.V2PolicyObservation) {
+						o.State = v2pStateDeleted
+					}))),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: nil,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, errCr := setupServerAndGetUnitTestExternalV2P(t, &tc.handlers, &tc.kube)
+			if errCr != nil {
+				t.Errorf("Observe(...): problem setting up the test server %s", errCr)
+			}
+
+			defer server.Close()
+
+			obs, err := e.Observe(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				// the case where our mock server returns error.
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Observe(...): want error string != got error string:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Observe(...): want error != got error:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestV2PolicyCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		cre managed.ExternalCreation
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"Successful": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						b, _ := io.ReadAll(r.Body)
+						_ = r.Body.Close()
+						got := &ibmcv2p.V2Policy{}
+						if err := json.Unmarshal(b, got); err != nil {
+							t.Errorf("r: cannot unmarshal request body: %s", err)
+						}
+						want := &ibmcv2p.V2Policy{}
+						wb, _ := json.Marshal(v2pInstance(func(i *ibmcv2p.V2Policy) {
+							i.ID, i.Href, i.CreatedAt, i.CreatedByID, i.LastModifiedAt, i.LastModifiedByID, i.State = nil, nil, nil, nil, nil, nil, nil
+						}))
+						_ = json.Unmarshal(wb, want)
+						if diff := cmp.Diff(want, got); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusCreated)
+						err := json.NewEncoder(w).Encode(v2pInstance())
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: v2p(v2pWithSpec(*v2pParams())),
+			},
+			want: want{
+				mg: v2p(v2pWithSpec(*v2pParams()),
+					v2pWithConditions(cpv1alpha1.Creating()),
+					v2pWithExternalNameAnnotation(policyID)),
+				cre: managed.ExternalCreation{ExternalNameAssigned: true},
+				err: nil,
+			},
+		},
+		"BadRequest": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusBadRequest)
+						_ = r.Body.Close()
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: v2p(v2pWithSpec(*v2pParams())),
+			},
+			want: want{
+				mg: v2p(v2pWithSpec(*v2pParams()),
+					v2pWithConditions(cpv1alpha1.Creating())),
+				cre: managed.ExternalCreation{ExternalNameAssigned: false},
+				err: errors.Wrap(errors.New(http.StatusText(http.StatusBadRequest)), errCreateV2Policy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, errCr := setupServerAndGetUnitTestExternalV2P(t, &tc.handlers, &tc.kube)
+			if errCr != nil {
+				t.Errorf("Create(...): problem setting up the test server %s", errCr)
+			}
+
+			defer server.Close()
+
+			cre, err := e.Create(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				// the case where our mock server returns error.
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Create(...): -want, +got:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Create(...): -want, +got:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.cre, cre); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestV2PolicyDelete(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"Successful": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff("/v2/policies/"+policyID, r.URL.Path); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusNoContent)
+						_ = r.Body.Close()
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: v2p(v2pWithStatus(*v2pObservation())),
+			},
+			want: want{
+				mg:  v2p(v2pWithStatus(*v2pObservation()), v2pWithConditions(cpv1alpha1.Deleting())),
+				err: nil,
+			},
+		},
+		"AlreadyGone": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusNotFound)
+						_ = r.Body.Close()
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: v2p(v2pWithStatus(*v2pObservation())),
+			},
+			want: want{
+				mg:  v2p(v2pWithStatus(*v2pObservation()), v2pWithConditions(cpv1alpha1.Deleting())),
+				err: nil,
+			},
+		},
+		"Failed": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusInternalServerError)
+						_ = r.Body.Close()
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: v2p(v2pWithStatus(*v2pObservation())),
+			},
+			want: want{
+				mg:  v2p(v2pWithStatus(*v2pObservation()), v2pWithConditions(cpv1alpha1.Deleting())),
+				err: errors.Wrap(errors.New(http.StatusText(http.StatusInternalServerError)), errDeleteV2Policy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, errCr := setupServerAndGetUnitTestExternalV2P(t, &tc.handlers, &tc.kube)
+			if errCr != nil {
+				t.Errorf("Delete(...): problem setting up the test server %s", errCr)
+			}
+
+			defer server.Close()
+
+			err := e.Delete(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				// the case where our mock server returns error.
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Delete(...): -want, +got:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Delete(...): -want, +got:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestV2PolicyUpdate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		upd managed.ExternalUpdate
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"Successful": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodPut, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff("/v2/policies/"+policyID, r.URL.Path); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff(eTag, r.Header.Get("If-Match")); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusOK)
+						_ = r.Body.Close()
+						err := json.NewEncoder(w).Encode(v2pInstance())
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: v2p(v2pWithSpec(*v2pParams()), v2pWithEtagAnnotation(eTag), v2pWithStatus(*v2pObservation())),
+			},
+			want: want{
+				mg:  v2p(v2pWithSpec(*v2pParams()), v2pWithEtagAnnotation(eTag), v2pWithStatus(*v2pObservation())),
+				upd: managed.ExternalUpdate{},
+				err: nil,
+			},
+		},
+		"PreconditionFailed": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodPut, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusPreconditionFailed)
+						_ = r.Body.Close()
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: v2p(v2pWithSpec(*v2pParams()), v2pWithEtagAnnotation(eTag), v2pWithStatus(*v2pObservation())),
+			},
+			want: want{
+				mg:  v2p(v2pWithSpec(*v2pParams()), v2pWithEtagAnnotation(eTag), v2pWithStatus(*v2pObservation())),
+				err: errors.Wrap(errors.New(http.StatusText(http.StatusPreconditionFailed)), errUpdV2Policy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, errCr := setupServerAndGetUnitTestExternalV2P(t, &tc.handlers, &tc.kube)
+			if errCr != nil {
+				t.Errorf("Update(...): problem setting up the test server %s", errCr)
+			}
+
+			defer server.Close()
+
+			upd, err := e.Update(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				// the case where our mock server returns error.
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Update(...): -want, +got:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Update(...): -want, +got:\n%s", diff)
+				}
+			}
+			if tc.want.err == nil {
+				if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+					t.Errorf("Update(...): -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(tc.want.upd, upd); diff != "" {
+					t.Errorf("Update(...): -want, +got:\n%s", diff)
+				}
+			}
+		})
+	}
+}
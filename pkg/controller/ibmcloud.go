@@ -23,12 +23,16 @@ import (
 	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/controller/cloudantv1"
 	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/controller/config"
 	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/controller/container/containerv2"
+	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/controller/contextbasedrestrictionsv1"
 	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/controller/cos"
 	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/controller/eventstreamsadminv1"
 	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/controller/iamaccessgroupsv2"
+	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/controller/iamidentityv1"
 	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/controller/iampolicymanagementv1"
 	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/controller/ibmclouddatabasesv5"
 	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/controller/resourcecontrollerv2"
+	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/controller/resourcemanagerv2"
+	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/controller/usermanagementv1"
 	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/controller/vpcv1"
 )
 
@@ -40,14 +44,26 @@ func Setup(mgr ctrl.Manager, l logging.Logger) error {
 		config.SetupToken,
 		resourcecontrollerv2.SetupResourceInstance,
 		resourcecontrollerv2.SetupResourceKey,
+		resourcecontrollerv2.SetupResourceAlias,
+		resourcemanagerv2.SetupResourceGroup,
 		ibmclouddatabasesv5.SetupScalingGroup,
 		ibmclouddatabasesv5.SetupWhitelist,
 		ibmclouddatabasesv5.SetupAutoscalingGroup,
 		iampolicymanagementv1.SetupPolicy,
+		iampolicymanagementv1.SetupV2Policy,
 		iampolicymanagementv1.SetupCustomRole,
 		iamaccessgroupsv2.SetupAccessGroup,
 		iamaccessgroupsv2.SetupGroupMembership,
 		iamaccessgroupsv2.SetupAccessGroupRule,
+		iamidentityv1.SetupServiceID,
+		iamidentityv1.SetupAPIKey,
+		iamidentityv1.SetupTrustedProfile,
+		iamidentityv1.SetupTrustedProfileClaimRule,
+		iamidentityv1.SetupTrustedProfileLink,
+		iamidentityv1.SetupAccountSettings,
+		usermanagementv1.SetupUser,
+		contextbasedrestrictionsv1.SetupZone,
+		contextbasedrestrictionsv1.SetupRule,
 		eventstreamsadminv1.SetupTopic,
 		cloudantv1.SetupCloudantDatabase,
 		cos.SetupBucket,
@@ -52,12 +52,13 @@ func SetupAutoscalingGroup(mgr ctrl.Manager, l logging.Logger) error {
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.AutoscalingGroupGroupVersionKind),
-		managed.WithExternalConnecter(&asgConnector{
+		managed.WithExternalConnecter(ibmc.NewAuditConnecter(&asgConnector{
 			kube:     mgr.GetClient(),
 			usage:    resource.NewProviderConfigUsageTracker(mgr.GetClient(), &v1beta1.ProviderConfigUsage{}),
 			clientFn: ibmc.NewClient,
-			logger:   log}),
-		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			logger:   log}, event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()),
+			ibmc.NewExpiration(mgr.GetClient(), event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
 		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 		managed.WithLogger(log),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
@@ -65,7 +66,7 @@ func SetupAutoscalingGroup(mgr ctrl.Manager, l logging.Logger) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		For(&v1alpha1.AutoscalingGroup{}).
-		Complete(r)
+		Complete(ibmc.NewExpirationReconciler(mgr, resource.ManagedKind(v1alpha1.AutoscalingGroupGroupVersionKind), r))
 }
 
 // A asgConnector is expected to produce an ExternalClient when its Connect method
@@ -132,6 +133,10 @@ func (c *asgExternal) Observe(ctx context.Context, mg resource.Managed) (managed
 	if err = ibmcasg.LateInitializeSpec(&cr.Spec.ForProvider, instance.Autoscaling); err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errLateInitSpec)
 	}
+	lateInitSpec := cr.Spec.ForProvider.DeepCopy()
+	if err = ibmc.RestrictLateInitialization(cr, currentSpec, &cr.Spec.ForProvider); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
+	}
 	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
 		if err := c.kube.Update(ctx, cr); err != nil {
 			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateCR)
@@ -146,7 +151,7 @@ func (c *asgExternal) Observe(ctx context.Context, mg resource.Managed) (managed
 	cr.Status.SetConditions(cpv1alpha1.Available())
 	cr.Status.AtProvider.State = string(cpv1alpha1.Available().Reason)
 
-	upToDate, err := ibmcasg.IsUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, instance.Autoscaling, c.logger)
+	upToDate, err := ibmcasg.IsUpToDate(meta.GetExternalName(cr), lateInitSpec, instance.Autoscaling, c.logger)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errCheckUpToDate)
 	}
@@ -42,7 +42,9 @@ import (
 )
 
 const (
-	errNotScalingGroup = "managed resource is not a ScalingGroup custom resource"
+	errNotScalingGroup      = "managed resource is not a ScalingGroup custom resource"
+	errEstimateScalingGroup = "could not estimate the monthly cost of the ScalingGroup"
+	errGetDeployment        = "cannot get the database deployment of the ScalingGroup"
 
 	errNewClient         = "cannot create new Client"
 	errGetAuth           = "error getting auth info"
@@ -62,12 +64,13 @@ func SetupScalingGroup(mgr ctrl.Manager, l logging.Logger) error {
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.ScalingGroupGroupVersionKind),
-		managed.WithExternalConnecter(&sgConnector{
+		managed.WithExternalConnecter(ibmc.NewAuditConnecter(&sgConnector{
 			kube:     mgr.GetClient(),
 			usage:    resource.NewProviderConfigUsageTracker(mgr.GetClient(), &v1beta1.ProviderConfigUsage{}),
 			clientFn: ibmc.NewClient,
-			logger:   log}),
-		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			logger:   log}, event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()),
+			ibmc.NewExpiration(mgr.GetClient(), event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
 		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 		managed.WithLogger(log),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
@@ -75,7 +78,7 @@ func SetupScalingGroup(mgr ctrl.Manager, l logging.Logger) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		For(&v1alpha1.ScalingGroup{}).
-		Complete(r)
+		Complete(ibmc.NewExpirationReconciler(mgr, resource.ManagedKind(v1alpha1.ScalingGroupGroupVersionKind), r))
 }
 
 // A sgConnector is expected to produce an ExternalClient when its Connect method
@@ -133,6 +136,10 @@ func (c *sgExternal) Observe(ctx context.Context, mg resource.Managed) (managed.
 	if err = ibmcsg.LateInitializeSpec(&cr.Spec.ForProvider, instance); err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errLateInitSpec)
 	}
+	lateInitSpec := cr.Spec.ForProvider.DeepCopy()
+	if err = ibmc.RestrictLateInitialization(cr, currentSpec, &cr.Spec.ForProvider); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
+	}
 	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
 		if err := c.kube.Update(ctx, cr); err != nil {
 			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateCR)
@@ -144,6 +151,16 @@ func (c *sgExternal) Observe(ctx context.Context, mg resource.Managed) (managed.
 		return managed.ExternalObservation{}, errors.Wrap(err, errGenObservation)
 	}
 
+	if pc := c.client.ProviderConfig(); ibmc.CostEstimationEnabled(pc) {
+		// an estimate is informational only, so failing to compute one does not fail the observation
+		cost, err := ibmcsg.EstimateMonthlyCost(c.client, meta.GetExternalName(cr), cr.Status.AtProvider.Groups)
+		if err != nil {
+			c.logger.Info(errEstimateScalingGroup, "error", err)
+		} else {
+			cr.Status.AtProvider.EstimatedMonthlyCost = ibmc.FormatCost(pc, cost)
+		}
+	}
+
 	if cr.Status.AtProvider.Groups != nil {
 		cr.Status.SetConditions(cpv1alpha1.Available())
 		cr.Status.AtProvider.State = string(cpv1alpha1.Available().Reason)
@@ -152,7 +169,7 @@ func (c *sgExternal) Observe(ctx context.Context, mg resource.Managed) (managed.
 		cr.Status.AtProvider.State = string(cpv1alpha1.Unavailable().Reason)
 	}
 
-	upToDate, err := ibmcsg.IsUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, instance, c.logger)
+	upToDate, err := ibmcsg.IsUpToDate(meta.GetExternalName(cr), lateInitSpec, instance, c.logger)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errCheckUpToDate)
 	}
@@ -175,6 +192,10 @@ func (c *sgExternal) Create(ctx context.Context, mg resource.Managed) (managed.E
 		return managed.ExternalCreation{}, errors.New(errResNotAvailable)
 	}
 
+	if err := c.enforcePolicies(cr, reference.FromPtrValue(cr.Spec.ForProvider.ID)); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
 	meta.SetExternalName(cr, reference.FromPtrValue(cr.Spec.ForProvider.ID))
 	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
 }
@@ -185,6 +206,10 @@ func (c *sgExternal) Update(ctx context.Context, mg resource.Managed) (managed.E
 		return managed.ExternalUpdate{}, errors.New(errNotScalingGroup)
 	}
 
+	if err := c.enforcePolicies(cr, meta.GetExternalName(cr), c.checkMonthlyBudget(cr)); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
 	opts := &icdv5.SetDeploymentScalingGroupOptions{}
 	err := ibmcsg.GenerateSetDeploymentScalingGroupOptions(meta.GetExternalName(cr), *cr, opts)
 	if err != nil {
@@ -199,6 +224,35 @@ func (c *sgExternal) Update(ctx context.Context, mg resource.Managed) (managed.E
 	return managed.ExternalUpdate{}, nil
 }
 
+// enforcePolicies checks the service, plan and region of the scaled deployment with the given CRN against the
+// guardrails of the ProviderConfig, as well as any additional checks
+func (c *sgExternal) enforcePolicies(cr *v1alpha1.ScalingGroup, deploymentCRN string, checks ...error) error {
+	pc := c.client.ProviderConfig()
+	var guardrails error
+	if pc != nil && pc.Guardrails != nil {
+		v, err := ibmcsg.DeploymentGuardedValues(c.client, deploymentCRN)
+		if err != nil {
+			return errors.Wrap(err, errGetDeployment)
+		}
+		guardrails = ibmc.CheckGuardrails(pc, v)
+	}
+	return ibmc.EnforcePolicies(cr, pc, append([]error{guardrails}, checks...)...)
+}
+
+// checkMonthlyBudget checks the estimated monthly cost of the groups of the ScalingGroup, as they will be allocated
+// once its spec is applied, against the budget of its ProviderConfig, if there is one
+func (c *sgExternal) checkMonthlyBudget(cr *v1alpha1.ScalingGroup) error {
+	pc := c.client.ProviderConfig()
+	if !ibmc.CostEstimationEnabled(pc) || pc.CostEstimation.MonthlyBudget == nil {
+		return nil
+	}
+	cost, err := ibmcsg.EstimateMonthlyCost(c.client, meta.GetExternalName(cr), ibmcsg.DesiredGroups(*cr))
+	if err != nil {
+		return errors.Wrap(err, errEstimateScalingGroup)
+	}
+	return ibmc.CheckMonthlyBudget(pc, cost)
+}
+
 func (c *sgExternal) Delete(ctx context.Context, mg resource.Managed) error {
 	cr, ok := mg.(*v1alpha1.ScalingGroup)
 	if !ok {
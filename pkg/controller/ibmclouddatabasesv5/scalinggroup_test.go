@@ -33,12 +33,16 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	icdv5 "github.com/IBM/experimental-go-sdk/ibmclouddatabasesv5"
+	gcat "github.com/IBM/platform-services-go-sdk/globalcatalogv1"
+	rcv2 "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 
 	"github.com/crossplane-contrib/provider-ibm-cloud/apis/ibmclouddatabasesv5/v1alpha1"
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
 	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
 	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/controller/tstutil"
 )
@@ -734,3 +738,104 @@ func TestScalingGroupUpdate(t *testing.T) {
 		})
 	}
 }
+
+// deploymentMux mocks the resource controller and global catalog calls made to find the plan of the scaled deployment
+func deploymentMux(planName string) *http.ServeMux {
+	planID := "dda29288-plan"
+	serviceName := "databases-for-postgresql"
+	encode := func(w http.ResponseWriter, v interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(v); err != nil {
+			klog.Errorf("%s", err)
+		}
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/resource_instances/", func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		encode(w, rcv2.ResourceInstance{CRN: &id, ResourcePlanID: &planID})
+	})
+	mux.HandleFunc("/"+serviceName+"/", func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		encode(w, gcat.EntrySearchResult{Resources: []gcat.CatalogEntry{{ID: &planID, Name: &planName}}})
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		encode(w, gcat.EntrySearchResult{Resources: []gcat.CatalogEntry{{
+			Metadata: &gcat.CatalogEntryMetadata{UI: &gcat.UIMetaData{PrimaryOfferingID: &serviceName}},
+		}}})
+	})
+	return mux
+}
+
+func TestScalingGroupUpdateGuardrails(t *testing.T) {
+	pc := &v1beta1.ProviderConfigSpec{Guardrails: &v1beta1.Guardrails{Plans: &v1beta1.AllowDenyList{Denied: []string{"platinum"}}}}
+	server := httptest.NewServer(deploymentMux("platinum"))
+	defer server.Close()
+
+	mClient, err := ibmc.GetTestClientWithProviderConfig(server.URL, pc)
+	if err != nil {
+		t.Fatalf("Update(...): problem setting up the test client %s", err)
+	}
+	e := &sgExternal{client: mClient, logger: logging.NewNopLogger()}
+
+	mg := sg()
+	upd, err := e.Update(context.Background(), mg)
+	violation := ibmc.CheckGuardrails(pc, ibmc.GuardedValues{Plans: []string{"platinum"}})
+	if diff := cmp.Diff(violation.Error(), err.Error()); diff != "" {
+		t.Errorf("Update(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(managed.ExternalUpdate{}, upd); diff != "" {
+		t.Errorf("Update(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(sg(sgWithConditions(ibmc.NonCompliant(violation))), mg); diff != "" {
+		t.Errorf("Update(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestScalingGroupUpdateOverBudget(t *testing.T) {
+	pc := &v1beta1.ProviderConfigSpec{CostEstimation: &v1beta1.CostEstimation{MonthlyBudget: reference.ToPtrValue("300")}}
+	gbMonthPrice := 10.0
+	mux := deploymentMux("standard")
+	mux.HandleFunc("/dda29288-plan/pricing", func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		w.Header().Set("Content-Type", "application/json")
+		pricing := gcat.PricingGet{
+			Metrics: []gcat.Metrics{{
+				ChargeUnitName:     reference.ToPtrValue("MEMORY_GIGABYTE_MONTHS"),
+				ChargeUnitQuantity: reference.ToPtrValue("1"),
+				Amounts: []gcat.Amount{{
+					Country:  reference.ToPtrValue("USA"),
+					Currency: reference.ToPtrValue("USD"),
+					Prices:   []gcat.Price{{QuantityTier: ibmc.Int64Ptr(1), Price: &gbMonthPrice}},
+				}},
+			}},
+		}
+		if err := json.NewEncoder(w).Encode(pricing); err != nil {
+			klog.Errorf("%s", err)
+		}
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mClient, err := ibmc.GetTestClientWithProviderConfig(server.URL, pc)
+	if err != nil {
+		t.Fatalf("Update(...): problem setting up the test client %s", err)
+	}
+	e := &sgExternal{client: mClient, logger: logging.NewNopLogger()}
+
+	// the current 25 GB of memory are within the budget, but not the 37.5 GB of a third member
+	moreMembers := func(p *v1alpha1.ScalingGroupParameters) { p.Members.AllocationCount = int64(membersAllocationCount + 1) }
+	mg := sg(sgWithSpec(*params(moreMembers)), sgWithStatus(*observation()))
+	upd, err := e.Update(context.Background(), mg)
+	violation := ibmc.CheckMonthlyBudget(pc, 375)
+	if diff := cmp.Diff(violation.Error(), err.Error()); diff != "" {
+		t.Errorf("Update(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(managed.ExternalUpdate{}, upd); diff != "" {
+		t.Errorf("Update(...): -want, +got:\n%s", diff)
+	}
+	want := sg(sgWithSpec(*params(moreMembers)), sgWithStatus(*observation()), sgWithConditions(ibmc.NonCompliant(violation)))
+	if diff := cmp.Diff(want, mg); diff != "" {
+		t.Errorf("Update(...): -want, +got:\n%s", diff)
+	}
+}
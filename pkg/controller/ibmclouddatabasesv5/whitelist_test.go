@@ -39,6 +39,7 @@ import (
 	icdv5 "github.com/IBM/experimental-go-sdk/ibmclouddatabasesv5"
 
 	"github.com/crossplane-contrib/provider-ibm-cloud/apis/ibmclouddatabasesv5/v1alpha1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
 	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/controller/tstutil"
 )
 
@@ -83,6 +84,10 @@ func wlWithExternalNameAnnotation(externalName string) wlModifier {
 	}
 }
 
+func wlWithLateInitialization(policy string) wlModifier {
+	return func(i *v1alpha1.Whitelist) { i.ObjectMeta.Annotations[ibmc.AnnotationLateInitialization] = policy }
+}
+
 func wlWithSpec(p v1alpha1.WhitelistParameters) wlModifier {
 	return func(r *v1alpha1.Whitelist) { r.Spec.ForProvider = p }
 }
@@ -302,6 +307,45 @@ func TestWhitelistObserve(t *testing.T) {
 				},
 			},
 		},
+		"LateInitializationDisabled": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						err := json.NewEncoder(w).Encode(wlInstance())
+						if err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(errors.New(errBadRequest)),
+			},
+			args: tstutil.Args{
+				Managed: wl(
+					wlWithExternalNameAnnotation(id),
+					wlWithLateInitialization("false"),
+					wlWithSpec(*wlParams(func(p *v1alpha1.WhitelistParameters) { p.IPAddresses = nil })),
+				),
+			},
+			want: want{
+				mg: wl(wlWithLateInitialization("false"),
+					wlWithSpec(*wlParams(func(p *v1alpha1.WhitelistParameters) { p.IPAddresses = nil })),
+					wlWithConditions(cpv1alpha1.Available()),
+					wlWithStatus(*wlObservation())),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: nil,
+				},
+			},
+		},
 		"NotUpToDate": {
 			handlers: []tstutil.Handler{
 				{
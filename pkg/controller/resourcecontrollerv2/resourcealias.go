@@ -0,0 +1,270 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcecontrollerv2
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	rcv2 "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/resourcecontrollerv2/v1alpha1"
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+	aliasclient "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/resourcealias"
+)
+
+const (
+	errNotResourceAlias        = "managed resource is not a ResourceAlias custom resource"
+	errCreateResourceAlias     = "could not create ResourceAlias"
+	errDeleteResourceAlias     = "could not delete ResourceAlias"
+	errGetResourceAliasFailed  = "error getting ResourceAlias"
+	errCreateResourceAliasOpts = "error creating ResourceAlias options"
+	errUpdResourceAlias        = "error updating ResourceAlias"
+)
+
+// resourceAliasDependents are the managed resources that reference resource aliases, and must be deleted before
+// them
+var resourceAliasDependents = []ibmc.Dependent{
+	{
+		Kind: v1alpha1.ResourceKeyKind,
+		List: func() resource.ManagedList { return &v1alpha1.ResourceKeyList{} },
+		DependsOn: func(mg resource.Managed, name string) bool {
+			return ibmc.IsReferenceTo(mg.(*v1alpha1.ResourceKey).Spec.ForProvider.SourceAliasRef, name)
+		},
+	},
+}
+
+// SetupResourceAlias adds a controller that reconciles ResourceAlias managed resources.
+func SetupResourceAlias(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.ResourceAliasGroupKind)
+	log := l.WithValues("resourcealias-controller", name)
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ResourceAliasGroupVersionKind),
+		managed.WithExternalConnecter(ibmc.NewAuditConnecter(&resourcealiasConnector{
+			kube:     mgr.GetClient(),
+			usage:    resource.NewProviderConfigUsageTracker(mgr.GetClient(), &v1beta1.ProviderConfigUsage{}),
+			clientFn: ibmc.NewClient,
+			logger:   log}, event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()),
+			ibmc.NewExpiration(mgr.GetClient(), event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(log),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.ResourceAlias{}).
+		Complete(ibmc.NewExpirationReconciler(mgr, resource.ManagedKind(v1alpha1.ResourceAliasGroupVersionKind), r))
+}
+
+// A resourcealiasConnector is expected to produce an ExternalClient when its Connect method
+// is called.
+type resourcealiasConnector struct {
+	kube     client.Client
+	usage    resource.Tracker
+	clientFn func(optd ibmc.ClientOptions) (ibmc.ClientSession, error)
+	logger   logging.Logger
+}
+
+// Connect produces an ExternalClient for IBM Cloud API
+func (c *resourcealiasConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	opts, err := ibmc.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, ibmc.ErrGetAuth)
+	}
+
+	service, err := c.clientFn(opts)
+	if err != nil {
+		return nil, errors.Wrap(err, ibmc.ErrNewClient)
+	}
+
+	return &resourcealiasExternal{client: service, kube: c.kube, logger: c.logger}, nil
+}
+
+// An resourcealiasExternal observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type resourcealiasExternal struct {
+	client ibmc.ClientSession
+	kube   client.Client
+	logger logging.Logger
+}
+
+func (c *resourcealiasExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ResourceAlias)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotResourceAlias)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	alias, _, err := c.client.ResourceControllerV2().GetResourceAlias(&rcv2.GetResourceAliasOptions{ID: reference.ToPtrValue(meta.GetExternalName(cr))})
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ibmc.IsResourceNotFound, err), errGetResourceAliasFailed)
+	}
+
+	if !(reference.FromPtrValue(alias.State) == aliasclient.StateActive ||
+		reference.FromPtrValue(alias.State) == aliasclient.StateInactive) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	if err = aliasclient.LateInitializeSpec(&cr.Spec.ForProvider, alias); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
+	}
+	lateInitSpec := cr.Spec.ForProvider.DeepCopy()
+	if err = ibmc.RestrictLateInitialization(cr, currentSpec, &cr.Spec.ForProvider); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
+	}
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := c.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
+		}
+	}
+
+	cr.Status.AtProvider, err = aliasclient.GenerateObservation(alias)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrGenObservation)
+	}
+
+	switch cr.Status.AtProvider.State {
+	case aliasclient.StateActive:
+		cr.Status.SetConditions(runtimev1alpha1.Available())
+	case aliasclient.StateInactive:
+		cr.Status.SetConditions(runtimev1alpha1.Creating())
+	default:
+		cr.Status.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	upToDate, err := aliasclient.IsUpToDate(lateInitSpec, alias, c.logger)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrCheckUpToDate)
+	}
+	if upToDate {
+		upToDate, err = ibmc.TagsUpToDate(c.client, cr.Status.AtProvider.CRN, cr.Spec.ForProvider.Tags, cr.Spec.ForProvider.AccessTags)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrCheckUpToDate)
+		}
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: nil,
+	}, nil
+}
+
+func (c *resourcealiasExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ResourceAlias)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotResourceAlias)
+	}
+
+	if err := c.enforcePolicies(cr); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateResourceAlias)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+	createOpts := &rcv2.CreateResourceAliasOptions{}
+	if err := aliasclient.GenerateCreateResourceAliasOptions(cr.Spec.ForProvider, createOpts); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateResourceAliasOpts)
+	}
+
+	alias, _, err := c.client.ResourceControllerV2().CreateResourceAlias(createOpts)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateResourceAlias)
+	}
+
+	meta.SetExternalName(cr, reference.FromPtrValue(alias.ID))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (c *resourcealiasExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ResourceAlias)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotResourceAlias)
+	}
+
+	if err := c.enforcePolicies(cr); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdResourceAlias)
+	}
+
+	updOpts := &rcv2.UpdateResourceAliasOptions{}
+	if err := aliasclient.GenerateUpdateResourceAliasOptions(meta.GetExternalName(cr), cr.Spec.ForProvider, updOpts); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdResourceAlias)
+	}
+
+	_, _, err := c.client.ResourceControllerV2().UpdateResourceAlias(updOpts)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdResourceAlias)
+	}
+
+	if err = ibmc.UpdateManagedTags(c.client, cr.Status.AtProvider.CRN, cr.Spec.ForProvider.Tags, cr.Spec.ForProvider.AccessTags); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdResourceAlias)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+// enforcePolicies checks the name and the target region of the resource alias against the naming policy and
+// guardrails of its ProviderConfig
+func (c *resourcealiasExternal) enforcePolicies(cr *v1alpha1.ResourceAlias) error {
+	pc := c.client.ProviderConfig()
+	regions := []string{}
+	if r := aliasclient.TargetRegion(cr.Spec.ForProvider.Target); r != "" {
+		regions = append(regions, r)
+	}
+	return ibmc.EnforcePolicies(cr, pc,
+		ibmc.CheckNamingPolicy(pc, &cr.Spec.ForProvider.Name),
+		ibmc.CheckGuardrails(pc, ibmc.GuardedValues{Regions: regions}))
+}
+
+func (c *resourcealiasExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ResourceAlias)
+	if !ok {
+		return errors.New(errNotResourceAlias)
+	}
+
+	if err := ibmc.CheckDependents(ctx, c.kube, cr, resourceAliasDependents...); err != nil {
+		return errors.Wrap(err, errDeleteResourceAlias)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+
+	_, err := c.client.ResourceControllerV2().DeleteResourceAlias(&rcv2.DeleteResourceAliasOptions{ID: reference.ToPtrValue(meta.GetExternalName(cr))})
+	if err != nil {
+		return errors.Wrap(resource.Ignore(ibmc.IsResourceGone, err), errDeleteResourceAlias)
+	}
+	return nil
+}
@@ -0,0 +1,601 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance rkWith the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcecontrollerv2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cpv1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	rcv2 "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/resourcecontrollerv2/v1alpha1"
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/controller/tstutil"
+)
+
+var (
+	raName      = "myalias"
+	raNewName   = "myalias2"
+	raID        = "crn:v1:bluemix:public:cloud-object-storage:global:a/0b5a00334eaf9eb9339d2ab48f20d7f5:78d88b2b-bbbb-aaaa-8888-5c26e8b6a555:resource-alias:3d4d1e2b-bbbb-4ec9-8b2e-4ac5b6e9a0a0"
+	raGUID      = "3d4d1e2b-bbbb-4ec9-8b2e-4ac5b6e9a0a0"
+	raTargetCRN = "crn:v1:bluemix:public:cf:us-south:o/5e939cd5-6377-4383-b9e0-9db22cd11753::cf-space:66c8b915-101a-406c-a784-e6636676e4f5"
+)
+
+var _ managed.ExternalConnecter = &resourcealiasConnector{}
+var _ managed.ExternalClient = &resourcealiasExternal{}
+
+type aliasModifier func(*v1alpha1.ResourceAlias)
+
+func raWithConditions(c ...cpv1alpha1.Condition) aliasModifier {
+	return func(i *v1alpha1.ResourceAlias) { i.Status.SetConditions(c...) }
+}
+
+func raWithExternalNameAnnotation(externalName string) aliasModifier {
+	return func(i *v1alpha1.ResourceAlias) { meta.SetExternalName(i, externalName) }
+}
+
+func raWithSpec(p v1alpha1.ResourceAliasParameters) aliasModifier {
+	return func(i *v1alpha1.ResourceAlias) { i.Spec.ForProvider = p }
+}
+
+func raWithObservation(o v1alpha1.ResourceAliasObservation) aliasModifier {
+	return func(i *v1alpha1.ResourceAlias) { i.Status.AtProvider = o }
+}
+
+func alias(m ...aliasModifier) *v1alpha1.ResourceAlias {
+	i := &v1alpha1.ResourceAlias{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        raName,
+			Finalizers:  []string{},
+			Annotations: map[string]string{},
+		},
+		Spec: v1alpha1.ResourceAliasSpec{
+			ForProvider: v1alpha1.ResourceAliasParameters{},
+		},
+	}
+	for _, f := range m {
+		f(i)
+	}
+	return i
+}
+
+func resourceAliasSpec() v1alpha1.ResourceAliasParameters {
+	return v1alpha1.ResourceAliasParameters{
+		Name:   raName,
+		Source: &guid,
+		Target: raTargetCRN,
+	}
+}
+
+func resourceAliasObservation() v1alpha1.ResourceAliasObservation {
+	return v1alpha1.ResourceAliasObservation{
+		ID:                 raID,
+		GUID:               raGUID,
+		CRN:                raID,
+		AccountID:          accountID,
+		State:              state,
+		ResourceInstanceID: guid,
+		CreatedAt:          ibmc.DateTimeToMetaV1Time(&createdAt),
+	}
+}
+
+func raWithTags(p v1alpha1.ResourceAliasParameters, tags, accessTags []string) v1alpha1.ResourceAliasParameters {
+	p.Tags = tags
+	p.AccessTags = accessTags
+	return p
+}
+
+func genTestSDKResourceAlias() *rcv2.ResourceAlias {
+	return &rcv2.ResourceAlias{
+		ID:                 &raID,
+		GUID:               &raGUID,
+		CRN:                &raID,
+		Name:               &raName,
+		AccountID:          &accountID,
+		TargetCRN:          &raTargetCRN,
+		State:              &state,
+		ResourceInstanceID: &guid,
+		CreatedAt:          &createdAt,
+	}
+}
+
+// handler to mock client SDK call to the resource controller API for a resource alias
+func aliasHandler(t *testing.T, method string, status int, body *rcv2.ResourceAlias) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		if diff := cmp.Diff(method, r.Method); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		if body == nil {
+			return
+		}
+		if err := json.NewEncoder(w).Encode(body); err != nil {
+			klog.Errorf("%s", err)
+		}
+	}
+}
+
+func setupServerAndGetUnitTestExternalRA(testingObj *testing.T, handlers *[]tstutil.Handler, kube *client.Client) (*resourcealiasExternal, *httptest.Server, error) {
+	mClient, tstServer, err := tstutil.SetupTestServerClient(testingObj, handlers)
+	if err != nil || mClient == nil || tstServer == nil {
+		return nil, nil, err
+	}
+
+	return &resourcealiasExternal{
+			kube:   *kube,
+			client: *mClient,
+			logger: logging.NewNopLogger(),
+		},
+		tstServer,
+		nil
+}
+
+func TestResourceAliasObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"NotFound": {
+			handlers: []tstutil.Handler{
+				{
+					Path:        "/",
+					HandlerFunc: aliasHandler(t, http.MethodGet, http.StatusNotFound, &rcv2.ResourceAlias{}),
+				},
+			},
+			args: tstutil.Args{
+				Managed: alias(raWithExternalNameAnnotation(raID), raWithSpec(resourceAliasSpec())),
+			},
+			want: want{
+				mg: alias(raWithExternalNameAnnotation(raID), raWithSpec(resourceAliasSpec())),
+			},
+		},
+		"GetFailed": {
+			handlers: []tstutil.Handler{
+				{
+					Path:        "/",
+					HandlerFunc: aliasHandler(t, http.MethodGet, http.StatusBadRequest, &rcv2.ResourceAlias{}),
+				},
+			},
+			args: tstutil.Args{
+				Managed: alias(raWithExternalNameAnnotation(raID), raWithSpec(resourceAliasSpec())),
+			},
+			want: want{
+				mg:  alias(raWithExternalNameAnnotation(raID), raWithSpec(resourceAliasSpec())),
+				err: errors.New(errGetResourceAliasFailed + ": Bad Request"),
+			},
+		},
+		"Removed": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						removed := genTestSDKResourceAlias()
+						removed.State = reference.ToPtrValue("removed")
+						aliasHandler(t, http.MethodGet, http.StatusOK, removed)(w, r)
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: alias(raWithExternalNameAnnotation(raID), raWithSpec(resourceAliasSpec())),
+			},
+			want: want{
+				mg: alias(raWithExternalNameAnnotation(raID), raWithSpec(resourceAliasSpec())),
+			},
+		},
+		"UpToDate": {
+			handlers: []tstutil.Handler{
+				{
+					Path:        "/",
+					HandlerFunc: aliasHandler(t, http.MethodGet, http.StatusOK, genTestSDKResourceAlias()),
+				},
+			},
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: tstutil.Args{
+				Managed: alias(raWithExternalNameAnnotation(raID), raWithSpec(resourceAliasSpec())),
+			},
+			want: want{
+				mg: alias(raWithExternalNameAnnotation(raID), raWithSpec(resourceAliasSpec()),
+					raWithObservation(resourceAliasObservation()), raWithConditions(cpv1alpha1.Available())),
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"TagsUpToDate": {
+			handlers: []tstutil.Handler{
+				{
+					Path:        "/",
+					HandlerFunc: aliasHandler(t, http.MethodGet, http.StatusOK, genTestSDKResourceAlias()),
+				},
+				{
+					Path:        "/v3/tags/",
+					HandlerFunc: tagsHandler,
+				},
+			},
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: tstutil.Args{
+				Managed: alias(raWithExternalNameAnnotation(raID), raWithSpec(raWithTags(resourceAliasSpec(), []string{"dev"}, nil))),
+			},
+			want: want{
+				mg: alias(raWithExternalNameAnnotation(raID), raWithSpec(raWithTags(resourceAliasSpec(), []string{"dev"}, nil)),
+					raWithObservation(resourceAliasObservation()), raWithConditions(cpv1alpha1.Available())),
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"AccessTagsNotUpToDate": {
+			handlers: []tstutil.Handler{
+				{
+					Path:        "/",
+					HandlerFunc: aliasHandler(t, http.MethodGet, http.StatusOK, genTestSDKResourceAlias()),
+				},
+				{
+					Path:        "/v3/tags/",
+					HandlerFunc: tagsHandler,
+				},
+			},
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: tstutil.Args{
+				Managed: alias(raWithExternalNameAnnotation(raID), raWithSpec(raWithTags(resourceAliasSpec(), nil, []string{"env:dev"}))),
+			},
+			want: want{
+				mg: alias(raWithExternalNameAnnotation(raID), raWithSpec(raWithTags(resourceAliasSpec(), nil, []string{"env:dev"})),
+					raWithObservation(resourceAliasObservation()), raWithConditions(cpv1alpha1.Available())),
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotUpToDate": {
+			handlers: []tstutil.Handler{
+				{
+					Path:        "/",
+					HandlerFunc: aliasHandler(t, http.MethodGet, http.StatusOK, genTestSDKResourceAlias()),
+				},
+			},
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: tstutil.Args{
+				Managed: alias(raWithExternalNameAnnotation(raID), raWithSpec(v1alpha1.ResourceAliasParameters{Name: raNewName})),
+			},
+			want: want{
+				mg: alias(raWithExternalNameAnnotation(raID),
+					raWithSpec(v1alpha1.ResourceAliasParameters{Name: raNewName, Source: &guid, Target: raTargetCRN}),
+					raWithObservation(resourceAliasObservation()), raWithConditions(cpv1alpha1.Available())),
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, err := setupServerAndGetUnitTestExternalRA(t, &tc.handlers, &tc.kube)
+			if err != nil {
+				t.Errorf("Observe(...): problem setting up the test server %s", err)
+			}
+			defer server.Close()
+
+			obs, err := e.Observe(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Observe(...): -want, +got:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Observe(...): -want, +got:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceAliasCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		cre managed.ExternalCreation
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"Successful": {
+			handlers: []tstutil.Handler{
+				{
+					Path:        "/",
+					HandlerFunc: aliasHandler(t, http.MethodPost, http.StatusCreated, genTestSDKResourceAlias()),
+				},
+			},
+			args: tstutil.Args{
+				Managed: alias(raWithSpec(resourceAliasSpec())),
+			},
+			want: want{
+				mg:  alias(raWithSpec(resourceAliasSpec()), raWithConditions(cpv1alpha1.Creating()), raWithExternalNameAnnotation(raID)),
+				cre: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"Failed": {
+			handlers: []tstutil.Handler{
+				{
+					Path:        "/",
+					HandlerFunc: aliasHandler(t, http.MethodPost, http.StatusBadRequest, &rcv2.ResourceAlias{}),
+				},
+			},
+			args: tstutil.Args{
+				Managed: alias(raWithSpec(resourceAliasSpec())),
+			},
+			want: want{
+				mg:  alias(raWithSpec(resourceAliasSpec()), raWithConditions(cpv1alpha1.Creating())),
+				err: errors.Wrap(errors.New(http.StatusText(http.StatusBadRequest)), errCreateResourceAlias),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, err := setupServerAndGetUnitTestExternalRA(t, &tc.handlers, &tc.kube)
+			if err != nil {
+				t.Errorf("Create(...): problem setting up the test server %s", err)
+			}
+			defer server.Close()
+
+			cre, err := e.Create(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Create(...): -want, +got:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Create(...): -want, +got:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.cre, cre); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceAliasCreateGuardrails(t *testing.T) {
+	pc := &v1beta1.ProviderConfigSpec{Guardrails: &v1beta1.Guardrails{Regions: &v1beta1.AllowDenyList{Allowed: []string{"eu-de"}}}}
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	mClient, err := ibmc.GetTestClientWithProviderConfig(server.URL, pc)
+	if err != nil {
+		t.Fatalf("Create(...): problem setting up the test client %s", err)
+	}
+	e := &resourcealiasExternal{client: mClient, logger: logging.NewNopLogger()}
+
+	mg := alias(raWithSpec(resourceAliasSpec()))
+	cre, err := e.Create(context.Background(), mg)
+	violation := ibmc.CheckGuardrails(pc, ibmc.GuardedValues{Regions: []string{"us-south"}})
+	if diff := cmp.Diff(errors.Wrap(violation, errCreateResourceAlias).Error(), err.Error()); diff != "" {
+		t.Errorf("Create(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(managed.ExternalCreation{}, cre); diff != "" {
+		t.Errorf("Create(...): -want, +got:\n%s", diff)
+	}
+	want := alias(raWithSpec(resourceAliasSpec()), raWithConditions(ibmc.NonCompliant(violation)))
+	if diff := cmp.Diff(want, mg); diff != "" {
+		t.Errorf("Create(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestResourceAliasUpdate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		upd managed.ExternalUpdate
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"Successful": {
+			handlers: []tstutil.Handler{
+				{
+					Path:        "/",
+					HandlerFunc: aliasHandler(t, http.MethodPatch, http.StatusOK, genTestSDKResourceAlias()),
+				},
+			},
+			args: tstutil.Args{
+				Managed: alias(raWithExternalNameAnnotation(raID), raWithSpec(resourceAliasSpec())),
+			},
+			want: want{
+				mg: alias(raWithExternalNameAnnotation(raID), raWithSpec(resourceAliasSpec())),
+			},
+		},
+		"Failed": {
+			handlers: []tstutil.Handler{
+				{
+					Path:        "/",
+					HandlerFunc: aliasHandler(t, http.MethodPatch, http.StatusBadRequest, &rcv2.ResourceAlias{}),
+				},
+			},
+			args: tstutil.Args{
+				Managed: alias(raWithExternalNameAnnotation(raID), raWithSpec(resourceAliasSpec())),
+			},
+			want: want{
+				mg:  alias(raWithExternalNameAnnotation(raID), raWithSpec(resourceAliasSpec())),
+				err: errors.Wrap(errors.New(http.StatusText(http.StatusBadRequest)), errUpdResourceAlias),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, err := setupServerAndGetUnitTestExternalRA(t, &tc.handlers, &tc.kube)
+			if err != nil {
+				t.Errorf("Update(...): problem setting up the test server %s", err)
+			}
+			defer server.Close()
+
+			upd, err := e.Update(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Update(...): -want, +got:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Update(...): -want, +got:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.upd, upd); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceAliasDelete(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"Successful": {
+			handlers: []tstutil.Handler{
+				{
+					Path:        "/",
+					HandlerFunc: aliasHandler(t, http.MethodDelete, http.StatusNoContent, nil),
+				},
+			},
+			kube: &test.MockClient{MockList: test.NewMockListFn(nil)},
+			args: tstutil.Args{
+				Managed: alias(raWithExternalNameAnnotation(raID)),
+			},
+			want: want{
+				mg: alias(raWithExternalNameAnnotation(raID), raWithConditions(cpv1alpha1.Deleting())),
+			},
+		},
+		"AlreadyGone": {
+			handlers: []tstutil.Handler{
+				{
+					Path:        "/",
+					HandlerFunc: aliasHandler(t, http.MethodDelete, http.StatusGone, nil),
+				},
+			},
+			kube: &test.MockClient{MockList: test.NewMockListFn(nil)},
+			args: tstutil.Args{
+				Managed: alias(raWithExternalNameAnnotation(raID)),
+			},
+			want: want{
+				mg: alias(raWithExternalNameAnnotation(raID), raWithConditions(cpv1alpha1.Deleting())),
+			},
+		},
+		"Failed": {
+			handlers: []tstutil.Handler{
+				{
+					Path:        "/",
+					HandlerFunc: aliasHandler(t, http.MethodDelete, http.StatusBadRequest, nil),
+				},
+			},
+			kube: &test.MockClient{MockList: test.NewMockListFn(nil)},
+			args: tstutil.Args{
+				Managed: alias(raWithExternalNameAnnotation(raID)),
+			},
+			want: want{
+				mg:  alias(raWithExternalNameAnnotation(raID), raWithConditions(cpv1alpha1.Deleting())),
+				err: errors.Wrap(errors.New(http.StatusText(http.StatusBadRequest)), errDeleteResourceAlias),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, err := setupServerAndGetUnitTestExternalRA(t, &tc.handlers, &tc.kube)
+			if err != nil {
+				t.Errorf("Delete(...): problem setting up the test server %s", err)
+			}
+			defer server.Close()
+
+			err = e.Delete(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Delete(...): -want, +got:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Delete(...): -want, +got:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
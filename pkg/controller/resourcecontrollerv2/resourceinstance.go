@@ -34,6 +34,8 @@ import (
 
 	rcv2 "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 
+	cosv1alpha1 "github.com/crossplane-contrib/provider-ibm-cloud/apis/cos/v1alpha1"
+	icdv5 "github.com/crossplane-contrib/provider-ibm-cloud/apis/ibmclouddatabasesv5/v1alpha1"
 	"github.com/crossplane-contrib/provider-ibm-cloud/apis/resourcecontrollerv2/v1alpha1"
 	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
 	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
@@ -47,8 +49,56 @@ const (
 	errGetResourceInstanceFailed  = "error getting ResourceInstance"
 	errCreateResourceInstanceOpts = "error creating ResourceInstance"
 	errUpdResourceInstance        = "error updating ResourceInstance"
+	errEstimateResourceInstance   = "could not estimate the monthly cost of the ResourceInstance"
 )
 
+// resourceInstanceDependents are the managed resources that reference resource instances, and must be deleted
+// before them
+var resourceInstanceDependents = []ibmc.Dependent{
+	{
+		Kind: v1alpha1.ResourceKeyKind,
+		List: func() resource.ManagedList { return &v1alpha1.ResourceKeyList{} },
+		DependsOn: func(mg resource.Managed, name string) bool {
+			return ibmc.IsReferenceTo(mg.(*v1alpha1.ResourceKey).Spec.ForProvider.SourceRef, name)
+		},
+	},
+	{
+		Kind: v1alpha1.ResourceAliasKind,
+		List: func() resource.ManagedList { return &v1alpha1.ResourceAliasList{} },
+		DependsOn: func(mg resource.Managed, name string) bool {
+			return ibmc.IsReferenceTo(mg.(*v1alpha1.ResourceAlias).Spec.ForProvider.SourceRef, name)
+		},
+	},
+	{
+		Kind: icdv5.WhitelistKind,
+		List: func() resource.ManagedList { return &icdv5.WhitelistList{} },
+		DependsOn: func(mg resource.Managed, name string) bool {
+			return ibmc.IsReferenceTo(mg.(*icdv5.Whitelist).Spec.ForProvider.IDRef, name)
+		},
+	},
+	{
+		Kind: icdv5.ScalingGroupKind,
+		List: func() resource.ManagedList { return &icdv5.ScalingGroupList{} },
+		DependsOn: func(mg resource.Managed, name string) bool {
+			return ibmc.IsReferenceTo(mg.(*icdv5.ScalingGroup).Spec.ForProvider.IDRef, name)
+		},
+	},
+	{
+		Kind: icdv5.AutoscalingGroupKind,
+		List: func() resource.ManagedList { return &icdv5.AutoscalingGroupList{} },
+		DependsOn: func(mg resource.Managed, name string) bool {
+			return ibmc.IsReferenceTo(mg.(*icdv5.AutoscalingGroup).Spec.ForProvider.IDRef, name)
+		},
+	},
+	{
+		Kind: cosv1alpha1.BucketKind,
+		List: func() resource.ManagedList { return &cosv1alpha1.BucketList{} },
+		DependsOn: func(mg resource.Managed, name string) bool {
+			return ibmc.IsReferenceTo(mg.(*cosv1alpha1.Bucket).Spec.ForProvider.IbmServiceInstanceIDRef, name)
+		},
+	},
+}
+
 // SetupResourceInstance adds a controller that reconciles ResourceInstance managed resources.
 func SetupResourceInstance(mgr ctrl.Manager, l logging.Logger) error {
 	name := managed.ControllerName(v1alpha1.ResourceInstanceGroupKind)
@@ -56,19 +106,22 @@ func SetupResourceInstance(mgr ctrl.Manager, l logging.Logger) error {
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.ResourceInstanceGroupVersionKind),
-		managed.WithExternalConnecter(&resourceinstanceConnector{
+		managed.WithExternalConnecter(ibmc.NewAuditConnecter(&resourceinstanceConnector{
 			kube:     mgr.GetClient(),
 			usage:    resource.NewProviderConfigUsageTracker(mgr.GetClient(), &v1beta1.ProviderConfigUsage{}),
 			clientFn: ibmc.NewClient,
-			logger:   log}),
-		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			logger:   log}, event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()),
+			ibmc.NewExpiration(mgr.GetClient(), event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+			ibmc.NewProviderConfigDefaults(mgr.GetClient(), resclient.ApplyProviderConfigDefaults)),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 		managed.WithLogger(log),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		For(&v1alpha1.ResourceInstance{}).
-		Complete(r)
+		Complete(ibmc.NewExpirationReconciler(mgr, resource.ManagedKind(v1alpha1.ResourceInstanceGroupVersionKind), r))
 }
 
 // A resourceinstanceConnector is expected to produce an ExternalClient when its Connect method
@@ -130,6 +183,10 @@ func (c *resourceinstanceExternal) Observe(ctx context.Context, mg resource.Mana
 	if err = resclient.LateInitializeSpec(c.client, &cr.Spec.ForProvider, instance); err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
 	}
+	lateInitSpec := cr.Spec.ForProvider.DeepCopy()
+	if err = ibmc.RestrictLateInitialization(cr, currentSpec, &cr.Spec.ForProvider); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
+	}
 	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
 		if err := c.kube.Update(ctx, cr); err != nil {
 			return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
@@ -141,6 +198,16 @@ func (c *resourceinstanceExternal) Observe(ctx context.Context, mg resource.Mana
 		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrGenObservation)
 	}
 
+	if pc := c.client.ProviderConfig(); ibmc.CostEstimationEnabled(pc) {
+		// an estimate is informational only, so failing to compute one does not fail the observation
+		cost, err := resclient.EstimateMonthlyCost(c.client, reference.FromPtrValue(instance.ResourcePlanID))
+		if err != nil {
+			c.logger.Info(errEstimateResourceInstance, "error", err)
+		} else {
+			cr.Status.AtProvider.EstimatedMonthlyCost = ibmc.FormatCost(pc, cost)
+		}
+	}
+
 	switch cr.Status.AtProvider.State {
 	case "active":
 		cr.Status.SetConditions(runtimev1alpha1.Available())
@@ -153,10 +220,16 @@ func (c *resourceinstanceExternal) Observe(ctx context.Context, mg resource.Mana
 	}
 	cr.Status.SetConditions(runtimev1alpha1.Available())
 
-	upToDate, err := resclient.IsUpToDate(c.client, &cr.Spec.ForProvider, instance, c.logger)
+	upToDate, err := resclient.IsUpToDate(c.client, lateInitSpec, instance, c.logger)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrCheckUpToDate)
 	}
+	if upToDate {
+		upToDate, err = ibmc.TagsUpToDate(c.client, cr.Status.AtProvider.CRN, nil, cr.Spec.ForProvider.AccessTags)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrCheckUpToDate)
+		}
+	}
 
 	return managed.ExternalObservation{
 		ResourceExists:    true,
@@ -171,6 +244,10 @@ func (c *resourceinstanceExternal) Create(ctx context.Context, mg resource.Manag
 		return managed.ExternalCreation{}, errors.New(errNotResourceInstance)
 	}
 
+	if err := c.enforcePolicies(cr, c.checkMonthlyBudget(cr)); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateResourceInstance)
+	}
+
 	cr.SetConditions(runtimev1alpha1.Creating())
 	resInstanceOptions := &rcv2.CreateResourceInstanceOptions{}
 	if err := resclient.GenerateCreateResourceInstanceOptions(c.client, cr.Spec.ForProvider, resInstanceOptions); err != nil {
@@ -192,6 +269,10 @@ func (c *resourceinstanceExternal) Update(ctx context.Context, mg resource.Manag
 		return managed.ExternalUpdate{}, errors.New(errNotResourceInstance)
 	}
 
+	if err := c.enforcePolicies(cr); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdResourceInstance)
+	}
+
 	id := cr.Status.AtProvider.ID
 	updInstanceOpts := &rcv2.UpdateResourceInstanceOptions{}
 	if err := resclient.GenerateUpdateResourceInstanceOptions(c.client, id, cr.Spec.ForProvider, updInstanceOpts); err != nil {
@@ -203,19 +284,59 @@ func (c *resourceinstanceExternal) Update(ctx context.Context, mg resource.Manag
 		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdResourceInstance)
 	}
 
-	if err = ibmc.UpdateResourceInstanceTags(c.client, cr.Status.AtProvider.CRN, cr.Spec.ForProvider.Tags); err != nil {
+	if err = ibmc.UpdateManagedTags(c.client, cr.Status.AtProvider.CRN, cr.Spec.ForProvider.Tags, cr.Spec.ForProvider.AccessTags); err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdResourceInstance)
 	}
 
 	return managed.ExternalUpdate{}, nil
 }
 
+// enforcePolicies checks the resource instance against the naming policy and guardrails of its ProviderConfig,
+// as well as against any additional checks
+func (c *resourceinstanceExternal) enforcePolicies(cr *v1alpha1.ResourceInstance, checks ...error) error {
+	pc := c.client.ProviderConfig()
+	p := cr.Spec.ForProvider
+	return ibmc.EnforcePolicies(cr, pc, append([]error{
+		ibmc.CheckNamingPolicy(pc, &p.Name),
+		ibmc.CheckGuardrails(pc, ibmc.GuardedValues{
+			Services: []string{p.ServiceName},
+			Plans:    []string{p.ResourcePlanName},
+			Regions:  []string{p.Target},
+		})}, checks...)...)
+}
+
+// checkMonthlyBudget checks the estimated monthly cost of the resource instance against the budget of its
+// ProviderConfig, if there is one
+func (c *resourceinstanceExternal) checkMonthlyBudget(cr *v1alpha1.ResourceInstance) error {
+	pc := c.client.ProviderConfig()
+	if !ibmc.CostEstimationEnabled(pc) || pc.CostEstimation.MonthlyBudget == nil {
+		return nil
+	}
+	planID, err := ibmc.GetResourcePlanID(c.client, cr.Spec.ForProvider.ServiceName, cr.Spec.ForProvider.ResourcePlanName)
+	if err != nil {
+		return errors.Wrap(err, errEstimateResourceInstance)
+	}
+	cost, err := resclient.EstimateMonthlyCost(c.client, reference.FromPtrValue(planID))
+	if err != nil {
+		return errors.Wrap(err, errEstimateResourceInstance)
+	}
+	return ibmc.CheckMonthlyBudget(pc, cost)
+}
+
 func (c *resourceinstanceExternal) Delete(ctx context.Context, mg resource.Managed) error {
 	cr, ok := mg.(*v1alpha1.ResourceInstance)
 	if !ok {
 		return errors.New(errNotResourceInstance)
 	}
 
+	if err := ibmc.CheckDeletionProtection(v1alpha1.ResourceInstanceKind, cr); err != nil {
+		return errors.Wrap(err, errDeleteResourceInstance)
+	}
+
+	if err := ibmc.CheckDependents(ctx, c.kube, cr, resourceInstanceDependents...); err != nil {
+		return errors.Wrap(err, errDeleteResourceInstance)
+	}
+
 	cr.SetConditions(runtimev1alpha1.Deleting())
 
 	_, err := c.client.ResourceControllerV2().DeleteResourceInstance(&rcv2.DeleteResourceInstanceOptions{ID: &cr.Status.AtProvider.ID})
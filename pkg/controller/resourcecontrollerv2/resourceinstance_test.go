@@ -46,6 +46,7 @@ import (
 	rmgrv2 "github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
 
 	"github.com/crossplane-contrib/provider-ibm-cloud/apis/resourcecontrollerv2/v1alpha1"
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
 	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
 	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/controller/tstutil"
 )
@@ -141,6 +142,15 @@ func withExternalNameAnnotation(externalName string) instanceModifier {
 	}
 }
 
+func withDeletionProtection() instanceModifier {
+	return func(i *v1alpha1.ResourceInstance) {
+		if i.ObjectMeta.Annotations == nil {
+			i.ObjectMeta.Annotations = make(map[string]string)
+		}
+		i.ObjectMeta.Annotations[ibmc.AnnotationDeletionProtection] = "true"
+	}
+}
+
 func withSpec(p v1alpha1.ResourceInstanceParameters) instanceModifier {
 	return func(r *v1alpha1.ResourceInstance) { r.Spec.ForProvider = p }
 }
@@ -715,6 +725,106 @@ func TestCreate(t *testing.T) {
 	}
 }
 
+func TestCreateNamingPolicy(t *testing.T) {
+	pc := &v1beta1.ProviderConfigSpec{NamingPolicy: &v1beta1.NamingPolicy{Prefix: reference.ToPtrValue("team-a-")}}
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	mClient, err := ibmc.GetTestClientWithProviderConfig(server.URL, pc)
+	if err != nil {
+		t.Fatalf("Create(...): problem setting up the test client %s", err)
+	}
+	e := &resourceinstanceExternal{client: mClient, logger: logging.NewNopLogger()}
+
+	mg := instance(withSpec(resourceInstanceSpec()))
+	cre, err := e.Create(context.Background(), mg)
+	wantErr := errors.Wrap(ibmc.CheckNamingPolicy(pc, &mg.Spec.ForProvider.Name), errCreateResourceInstance)
+	if diff := cmp.Diff(wantErr.Error(), err.Error()); diff != "" {
+		t.Errorf("Create(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(managed.ExternalCreation{}, cre); diff != "" {
+		t.Errorf("Create(...): -want, +got:\n%s", diff)
+	}
+	want := instance(withSpec(resourceInstanceSpec()), withConditions(ibmc.NonCompliant(ibmc.CheckNamingPolicy(pc, &mg.Spec.ForProvider.Name))))
+	if diff := cmp.Diff(want, mg); diff != "" {
+		t.Errorf("Create(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestCreateOverBudget(t *testing.T) {
+	pc := &v1beta1.ProviderConfigSpec{CostEstimation: &v1beta1.CostEstimation{MonthlyBudget: reference.ToPtrValue("100")}}
+	monthlyPrice := 120.0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", svcatHandler)
+	mux.HandleFunc("/"+serviceName+"/", pcatHandler)
+	mux.HandleFunc("/"+resourcePlanID+"/pricing", func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		w.Header().Set("Content-Type", "application/json")
+		pricing := gcat.PricingGet{
+			Metrics: []gcat.Metrics{{
+				ChargeUnitName:     reference.ToPtrValue("INSTANCES"),
+				ChargeUnitQuantity: reference.ToPtrValue("1"),
+				Amounts: []gcat.Amount{{
+					Country:  reference.ToPtrValue("USA"),
+					Currency: reference.ToPtrValue("USD"),
+					Prices:   []gcat.Price{{QuantityTier: ibmc.Int64Ptr(1), Price: &monthlyPrice}},
+				}},
+			}},
+		}
+		if err := json.NewEncoder(w).Encode(pricing); err != nil {
+			klog.Errorf("%s", err)
+		}
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mClient, err := ibmc.GetTestClientWithProviderConfig(server.URL, pc)
+	if err != nil {
+		t.Fatalf("Create(...): problem setting up the test client %s", err)
+	}
+	e := &resourceinstanceExternal{client: mClient, logger: logging.NewNopLogger()}
+
+	mg := instance(withSpec(resourceInstanceSpec()))
+	cre, err := e.Create(context.Background(), mg)
+	violation := ibmc.CheckMonthlyBudget(pc, 120)
+	if diff := cmp.Diff(errors.Wrap(violation, errCreateResourceInstance).Error(), err.Error()); diff != "" {
+		t.Errorf("Create(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(managed.ExternalCreation{}, cre); diff != "" {
+		t.Errorf("Create(...): -want, +got:\n%s", diff)
+	}
+	want := instance(withSpec(resourceInstanceSpec()), withConditions(ibmc.NonCompliant(violation)))
+	if diff := cmp.Diff(want, mg); diff != "" {
+		t.Errorf("Create(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestUpdateGuardrails(t *testing.T) {
+	pc := &v1beta1.ProviderConfigSpec{Guardrails: &v1beta1.Guardrails{Regions: &v1beta1.AllowDenyList{Denied: []string{target}}}}
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	mClient, err := ibmc.GetTestClientWithProviderConfig(server.URL, pc)
+	if err != nil {
+		t.Fatalf("Update(...): problem setting up the test client %s", err)
+	}
+	e := &resourceinstanceExternal{client: mClient, logger: logging.NewNopLogger()}
+
+	mg := instance(withID(id), withSpec(resourceInstanceSpec()))
+	upd, err := e.Update(context.Background(), mg)
+	violation := ibmc.CheckGuardrails(pc, ibmc.GuardedValues{Regions: []string{target}})
+	if diff := cmp.Diff(errors.Wrap(violation, errUpdResourceInstance).Error(), err.Error()); diff != "" {
+		t.Errorf("Update(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(managed.ExternalUpdate{}, upd); diff != "" {
+		t.Errorf("Update(...): -want, +got:\n%s", diff)
+	}
+	want := instance(withID(id), withSpec(resourceInstanceSpec()), withConditions(ibmc.NonCompliant(violation)))
+	if diff := cmp.Diff(want, mg); diff != "" {
+		t.Errorf("Update(...): -want, +got:\n%s", diff)
+	}
+}
+
 func TestDelete(t *testing.T) {
 	type want struct {
 		mg  resource.Managed
@@ -740,6 +850,7 @@ func TestDelete(t *testing.T) {
 					},
 				},
 			},
+			kube: &test.MockClient{MockList: test.NewMockListFn(nil)},
 			args: tstutil.Args{
 				Managed: instance(withID(id)),
 			},
@@ -762,6 +873,7 @@ func TestDelete(t *testing.T) {
 					},
 				},
 			},
+			kube: &test.MockClient{MockList: test.NewMockListFn(nil)},
 			args: tstutil.Args{
 				Managed: instance(withID(id)),
 			},
@@ -784,6 +896,7 @@ func TestDelete(t *testing.T) {
 					},
 				},
 			},
+			kube: &test.MockClient{MockList: test.NewMockListFn(nil)},
 			args: tstutil.Args{
 				Managed: instance(withID(id)),
 			},
@@ -792,6 +905,53 @@ func TestDelete(t *testing.T) {
 				err: errors.Wrap(errors.New(http.StatusText(http.StatusBadRequest)), errDeleteResourceInstance),
 			},
 		},
+		"DeletionProtected": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/v2/resource_instances/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						t.Errorf("r: unexpected %s request for a protected resource instance", r.Method)
+					},
+				},
+			},
+			kube: &test.MockClient{MockList: test.NewMockListFn(nil)},
+			args: tstutil.Args{
+				Managed: instance(withID(id), withDeletionProtection()),
+			},
+			want: want{
+				mg: instance(withID(id), withDeletionProtection()),
+				err: errors.Wrap(ibmc.CheckDeletionProtection(v1alpha1.ResourceInstanceKind, instance(withDeletionProtection())),
+					errDeleteResourceInstance),
+			},
+		},
+		"DependentsExist": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/v2/resource_instances/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						t.Errorf("r: unexpected %s request for a resource instance with dependents", r.Method)
+					},
+				},
+			},
+			kube: &test.MockClient{MockList: test.NewMockListFn(nil, func(obj runtime.Object) error {
+				if l, ok := obj.(*v1alpha1.ResourceKeyList); ok {
+					k := v1alpha1.ResourceKey{}
+					k.SetName("mykey")
+					k.Spec.ForProvider.SourceRef = &cpv1alpha1.Reference{Name: name}
+					l.Items = []v1alpha1.ResourceKey{k}
+				}
+				return nil
+			})},
+			args: tstutil.Args{
+				Managed: instance(withID(id)),
+			},
+			want: want{
+				mg: instance(withID(id), withConditions(ibmc.DeletionBlocked(
+					errors.New("waiting for the deletion of the managed resources that depend on the resource: ResourceKey/mykey")))),
+				err: errors.Wrap(errors.New("waiting for the deletion of the managed resources that depend on the resource: ResourceKey/mykey"),
+					errDeleteResourceInstance),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -18,6 +18,7 @@ package resourcecontrollerv2
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
@@ -34,6 +35,8 @@ import (
 
 	rcv2 "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 
+	cloudantv1alpha1 "github.com/crossplane-contrib/provider-ibm-cloud/apis/cloudantv1/v1alpha1"
+	esv1alpha1 "github.com/crossplane-contrib/provider-ibm-cloud/apis/eventstreamsadminv1/v1alpha1"
 	"github.com/crossplane-contrib/provider-ibm-cloud/apis/resourcecontrollerv2/v1alpha1"
 	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
 	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
@@ -47,8 +50,29 @@ const (
 	errGetResourceKeyFailed  = "error getting ResourceKey"
 	errCreateResourceKeyOpts = "error creating ResourceKey"
 	errUpdResourceKey        = "error updating ResourceKey"
+	errRotationResourceKey   = "error computing the rotation of ResourceKey"
+	errRotateResourceKey     = "could not rotate ResourceKey"
+	errDeleteRotatedKey      = "could not delete rotated ResourceKey"
 )
 
+// resourceKeyDependents are the managed resources that reference resource keys, and must be deleted before them
+var resourceKeyDependents = []ibmc.Dependent{
+	{
+		Kind: esv1alpha1.TopicKind,
+		List: func() resource.ManagedList { return &esv1alpha1.TopicList{} },
+		DependsOn: func(mg resource.Managed, name string) bool {
+			return ibmc.IsReferenceTo(mg.(*esv1alpha1.Topic).Spec.ForProvider.KafkaAdminURLRef, name)
+		},
+	},
+	{
+		Kind: cloudantv1alpha1.CloudantDatabaseKind,
+		List: func() resource.ManagedList { return &cloudantv1alpha1.CloudantDatabaseList{} },
+		DependsOn: func(mg resource.Managed, name string) bool {
+			return ibmc.IsReferenceTo(mg.(*cloudantv1alpha1.CloudantDatabase).Spec.ForProvider.CloudantAdminURLRef, name)
+		},
+	},
+}
+
 // SetupResourceKey adds a controller that reconciles ResourceKey managed resources.
 func SetupResourceKey(mgr ctrl.Manager, l logging.Logger) error {
 	name := managed.ControllerName(v1alpha1.ResourceKeyGroupKind)
@@ -56,19 +80,21 @@ func SetupResourceKey(mgr ctrl.Manager, l logging.Logger) error {
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.ResourceKeyGroupVersionKind),
-		managed.WithExternalConnecter(&resourcekeyConnector{
+		managed.WithExternalConnecter(ibmc.NewAuditConnecter(&resourcekeyConnector{
 			kube:     mgr.GetClient(),
 			usage:    resource.NewProviderConfigUsageTracker(mgr.GetClient(), &v1beta1.ProviderConfigUsage{}),
 			clientFn: ibmc.NewClient,
-			logger:   log}),
-		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			logger:   log}, event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()),
+			ibmc.NewExpiration(mgr.GetClient(), event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 		managed.WithLogger(log),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		For(&v1alpha1.ResourceKey{}).
-		Complete(r)
+		Complete(ibmc.NewExpirationReconciler(mgr, resource.ManagedKind(v1alpha1.ResourceKeyGroupVersionKind), r))
 }
 
 // A resourcekeyConnector is expected to produce an ExternalClient when its Connect method
@@ -129,6 +155,10 @@ func (c *resourcekeyExternal) Observe(ctx context.Context, mg resource.Managed)
 	if err = resclient.LateInitializeSpec(c.client, &cr.Spec.ForProvider, instance); err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
 	}
+	lateInitSpec := cr.Spec.ForProvider.DeepCopy()
+	if err = ibmc.RestrictLateInitialization(cr, currentSpec, &cr.Spec.ForProvider); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
+	}
 	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
 		if err := c.kube.Update(ctx, cr); err != nil {
 			return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
@@ -150,10 +180,30 @@ func (c *resourcekeyExternal) Observe(ctx context.Context, mg resource.Managed)
 	}
 	cr.Status.SetConditions(runtimev1alpha1.Available())
 
-	upToDate, err := resclient.IsUpToDate(c.client, &cr.Spec.ForProvider, instance, c.logger)
+	upToDate, err := resclient.IsUpToDate(c.client, lateInitSpec, instance, c.logger)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrCheckUpToDate)
 	}
+	if upToDate {
+		upToDate, err = ibmc.TagsUpToDate(c.client, cr.Status.AtProvider.CRN, cr.Spec.ForProvider.Tags, cr.Spec.ForProvider.AccessTags)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrCheckUpToDate)
+		}
+	}
+
+	// a due rotation, or replaced keys past their overlap window, are handled by Update
+	now := time.Now()
+	rotationDue, err := resclient.IsRotationDue(cr, now)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errRotationResourceKey)
+	}
+	expired, err := resclient.ExpiredRotatedKeys(cr, now)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errRotationResourceKey)
+	}
+	if rotationDue || len(expired) > 0 {
+		upToDate = false
+	}
 
 	cd, err := resclient.GetConnectionDetails(cr, instance)
 	if err != nil {
@@ -173,6 +223,10 @@ func (c *resourcekeyExternal) Create(ctx context.Context, mg resource.Managed) (
 		return managed.ExternalCreation{}, errors.New(errNotResourceKey)
 	}
 
+	if err := c.enforcePolicies(cr); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateResourceKey)
+	}
+
 	cr.SetConditions(runtimev1alpha1.Creating())
 	resInstanceOptions := &rcv2.CreateResourceKeyOptions{}
 	if err := resclient.GenerateCreateResourceKeyOptions(c.client, cr.Spec.ForProvider, resInstanceOptions); err != nil {
@@ -194,29 +248,139 @@ func (c *resourcekeyExternal) Update(ctx context.Context, mg resource.Managed) (
 		return managed.ExternalUpdate{}, errors.New(errNotResourceKey)
 	}
 
+	if err := c.enforcePolicies(cr); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdResourceKey)
+	}
+
+	now := time.Now()
+	rotationDue, err := resclient.IsRotationDue(cr, now)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errRotationResourceKey)
+	}
+	if rotationDue {
+		return c.rotate(ctx, cr, now)
+	}
+
+	expired, err := resclient.ExpiredRotatedKeys(cr, now)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errRotationResourceKey)
+	}
+	for _, id := range expired {
+		if _, err := c.client.ResourceControllerV2().DeleteResourceKey(&rcv2.DeleteResourceKeyOptions{ID: reference.ToPtrValue(id)}); err != nil && !ibmc.IsResourceGone(err) {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDeleteRotatedKey)
+		}
+		if err := resclient.MarkRotatedKeyDeleted(cr, id, now); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRotationResourceKey)
+		}
+	}
+	if len(expired) > 0 {
+		if err := c.updateKeepingStatus(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
+		}
+	}
+
 	id := cr.Status.AtProvider.ID
 	updInstanceOpts := &rcv2.UpdateResourceKeyOptions{}
 	if err := resclient.GenerateUpdateResourceKeyOptions(c.client, id, cr.Spec.ForProvider, updInstanceOpts); err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdResourceKey)
 	}
 
-	_, _, err := c.client.ResourceControllerV2().UpdateResourceKey(updInstanceOpts)
+	_, _, err = c.client.ResourceControllerV2().UpdateResourceKey(updInstanceOpts)
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdResourceKey)
 	}
 
+	if err = ibmc.UpdateManagedTags(c.client, cr.Status.AtProvider.CRN, cr.Spec.ForProvider.Tags, cr.Spec.ForProvider.AccessTags); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdResourceKey)
+	}
+
 	return managed.ExternalUpdate{}, nil
 }
 
+// rotate replaces the current key with a new one, publishing the credentials of the new key. The
+// replaced key is recorded in the rotation history, and deleted once the overlap window has passed.
+func (c *resourcekeyExternal) rotate(ctx context.Context, cr *v1alpha1.ResourceKey, now time.Time) (managed.ExternalUpdate, error) {
+	resInstanceOptions := &rcv2.CreateResourceKeyOptions{}
+	if err := resclient.GenerateCreateResourceKeyOptions(c.client, cr.Spec.ForProvider, resInstanceOptions); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errCreateResourceKeyOpts)
+	}
+
+	key, _, err := c.client.ResourceControllerV2().CreateResourceKey(resInstanceOptions)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errRotateResourceKey)
+	}
+
+	// the reconciler only persists the status after an update, so the new external name, and the
+	// replaced key still to be deleted, have to be saved here before the new key is published.
+	previous := cr.DeepCopy()
+	meta.SetExternalName(cr, reference.FromPtrValue(key.ID))
+	err = resclient.RecordRotation(cr, meta.GetExternalName(previous), now)
+	if err == nil {
+		err = c.updateKeepingStatus(ctx, cr)
+	}
+	if err != nil {
+		cr.SetAnnotations(previous.GetAnnotations())
+		cr.Status = previous.Status
+		if _, derr := c.client.ResourceControllerV2().DeleteResourceKey(&rcv2.DeleteResourceKeyOptions{ID: key.ID}); derr != nil {
+			c.logger.Info("could not delete the new key of a failed rotation", "id", reference.FromPtrValue(key.ID), "error", derr)
+		}
+		return managed.ExternalUpdate{}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
+	}
+
+	cr.Status.AtProvider, err = resclient.GenerateObservation(c.client, key)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, ibmc.ErrGenObservation)
+	}
+
+	cd, err := resclient.GetConnectionDetails(cr, key)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, ibmc.ErrGetConnDetails)
+	}
+	return managed.ExternalUpdate{ConnectionDetails: cd}, nil
+}
+
+// enforcePolicies checks the name of the resource key against the naming policy of its ProviderConfig
+func (c *resourcekeyExternal) enforcePolicies(cr *v1alpha1.ResourceKey) error {
+	pc := c.client.ProviderConfig()
+	return ibmc.EnforcePolicies(cr, pc, ibmc.CheckNamingPolicy(pc, &cr.Spec.ForProvider.Name))
+}
+
+// updateKeepingStatus updates the given managed resource, restoring its status which the update resets.
+func (c *resourcekeyExternal) updateKeepingStatus(ctx context.Context, cr *v1alpha1.ResourceKey) error {
+	status := cr.Status.DeepCopy()
+	if err := c.kube.Update(ctx, cr); err != nil {
+		return err
+	}
+	cr.Status = *status
+	return nil
+}
+
 func (c *resourcekeyExternal) Delete(ctx context.Context, mg resource.Managed) error {
 	cr, ok := mg.(*v1alpha1.ResourceKey)
 	if !ok {
 		return errors.New(errNotResourceKey)
 	}
 
+	if err := ibmc.CheckDependents(ctx, c.kube, cr, resourceKeyDependents...); err != nil {
+		return errors.Wrap(err, errDeleteResourceKey)
+	}
+
 	cr.SetConditions(runtimev1alpha1.Deleting())
 
-	_, err := c.client.ResourceControllerV2().DeleteResourceKey(&rcv2.DeleteResourceKeyOptions{ID: &cr.Status.AtProvider.ID})
+	pending, err := resclient.PendingRotatedKeys(cr)
+	if err != nil {
+		return errors.Wrap(err, errDeleteResourceKey)
+	}
+	for _, id := range pending {
+		if _, err := c.client.ResourceControllerV2().DeleteResourceKey(&rcv2.DeleteResourceKeyOptions{ID: reference.ToPtrValue(id)}); err != nil && !ibmc.IsResourceGone(err) {
+			return errors.Wrap(err, errDeleteRotatedKey)
+		}
+		if err := resclient.MarkRotatedKeyDeleted(cr, id, time.Now()); err != nil {
+			return errors.Wrap(err, errDeleteResourceKey)
+		}
+	}
+
+	_, err = c.client.ResourceControllerV2().DeleteResourceKey(&rcv2.DeleteResourceKeyOptions{ID: &cr.Status.AtProvider.ID})
 	if err != nil {
 		return errors.Wrap(resource.Ignore(ibmc.IsResourceGone, err), errDeleteResourceKey)
 	}
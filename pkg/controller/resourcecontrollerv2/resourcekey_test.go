@@ -23,9 +23,11 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -43,7 +45,9 @@ import (
 	rcv2 "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 
 	"github.com/crossplane-contrib/provider-ibm-cloud/apis/resourcecontrollerv2/v1alpha1"
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
 	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+	resclient "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/resourcekey"
 	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/controller/tstutil"
 )
 
@@ -590,6 +594,7 @@ func TestResourceKeyDelete(t *testing.T) {
 					},
 				},
 			},
+			kube: &test.MockClient{MockList: test.NewMockListFn(nil)},
 			args: tstutil.Args{
 				Managed: key(rkWithID(id)),
 			},
@@ -612,6 +617,7 @@ func TestResourceKeyDelete(t *testing.T) {
 					},
 				},
 			},
+			kube: &test.MockClient{MockList: test.NewMockListFn(nil)},
 			args: tstutil.Args{
 				Managed: key(rkWithID(id)),
 			},
@@ -634,6 +640,7 @@ func TestResourceKeyDelete(t *testing.T) {
 					},
 				},
 			},
+			kube: &test.MockClient{MockList: test.NewMockListFn(nil)},
 			args: tstutil.Args{
 				Managed: key(rkWithID(id)),
 			},
@@ -766,3 +773,202 @@ func TestResourceKeyUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestResourceKeyUpdateNamingPolicy(t *testing.T) {
+	pc := &v1beta1.ProviderConfigSpec{NamingPolicy: &v1beta1.NamingPolicy{Prefix: reference.ToPtrValue("team-a-")}}
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	mClient, err := ibmc.GetTestClientWithProviderConfig(server.URL, pc)
+	if err != nil {
+		t.Fatalf("Update(...): problem setting up the test client %s", err)
+	}
+	e := &resourcekeyExternal{client: mClient, logger: logging.NewNopLogger()}
+
+	mg := genTestCRResourceKey()
+	upd, err := e.Update(context.Background(), mg)
+	violation := ibmc.CheckNamingPolicy(pc, &mg.Spec.ForProvider.Name)
+	if diff := cmp.Diff(errors.Wrap(violation, errUpdResourceKey).Error(), err.Error()); diff != "" {
+		t.Errorf("Update(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(managed.ExternalUpdate{}, upd); diff != "" {
+		t.Errorf("Update(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(genTestCRResourceKey(rkWithConditions(ibmc.NonCompliant(violation))), mg); diff != "" {
+		t.Errorf("Update(...): -want, +got:\n%s", diff)
+	}
+}
+
+func rkWithRotation(r *v1alpha1.ResourceKeyRotation) keyModifier {
+	return func(i *v1alpha1.ResourceKey) { i.Spec.Rotation = r }
+}
+
+func rkWithRotationStatus(s *v1alpha1.ResourceKeyRotationStatus) keyModifier {
+	return func(i *v1alpha1.ResourceKey) { i.Status.Rotation = s }
+}
+
+func rkWithRotatedKeys(keys string) keyModifier {
+	return func(i *v1alpha1.ResourceKey) {
+		meta.AddAnnotations(i, map[string]string{resclient.AnnotationRotatedKeys: keys})
+	}
+}
+
+func TestResourceKeyRotation(t *testing.T) {
+	newRkID := "crn:v1:bluemix:public:cloud-object-storage:global:a/0b5a00334eaf9eb9339d2ab48f20d7f5:f931e669-6c11-4d4d-b720-8b2f844a6d9e:resource-key:4b5c6d7e-283f-443c-9aca-cd3f72c6f493"
+	newApikey := "new-api-key"
+	errBoom := errors.New("boom")
+	rotation := &v1alpha1.ResourceKeyRotation{
+		Interval: &metav1.Duration{Duration: 90 * 24 * time.Hour},
+		Overlap:  &metav1.Duration{Duration: 24 * time.Hour},
+	}
+	past := metav1.NewTime(time.Now().Add(-time.Hour))
+	future := metav1.NewTime(time.Now().Add(time.Hour))
+	rotatedKeys := func(deleteAfter metav1.Time) string {
+		return fmt.Sprintf(`{%q:%q}`, rkID, deleteAfter.UTC().Format(time.RFC3339))
+	}
+
+	type want struct {
+		externalName string
+		rotatedKeys  []string
+		history      []string
+		deleted      []string
+		requests     []string
+		cd           bool
+		err          error
+	}
+	cases := map[string]struct {
+		kube client.Client
+		cr   *v1alpha1.ResourceKey
+		want want
+	}{
+		"RotationDue": {
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			cr:   genTestCRResourceKey(rkWithRotation(rotation)),
+			want: want{
+				externalName: newRkID,
+				rotatedKeys:  []string{rkName},
+				history:      []string{rkName},
+				requests:     []string{http.MethodPost},
+				cd:           true,
+			},
+		},
+		"OverlapNotPassed": {
+			cr: genTestCRResourceKey(rkWithRotation(rotation), rkWithRotatedKeys(rotatedKeys(future)), rkWithRotationStatus(&v1alpha1.ResourceKeyRotationStatus{
+				LastRotationTime: &past,
+				History:          []v1alpha1.RotatedResourceKey{{ID: rkID, RotatedAt: past, DeleteAfter: future}},
+			})),
+			want: want{
+				externalName: rkName,
+				rotatedKeys:  []string{rkID},
+				history:      []string{rkID},
+				requests:     []string{http.MethodPatch},
+			},
+		},
+		"OverlapPassed": {
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			cr: genTestCRResourceKey(rkWithRotation(rotation), rkWithRotatedKeys(rotatedKeys(past)), rkWithRotationStatus(&v1alpha1.ResourceKeyRotationStatus{
+				LastRotationTime: &past,
+				History:          []v1alpha1.RotatedResourceKey{{ID: rkID, RotatedAt: past, DeleteAfter: past}},
+			})),
+			want: want{
+				externalName: rkName,
+				history:      []string{rkID},
+				deleted:      []string{rkID},
+				requests:     []string{http.MethodDelete, http.MethodPatch},
+			},
+		},
+		"OverlapPassedHistoryLost": {
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			cr: genTestCRResourceKey(rkWithRotation(rotation), rkWithRotatedKeys(rotatedKeys(past)), rkWithRotationStatus(&v1alpha1.ResourceKeyRotationStatus{
+				LastRotationTime: &past,
+			})),
+			want: want{
+				externalName: rkName,
+				requests:     []string{http.MethodDelete, http.MethodPatch},
+			},
+		},
+		"UpdateManagedFails": {
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			cr:   genTestCRResourceKey(rkWithRotation(rotation)),
+			want: want{
+				externalName: rkName,
+				requests:     []string{http.MethodPost, http.MethodDelete},
+				err:          errors.Wrap(errBoom, ibmc.ErrManagedUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var requests []string
+			handlers := []tstutil.Handler{
+				{
+					Path: "/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						requests = append(requests, r.Method)
+						if r.Method == http.MethodDelete {
+							w.WriteHeader(http.StatusNoContent)
+							return
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusOK)
+						rk := genTestSDKResourceKey()
+						if r.Method == http.MethodPost {
+							rk.ID = &newRkID
+							rk.Credentials = &rcv2.Credentials{Apikey: &newApikey}
+						}
+						if err := json.NewEncoder(w).Encode(rk); err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			}
+			e, server, err := setupServerAndGetUnitTestExternalRK(t, &handlers, &tc.kube)
+			if err != nil {
+				t.Errorf("Update(...): problem setting up the test server %s", err)
+			}
+			defer server.Close()
+
+			upd, err := e.Update(context.Background(), tc.cr)
+			if tc.want.err != nil && err != nil {
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Update(...): -want, +got:\n%s", diff)
+				}
+			} else if diff := cmp.Diff(tc.want.err, err); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.requests, requests); diff != "" {
+				t.Errorf("Update(...): requests -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(tc.cr)); diff != "" {
+				t.Errorf("Update(...): external name -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cd, string(upd.ConnectionDetails["apikey"]) == newApikey); diff != "" {
+				t.Errorf("Update(...): connection details -want, +got:\n%s", diff)
+			}
+			pending, err := resclient.PendingRotatedKeys(tc.cr)
+			if err != nil {
+				t.Errorf("Update(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.want.rotatedKeys, pending, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Update(...): rotated keys -want, +got:\n%s", diff)
+			}
+			var history, deleted []string
+			if tc.cr.Status.Rotation != nil {
+				for _, k := range tc.cr.Status.Rotation.History {
+					history = append(history, k.ID)
+					if k.DeletedAt != nil {
+						deleted = append(deleted, k.ID)
+					}
+				}
+			}
+			if diff := cmp.Diff(tc.want.history, history); diff != "" {
+				t.Errorf("Update(...): history -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("Update(...): deleted -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcemanagerv2
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	rmgrv2 "github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/resourcemanagerv2/v1alpha1"
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+	rgclient "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/resourcegroup"
+)
+
+const (
+	errNotResourceGroup        = "managed resource is not a ResourceGroup custom resource"
+	errCreateResourceGroup     = "could not create ResourceGroup"
+	errDeleteResourceGroup     = "could not delete ResourceGroup"
+	errGetResourceGroupFailed  = "error getting ResourceGroup"
+	errGetQuotaFailed          = "error getting the quota definition of the ResourceGroup"
+	errCreateResourceGroupOpts = "error creating ResourceGroup options"
+	errUpdResourceGroup        = "error updating ResourceGroup"
+)
+
+// SetupResourceGroup adds a controller that reconciles ResourceGroup managed resources.
+func SetupResourceGroup(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.ResourceGroupGroupKind)
+	log := l.WithValues("resourcegroup-controller", name)
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ResourceGroupGroupVersionKind),
+		managed.WithExternalConnecter(ibmc.NewAuditConnecter(&resourcegroupConnector{
+			kube:     mgr.GetClient(),
+			usage:    resource.NewProviderConfigUsageTracker(mgr.GetClient(), &v1beta1.ProviderConfigUsage{}),
+			clientFn: ibmc.NewClient,
+			logger:   log}, event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()),
+			ibmc.NewExpiration(mgr.GetClient(), event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+		managed.WithLogger(log),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.ResourceGroup{}).
+		Complete(ibmc.NewExpirationReconciler(mgr, resource.ManagedKind(v1alpha1.ResourceGroupGroupVersionKind), r))
+}
+
+// A resourcegroupConnector is expected to produce an ExternalClient when its Connect method
+// is called.
+type resourcegroupConnector struct {
+	kube     client.Client
+	usage    resource.Tracker
+	clientFn func(optd ibmc.ClientOptions) (ibmc.ClientSession, error)
+	logger   logging.Logger
+}
+
+// Connect produces an ExternalClient for IBM Cloud API
+func (c *resourcegroupConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	opts, err := ibmc.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, ibmc.ErrGetAuth)
+	}
+
+	service, err := c.clientFn(opts)
+	if err != nil {
+		return nil, errors.Wrap(err, ibmc.ErrNewClient)
+	}
+
+	return &resourcegroupExternal{client: service, kube: c.kube, logger: c.logger}, nil
+}
+
+// An resourcegroupExternal observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type resourcegroupExternal struct {
+	client ibmc.ClientSession
+	kube   client.Client
+	logger logging.Logger
+}
+
+func (c *resourcegroupExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ResourceGroup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotResourceGroup)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	group, _, err := c.client.ResourceManagerV2().GetResourceGroup(&rmgrv2.GetResourceGroupOptions{ID: reference.ToPtrValue(meta.GetExternalName(cr))})
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ibmc.IsResourceNotFound, err), errGetResourceGroupFailed)
+	}
+
+	// a deleted resource group can still be retrieved for a while, in a deleted state
+	if meta.WasDeleted(cr) && rgclient.IsDeleted(reference.FromPtrValue(group.State)) {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	if err = rgclient.LateInitializeSpec(&cr.Spec.ForProvider, group); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
+	}
+	lateInitSpec := cr.Spec.ForProvider.DeepCopy()
+	if err = ibmc.RestrictLateInitialization(cr, currentSpec, &cr.Spec.ForProvider); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
+	}
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := c.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
+		}
+	}
+
+	var quota *rmgrv2.QuotaDefinition
+	if group.QuotaID != nil {
+		quota, _, err = c.client.ResourceManagerV2().GetQuotaDefinition(&rmgrv2.GetQuotaDefinitionOptions{ID: group.QuotaID})
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetQuotaFailed)
+		}
+	}
+
+	cr.Status.AtProvider, err = rgclient.GenerateObservation(group, quota)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrGenObservation)
+	}
+
+	if rgclient.IsActive(cr.Status.AtProvider.State) {
+		cr.Status.SetConditions(runtimev1alpha1.Available())
+	} else {
+		cr.Status.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	upToDate, err := rgclient.IsUpToDate(lateInitSpec, group, c.logger)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrCheckUpToDate)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: nil,
+	}, nil
+}
+
+func (c *resourcegroupExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ResourceGroup)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotResourceGroup)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+	createOpts := &rmgrv2.CreateResourceGroupOptions{}
+	if err := rgclient.GenerateCreateResourceGroupOptions(cr.Spec.ForProvider, createOpts); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateResourceGroupOpts)
+	}
+
+	group, _, err := c.client.ResourceManagerV2().CreateResourceGroup(createOpts)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateResourceGroup)
+	}
+	ibmc.InvalidateResourceGroupsCache(c.client)
+
+	meta.SetExternalName(cr, reference.FromPtrValue(group.ID))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (c *resourcegroupExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ResourceGroup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotResourceGroup)
+	}
+
+	updOpts := &rmgrv2.UpdateResourceGroupOptions{}
+	if err := rgclient.GenerateUpdateResourceGroupOptions(meta.GetExternalName(cr), cr.Spec.ForProvider, updOpts); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdResourceGroup)
+	}
+
+	_, _, err := c.client.ResourceManagerV2().UpdateResourceGroup(updOpts)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdResourceGroup)
+	}
+	ibmc.InvalidateResourceGroupsCache(c.client)
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *resourcegroupExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ResourceGroup)
+	if !ok {
+		return errors.New(errNotResourceGroup)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+
+	_, err := c.client.ResourceManagerV2().DeleteResourceGroup(&rmgrv2.DeleteResourceGroupOptions{ID: reference.ToPtrValue(meta.GetExternalName(cr))})
+	if err != nil {
+		return errors.Wrap(resource.Ignore(ibmc.IsResourceGone, err), errDeleteResourceGroup)
+	}
+	ibmc.InvalidateResourceGroupsCache(c.client)
+
+	return nil
+}
@@ -0,0 +1,601 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcemanagerv2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cpv1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	rmgrv2 "github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/resourcemanagerv2/v1alpha1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/controller/tstutil"
+)
+
+var (
+	name         = "tenant-a"
+	newName      = "tenant-b"
+	id           = "0be5ad401ae913d8ff665d92680664ed"
+	accountID    = "aa5a00334eaf9eb9339d2ab48f20d7ff"
+	crn          = "crn:v1:bluemix:public:resource-controller::a/aa5a00334eaf9eb9339d2ab48f20d7ff::resource-group:0be5ad401ae913d8ff665d92680664ed"
+	state        = "ACTIVE"
+	quotaID      = "7ce89f4a4e2f4ac0b5c8b7a3a0f6a5a4"
+	quotaName    = "Trial Quota"
+	createdAt, _ = strfmt.ParseDateTime("2020-10-31T02:33:06Z")
+)
+
+var _ managed.ExternalConnecter = &resourcegroupConnector{}
+var _ managed.ExternalClient = &resourcegroupExternal{}
+
+type groupModifier func(*v1alpha1.ResourceGroup)
+
+func withConditions(c ...cpv1alpha1.Condition) groupModifier {
+	return func(i *v1alpha1.ResourceGroup) { i.Status.SetConditions(c...) }
+}
+
+func withExternalName(n string) groupModifier {
+	return func(i *v1alpha1.ResourceGroup) { meta.SetExternalName(i, n) }
+}
+
+func withDeletionTimestamp(t metav1.Time) groupModifier {
+	return func(i *v1alpha1.ResourceGroup) { i.SetDeletionTimestamp(&t) }
+}
+
+func withSpec(p v1alpha1.ResourceGroupParameters) groupModifier {
+	return func(i *v1alpha1.ResourceGroup) { i.Spec.ForProvider = p }
+}
+
+func withObservation(o v1alpha1.ResourceGroupObservation) groupModifier {
+	return func(i *v1alpha1.ResourceGroup) { i.Status.AtProvider = o }
+}
+
+func group(m ...groupModifier) *v1alpha1.ResourceGroup {
+	i := &v1alpha1.ResourceGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Finalizers:  []string{},
+			Annotations: map[string]string{},
+		},
+		Spec: v1alpha1.ResourceGroupSpec{
+			ForProvider: v1alpha1.ResourceGroupParameters{},
+		},
+	}
+	for _, f := range m {
+		f(i)
+	}
+	return i
+}
+
+func groupSpec() v1alpha1.ResourceGroupParameters {
+	return v1alpha1.ResourceGroupParameters{
+		Name:      name,
+		AccountID: &accountID,
+	}
+}
+
+func groupObservation() v1alpha1.ResourceGroupObservation {
+	return v1alpha1.ResourceGroupObservation{
+		ID:        id,
+		CRN:       crn,
+		AccountID: accountID,
+		State:     state,
+		QuotaID:   quotaID,
+		Quota: &v1alpha1.QuotaDefinitionObservation{
+			ID:   quotaID,
+			Name: quotaName,
+		},
+		CreatedAt: ibmc.DateTimeToMetaV1Time(&createdAt),
+	}
+}
+
+func genTestSDKResourceGroup() *rmgrv2.ResourceGroup {
+	return &rmgrv2.ResourceGroup{
+		ID:        &id,
+		CRN:       &crn,
+		AccountID: &accountID,
+		Name:      &name,
+		State:     &state,
+		QuotaID:   &quotaID,
+		CreatedAt: &createdAt,
+	}
+}
+
+func encode(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		klog.Errorf("%s", err)
+	}
+}
+
+// handler to mock client SDK call to the resource manager API for a resource group
+func groupHandler(t *testing.T, method string, status int) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		if diff := cmp.Diff(method, r.Method); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		encode(w, status, genTestSDKResourceGroup())
+	}
+}
+
+// handler to mock client SDK call to the resource manager API for a quota definition
+var quotaHandler = func(w http.ResponseWriter, r *http.Request) {
+	_ = r.Body.Close()
+	encode(w, http.StatusOK, &rmgrv2.QuotaDefinition{ID: &quotaID, Name: &quotaName})
+}
+
+func setupServerAndGetUnitTestExternal(testingObj *testing.T, handlers *[]tstutil.Handler, kube *client.Client) (*resourcegroupExternal, *httptest.Server, error) {
+	mClient, tstServer, err := tstutil.SetupTestServerClient(testingObj, handlers)
+	if err != nil || mClient == nil || tstServer == nil {
+		return nil, nil, err
+	}
+
+	return &resourcegroupExternal{
+			kube:   *kube,
+			client: *mClient,
+			logger: logging.NewNopLogger(),
+		},
+		tstServer,
+		nil
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"NoExternalName": {
+			args: tstutil.Args{
+				Managed: group(withSpec(groupSpec())),
+			},
+			want: want{
+				mg: group(withSpec(groupSpec())),
+			},
+		},
+		"NotFound": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/resource_groups/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						encode(w, http.StatusNotFound, &rmgrv2.ResourceGroup{})
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: group(withExternalName(id), withSpec(groupSpec())),
+			},
+			want: want{
+				mg: group(withExternalName(id), withSpec(groupSpec())),
+			},
+		},
+		"Deleted": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/resource_groups/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						g := genTestSDKResourceGroup()
+						g.State = reference.ToPtrValue("DELETED")
+						encode(w, http.StatusOK, g)
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: group(withExternalName(id), withSpec(groupSpec()), withDeletionTimestamp(metav1.Unix(1, 0))),
+			},
+			want: want{
+				mg: group(withExternalName(id), withSpec(groupSpec()), withDeletionTimestamp(metav1.Unix(1, 0))),
+			},
+		},
+		"SuspendedBeingDeleted": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/resource_groups/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						g := genTestSDKResourceGroup()
+						g.State = reference.ToPtrValue("SUSPENDED")
+						encode(w, http.StatusOK, g)
+					},
+				},
+				{
+					Path:        "/quota_definitions/",
+					HandlerFunc: quotaHandler,
+				},
+			},
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: tstutil.Args{
+				Managed: group(withExternalName(id), withSpec(groupSpec()), withDeletionTimestamp(metav1.Unix(1, 0))),
+			},
+			want: want{
+				mg: group(withExternalName(id), withSpec(groupSpec()), withDeletionTimestamp(metav1.Unix(1, 0)),
+					withObservation(func() v1alpha1.ResourceGroupObservation {
+						o := groupObservation()
+						o.State = "SUSPENDED"
+						return o
+					}()), withConditions(cpv1alpha1.Unavailable())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"GetFailed": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/resource_groups/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						encode(w, http.StatusBadRequest, &rmgrv2.ResourceGroup{})
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: group(withExternalName(id), withSpec(groupSpec())),
+			},
+			want: want{
+				mg:  group(withExternalName(id), withSpec(groupSpec())),
+				err: errors.New(errGetResourceGroupFailed + ": Bad Request"),
+			},
+		},
+		"UpToDate": {
+			handlers: []tstutil.Handler{
+				{
+					Path:        "/resource_groups/",
+					HandlerFunc: groupHandler(t, http.MethodGet, http.StatusOK),
+				},
+				{
+					Path:        "/quota_definitions/",
+					HandlerFunc: quotaHandler,
+				},
+			},
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: tstutil.Args{
+				Managed: group(withExternalName(id), withSpec(v1alpha1.ResourceGroupParameters{Name: name})),
+			},
+			want: want{
+				mg: group(withExternalName(id), withSpec(groupSpec()), withObservation(groupObservation()),
+					withConditions(cpv1alpha1.Available())),
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			handlers: []tstutil.Handler{
+				{
+					Path:        "/resource_groups/",
+					HandlerFunc: groupHandler(t, http.MethodGet, http.StatusOK),
+				},
+				{
+					Path:        "/quota_definitions/",
+					HandlerFunc: quotaHandler,
+				},
+			},
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: tstutil.Args{
+				Managed: group(withExternalName(id), withSpec(v1alpha1.ResourceGroupParameters{Name: newName, AccountID: &accountID})),
+			},
+			want: want{
+				mg: group(withExternalName(id), withSpec(v1alpha1.ResourceGroupParameters{Name: newName, AccountID: &accountID}),
+					withObservation(groupObservation()), withConditions(cpv1alpha1.Available())),
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, err := setupServerAndGetUnitTestExternal(t, &tc.handlers, &tc.kube)
+			if err != nil {
+				t.Errorf("Observe(...): problem setting up the test server %s", err)
+			}
+			defer server.Close()
+
+			obs, err := e.Observe(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Observe(...): -want, +got:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Observe(...): -want, +got:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		cre managed.ExternalCreation
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"Successful": {
+			handlers: []tstutil.Handler{
+				{
+					Path:        "/resource_groups",
+					HandlerFunc: groupHandler(t, http.MethodPost, http.StatusCreated),
+				},
+			},
+			args: tstutil.Args{
+				Managed: group(withSpec(groupSpec())),
+			},
+			want: want{
+				mg:  group(withSpec(groupSpec()), withConditions(cpv1alpha1.Creating()), withExternalName(id)),
+				cre: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"Failed": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/resource_groups",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						encode(w, http.StatusBadRequest, &rmgrv2.ResourceGroup{})
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: group(withSpec(groupSpec())),
+			},
+			want: want{
+				mg:  group(withSpec(groupSpec()), withConditions(cpv1alpha1.Creating())),
+				err: errors.Wrap(errors.New(http.StatusText(http.StatusBadRequest)), errCreateResourceGroup),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, err := setupServerAndGetUnitTestExternal(t, &tc.handlers, &tc.kube)
+			if err != nil {
+				t.Errorf("Create(...): problem setting up the test server %s", err)
+			}
+			defer server.Close()
+
+			cre, err := e.Create(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Create(...): -want, +got:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Create(...): -want, +got:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.cre, cre); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		upd managed.ExternalUpdate
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"Successful": {
+			handlers: []tstutil.Handler{
+				{
+					Path:        "/resource_groups/",
+					HandlerFunc: groupHandler(t, http.MethodPatch, http.StatusOK),
+				},
+			},
+			args: tstutil.Args{
+				Managed: group(withExternalName(id), withSpec(groupSpec())),
+			},
+			want: want{
+				mg: group(withExternalName(id), withSpec(groupSpec())),
+			},
+		},
+		"Failed": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/resource_groups/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						encode(w, http.StatusBadRequest, &rmgrv2.ResourceGroup{})
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: group(withExternalName(id), withSpec(groupSpec())),
+			},
+			want: want{
+				mg:  group(withExternalName(id), withSpec(groupSpec())),
+				err: errors.Wrap(errors.New(http.StatusText(http.StatusBadRequest)), errUpdResourceGroup),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, err := setupServerAndGetUnitTestExternal(t, &tc.handlers, &tc.kube)
+			if err != nil {
+				t.Errorf("Update(...): problem setting up the test server %s", err)
+			}
+			defer server.Close()
+
+			upd, err := e.Update(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Update(...): -want, +got:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Update(...): -want, +got:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.upd, upd); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"Successful": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/resource_groups/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.WriteHeader(http.StatusNoContent)
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: group(withExternalName(id)),
+			},
+			want: want{
+				mg: group(withExternalName(id), withConditions(cpv1alpha1.Deleting())),
+			},
+		},
+		"AlreadyGone": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/resource_groups/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						w.WriteHeader(http.StatusNotFound)
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: group(withExternalName(id)),
+			},
+			want: want{
+				mg: group(withExternalName(id), withConditions(cpv1alpha1.Deleting())),
+			},
+		},
+		"Failed": {
+			handlers: []tstutil.Handler{
+				{
+					Path: "/resource_groups/",
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						_ = r.Body.Close()
+						w.WriteHeader(http.StatusBadRequest)
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: group(withExternalName(id)),
+			},
+			want: want{
+				mg:  group(withExternalName(id), withConditions(cpv1alpha1.Deleting())),
+				err: errors.Wrap(errors.New(http.StatusText(http.StatusBadRequest)), errDeleteResourceGroup),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, err := setupServerAndGetUnitTestExternal(t, &tc.handlers, &tc.kube)
+			if err != nil {
+				t.Errorf("Delete(...): problem setting up the test server %s", err)
+			}
+			defer server.Close()
+
+			err = e.Delete(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Delete(...): -want, +got:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Delete(...): -want, +got:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
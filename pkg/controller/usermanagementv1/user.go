@@ -0,0 +1,239 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usermanagementv1
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cpv1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	umv1 "github.com/IBM/platform-services-go-sdk/usermanagementv1"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/usermanagementv1/v1alpha1"
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+	ibmcu "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/user"
+)
+
+const (
+	errNotUser             = "managed resource is not a User custom resource"
+	errInviteUser          = "could not invite user"
+	errInviteUserNoIamID   = "the invitation of the user did not return its IAM ID"
+	errRemoveUser          = "could not remove user"
+	errGetUserFailed       = "error getting user"
+	errGetUserSettings     = "error getting user settings"
+	errInviteUserOpts      = "error creating user invitation options"
+	errUpdUserSettings     = "error updating user settings"
+	errUpdUserSettingsOpts = "error creating user settings options"
+)
+
+// SetupUser adds a controller that reconciles User managed resources.
+func SetupUser(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.UserGroupKind)
+	log := l.WithValues("User-controller", name)
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.UserGroupVersionKind),
+		managed.WithExternalConnecter(ibmc.NewAuditConnecter(&userConnector{
+			kube:     mgr.GetClient(),
+			usage:    resource.NewProviderConfigUsageTracker(mgr.GetClient(), &v1beta1.ProviderConfigUsage{}),
+			clientFn: ibmc.NewClient,
+			logger:   log}, event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()),
+			ibmc.NewExpiration(mgr.GetClient(), event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+		managed.WithLogger(log),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.User{}).
+		Complete(ibmc.NewExpirationReconciler(mgr, resource.ManagedKind(v1alpha1.UserGroupVersionKind), r))
+}
+
+// A userConnector is expected to produce an ExternalClient when its Connect method
+// is called.
+type userConnector struct {
+	kube     client.Client
+	usage    resource.Tracker
+	clientFn func(optd ibmc.ClientOptions) (ibmc.ClientSession, error)
+	logger   logging.Logger
+}
+
+// Connect produces an ExternalClient for IBM Cloud API
+func (c *userConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	opts, err := ibmc.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, ibmc.ErrGetAuth)
+	}
+
+	service, err := c.clientFn(opts)
+	if err != nil {
+		return nil, errors.Wrap(err, ibmc.ErrNewClient)
+	}
+
+	return &userExternal{client: service, kube: c.kube, logger: c.logger}, nil
+}
+
+// A userExternal observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type userExternal struct {
+	client ibmc.ClientSession
+	kube   client.Client
+	logger logging.Logger
+}
+
+func (c *userExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.User)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotUser)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	profile, _, err := c.client.UserManagementV1().GetUserProfile(&umv1.GetUserProfileOptions{
+		AccountID: reference.ToPtrValue(cr.Spec.ForProvider.AccountID),
+		IamID:     reference.ToPtrValue(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ibmc.IsResourceNotFound, err), errGetUserFailed)
+	}
+
+	settings, _, err := c.client.UserManagementV1().GetUserSettings(&umv1.GetUserSettingsOptions{
+		AccountID: reference.ToPtrValue(cr.Spec.ForProvider.AccountID),
+		IamID:     reference.ToPtrValue(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetUserSettings)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	if err = ibmcu.LateInitializeSpec(&cr.Spec.ForProvider, settings); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
+	}
+	lateInitSpec := cr.Spec.ForProvider.DeepCopy()
+	if err = ibmc.RestrictLateInitialization(cr, currentSpec, &cr.Spec.ForProvider); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
+	}
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := c.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
+		}
+	}
+
+	cr.Status.AtProvider, err = ibmcu.GenerateObservation(profile)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrGenObservation)
+	}
+
+	switch cr.Status.AtProvider.State {
+	case ibmcu.StateActive:
+		cr.Status.SetConditions(cpv1alpha1.Available())
+	case ibmcu.StateProcessing, ibmcu.StatePending:
+		// the user has not accepted the invitation yet
+		cr.Status.SetConditions(cpv1alpha1.Creating())
+	default:
+		cr.Status.SetConditions(cpv1alpha1.Unavailable())
+	}
+
+	upToDate, err := ibmcu.IsUpToDate(lateInitSpec, settings, c.logger)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrCheckUpToDate)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: nil,
+	}, nil
+}
+
+func (c *userExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.User)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotUser)
+	}
+
+	cr.SetConditions(cpv1alpha1.Creating())
+	inviteOptions := &umv1.InviteUsersOptions{}
+	if err := ibmcu.GenerateInviteUsersOptions(cr.Spec.ForProvider, inviteOptions); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errInviteUserOpts)
+	}
+
+	invited, _, err := c.client.UserManagementV1().InviteUsers(inviteOptions)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errInviteUser)
+	}
+	if len(invited.Resources) == 0 || reference.FromPtrValue(invited.Resources[0].ID) == "" {
+		return managed.ExternalCreation{}, errors.New(errInviteUserNoIamID)
+	}
+
+	// the settings of the user are set by the next reconciliation, as they are not part of the invitation
+	meta.SetExternalName(cr, reference.FromPtrValue(invited.Resources[0].ID))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (c *userExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.User)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotUser)
+	}
+
+	updOpts := &umv1.UpdateUserSettingsOptions{}
+	if err := ibmcu.GenerateUpdateUserSettingsOptions(meta.GetExternalName(cr), cr.Spec.ForProvider, updOpts); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdUserSettingsOpts)
+	}
+
+	if _, err := c.client.UserManagementV1().UpdateUserSettings(updOpts); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdUserSettings)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *userExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.User)
+	if !ok {
+		return errors.New(errNotUser)
+	}
+
+	cr.SetConditions(cpv1alpha1.Deleting())
+
+	_, err := c.client.UserManagementV1().RemoveUser(&umv1.RemoveUserOptions{
+		AccountID: reference.ToPtrValue(cr.Spec.ForProvider.AccountID),
+		IamID:     reference.ToPtrValue(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return errors.Wrap(resource.Ignore(ibmc.IsResourceGone, err), errRemoveUser)
+	}
+	return nil
+}
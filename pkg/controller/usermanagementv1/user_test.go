@@ -0,0 +1,666 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usermanagementv1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	cpv1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	umv1 "github.com/IBM/platform-services-go-sdk/usermanagementv1"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/usermanagementv1/v1alpha1"
+	ibmcu "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/user"
+	"github.com/crossplane-contrib/provider-ibm-cloud/pkg/controller/tstutil"
+)
+
+const (
+	errBadRequest = "error getting user: Bad Request"
+	errForbidden  = "error getting user: Forbidden"
+)
+
+var (
+	userName             = "jane-doe"
+	accountID            = "aa5a00334eaf9eb9339d2ab48f20d7ff"
+	iamID                = "IBMid-550006JKXX"
+	profileID            = "2f2d1f3b0c9a4d6fb2c1d0e9a8b7c6d5"
+	email                = "developer@example.com"
+	accountRole          = "Member"
+	realm                = "IBMid"
+	language             = "en"
+	notificationLanguage = "fr"
+	allowedIPAddresses   = "192.168.0.0/16"
+	selfManage           = true
+	notSelfManage        = false
+	stateActive          = ibmcu.StateActive
+	statePending         = ibmcu.StatePending
+
+	userPath     = "/v2/accounts/" + accountID + "/users/" + iamID
+	settingsPath = userPath + "/settings"
+	invitePath   = "/v2/accounts/" + accountID + "/users"
+)
+
+var _ managed.ExternalConnecter = &userConnector{}
+var _ managed.ExternalClient = &userExternal{}
+
+type userModifier func(*v1alpha1.User)
+
+func user(im ...userModifier) *v1alpha1.User {
+	i := &v1alpha1.User{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       userName,
+			Finalizers: []string{},
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: iamID,
+			},
+		},
+		Spec: v1alpha1.UserSpec{
+			ForProvider: v1alpha1.UserParameters{},
+		},
+	}
+	for _, m := range im {
+		m(i)
+	}
+	return i
+}
+
+func userWithExternalNameAnnotation(externalName string) userModifier {
+	return func(i *v1alpha1.User) {
+		if i.ObjectMeta.Annotations == nil {
+			i.ObjectMeta.Annotations = make(map[string]string)
+		}
+		i.ObjectMeta.Annotations[meta.AnnotationKeyExternalName] = externalName
+	}
+}
+
+func userWithSpec(p v1alpha1.UserParameters) userModifier {
+	return func(r *v1alpha1.User) { r.Spec.ForProvider = p }
+}
+
+func userWithConditions(c ...cpv1alpha1.Condition) userModifier {
+	return func(i *v1alpha1.User) { i.Status.SetConditions(c...) }
+}
+
+func userWithStatus(p v1alpha1.UserObservation) userModifier {
+	return func(r *v1alpha1.User) { r.Status.AtProvider = p }
+}
+
+func params(m ...func(*v1alpha1.UserParameters)) *v1alpha1.UserParameters {
+	p := &v1alpha1.UserParameters{
+		AccountID:            accountID,
+		Email:                email,
+		AccountRole:          &accountRole,
+		Language:             &language,
+		NotificationLanguage: &notificationLanguage,
+		AllowedIPAddresses:   &allowedIPAddresses,
+		SelfManage:           &selfManage,
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func observation(m ...func(*v1alpha1.UserObservation)) *v1alpha1.UserObservation {
+	o := &v1alpha1.UserObservation{
+		IamID:  iamID,
+		ID:     profileID,
+		Realm:  realm,
+		UserID: email,
+		State:  ibmcu.StateActive,
+	}
+	for _, f := range m {
+		f(o)
+	}
+	return o
+}
+
+func profile(m ...func(*umv1.UserProfile)) *umv1.UserProfile {
+	i := &umv1.UserProfile{
+		ID:        &profileID,
+		IamID:     &iamID,
+		Realm:     &realm,
+		UserID:    &email,
+		State:     &stateActive,
+		Email:     &email,
+		AccountID: &accountID,
+	}
+	for _, f := range m {
+		f(i)
+	}
+	return i
+}
+
+func settings(m ...func(*umv1.UserSettings)) *umv1.UserSettings {
+	i := &umv1.UserSettings{
+		Language:             &language,
+		NotificationLanguage: &notificationLanguage,
+		AllowedIPAddresses:   &allowedIPAddresses,
+		SelfManage:           &selfManage,
+	}
+	for _, f := range m {
+		f(i)
+	}
+	return i
+}
+
+// jsonHandler returns a handler that checks the method of the request, and replies with the given status code and
+// body
+func jsonHandler(t *testing.T, method string, statusCode int, body interface{}) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		if diff := cmp.Diff(method, r.Method); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		if body != nil {
+			if err := json.NewEncoder(w).Encode(body); err != nil {
+				klog.Errorf("%s", err)
+			}
+		}
+	}
+}
+
+// Sets up a unit test http server, and creates an external user structure appropriate for unit test.
+func setupServerAndGetUnitTestExternalUser(testingObj *testing.T, handlers *[]tstutil.Handler, kube *client.Client) (*userExternal, *httptest.Server, error) {
+	mClient, tstServer, err := tstutil.SetupTestServerClient(testingObj, handlers)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &userExternal{
+			kube:   *kube,
+			client: *mClient,
+			logger: logging.NewNopLogger(),
+		},
+		tstServer,
+		nil
+}
+
+func TestUserObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"NotFound": {
+			handlers: []tstutil.Handler{
+				{Path: userPath, HandlerFunc: jsonHandler(t, http.MethodGet, http.StatusNotFound, nil)},
+			},
+			args: tstutil.Args{
+				Managed: user(userWithSpec(*params())),
+			},
+			want: want{
+				mg:  user(userWithSpec(*params())),
+				err: nil,
+			},
+		},
+		"GetFailed": {
+			handlers: []tstutil.Handler{
+				{Path: userPath, HandlerFunc: jsonHandler(t, http.MethodGet, http.StatusBadRequest, nil)},
+			},
+			args: tstutil.Args{
+				Managed: user(userWithSpec(*params())),
+			},
+			want: want{
+				mg:  user(userWithSpec(*params())),
+				err: errors.New(errBadRequest),
+			},
+		},
+		"GetForbidden": {
+			handlers: []tstutil.Handler{
+				{Path: userPath, HandlerFunc: jsonHandler(t, http.MethodGet, http.StatusForbidden, nil)},
+			},
+			args: tstutil.Args{
+				Managed: user(userWithSpec(*params())),
+			},
+			want: want{
+				mg:  user(userWithSpec(*params())),
+				err: errors.New(errForbidden),
+			},
+		},
+		"GetSettingsFailed": {
+			handlers: []tstutil.Handler{
+				{Path: userPath, HandlerFunc: jsonHandler(t, http.MethodGet, http.StatusOK, profile())},
+				{Path: settingsPath, HandlerFunc: jsonHandler(t, http.MethodGet, http.StatusInternalServerError, nil)},
+			},
+			args: tstutil.Args{
+				Managed: user(userWithSpec(*params())),
+			},
+			want: want{
+				mg:  user(userWithSpec(*params())),
+				err: errors.Wrap(errors.New(http.StatusText(http.StatusInternalServerError)), errGetUserSettings),
+			},
+		},
+		"UpToDate": {
+			handlers: []tstutil.Handler{
+				{Path: userPath, HandlerFunc: jsonHandler(t, http.MethodGet, http.StatusOK, profile())},
+				{Path: settingsPath, HandlerFunc: jsonHandler(t, http.MethodGet, http.StatusOK, settings())},
+			},
+			args: tstutil.Args{
+				Managed: user(userWithSpec(*params())),
+			},
+			want: want{
+				mg: user(userWithSpec(*params()),
+					userWithConditions(cpv1alpha1.Available()),
+					userWithStatus(*observation())),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: nil,
+				},
+			},
+		},
+		"Pending": {
+			handlers: []tstutil.Handler{
+				{Path: userPath, HandlerFunc: jsonHandler(t, http.MethodGet, http.StatusOK, profile(func(p *umv1.UserProfile) {
+					p.State = &statePending
+				}))},
+				{Path: settingsPath, HandlerFunc: jsonHandler(t, http.MethodGet, http.StatusOK, settings())},
+			},
+			args: tstutil.Args{
+				Managed: user(userWithSpec(*params())),
+			},
+			want: want{
+				mg: user(userWithSpec(*params()),
+					userWithConditions(cpv1alpha1.Creating()),
+					userWithStatus(*observation(func(o *v1alpha1.UserObservation) {
+						o.State = ibmcu.StatePending
+					}))),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: nil,
+				},
+			},
+		},
+		"NotUpToDate": {
+			handlers: []tstutil.Handler{
+				{Path: userPath, HandlerFunc: jsonHandler(t, http.MethodGet, http.StatusOK, profile())},
+				{Path: settingsPath, HandlerFunc: jsonHandler(t, http.MethodGet, http.StatusOK, settings(func(s *umv1.UserSettings) {
+					s.SelfManage = &notSelfManage
+				}))},
+			},
+			args: tstutil.Args{
+				Managed: user(userWithSpec(*params())),
+			},
+			want: want{
+				mg: user(userWithSpec(*params()),
+					userWithConditions(cpv1alpha1.Available()),
+					userWithStatus(*observation())),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: nil,
+				},
+			},
+		},
+		"LateInitSuccess": {
+			handlers: []tstutil.Handler{
+				{Path: userPath, HandlerFunc: jsonHandler(t, http.MethodGet, http.StatusOK, profile())},
+				{Path: settingsPath, HandlerFunc: jsonHandler(t, http.MethodGet, http.StatusOK, settings())},
+			},
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: tstutil.Args{
+				Managed: user(userWithSpec(*params(func(p *v1alpha1.UserParameters) {
+					p.Language = nil
+					p.SelfManage = nil
+				}))),
+			},
+			want: want{
+				mg: user(userWithSpec(*params()),
+					userWithConditions(cpv1alpha1.Available()),
+					userWithStatus(*observation())),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: nil,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, errCr := setupServerAndGetUnitTestExternalUser(t, &tc.handlers, &tc.kube)
+			if errCr != nil {
+				t.Errorf("Observe(...): problem setting up the test server %s", errCr)
+			}
+
+			defer server.Close()
+
+			obs, err := e.Observe(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				// the case where our mock server returns error.
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Observe(...): want error string != got error string:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Observe(...): want error != got error:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUserCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		cre managed.ExternalCreation
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"Successful": {
+			handlers: []tstutil.Handler{
+				{
+					Path: invitePath,
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						body := &struct {
+							Users []umv1.InviteUser `json:"users"`
+						}{}
+						if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+							t.Errorf("r: cannot decode request body: %s", err)
+						}
+						_ = r.Body.Close()
+						if diff := cmp.Diff([]umv1.InviteUser{{Email: &email, AccountRole: &accountRole}}, body.Users); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.Header().Set("Content-Type", "application/json")
+						w.WriteHeader(http.StatusAccepted)
+						invited := &umv1.InvitedUserList{Resources: []umv1.InvitedUser{{Email: &email, ID: &iamID, State: &statePending}}}
+						if err := json.NewEncoder(w).Encode(invited); err != nil {
+							klog.Errorf("%s", err)
+						}
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: user(userWithExternalNameAnnotation(""), userWithSpec(*params())),
+			},
+			want: want{
+				mg: user(userWithSpec(*params()),
+					userWithConditions(cpv1alpha1.Creating()),
+					userWithExternalNameAnnotation(iamID)),
+				cre: managed.ExternalCreation{ExternalNameAssigned: true},
+				err: nil,
+			},
+		},
+		"NoIamID": {
+			handlers: []tstutil.Handler{
+				{Path: invitePath, HandlerFunc: jsonHandler(t, http.MethodPost, http.StatusAccepted, &umv1.InvitedUserList{})},
+			},
+			args: tstutil.Args{
+				Managed: user(userWithExternalNameAnnotation(""), userWithSpec(*params())),
+			},
+			want: want{
+				mg: user(userWithExternalNameAnnotation(""), userWithSpec(*params()),
+					userWithConditions(cpv1alpha1.Creating())),
+				cre: managed.ExternalCreation{},
+				err: errors.New(errInviteUserNoIamID),
+			},
+		},
+		"Conflict": {
+			handlers: []tstutil.Handler{
+				{Path: invitePath, HandlerFunc: jsonHandler(t, http.MethodPost, http.StatusConflict, nil)},
+			},
+			args: tstutil.Args{
+				Managed: user(userWithExternalNameAnnotation(""), userWithSpec(*params())),
+			},
+			want: want{
+				mg: user(userWithExternalNameAnnotation(""), userWithSpec(*params()),
+					userWithConditions(cpv1alpha1.Creating())),
+				cre: managed.ExternalCreation{},
+				err: errors.Wrap(errors.New(http.StatusText(http.StatusConflict)), errInviteUser),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, errCr := setupServerAndGetUnitTestExternalUser(t, &tc.handlers, &tc.kube)
+			if errCr != nil {
+				t.Errorf("Create(...): problem setting up the test server %s", errCr)
+			}
+
+			defer server.Close()
+
+			cre, err := e.Create(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				// the case where our mock server returns error.
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Create(...): -want, +got:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Create(...): -want, +got:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.cre, cre); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUserDelete(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"Successful": {
+			handlers: []tstutil.Handler{
+				{Path: userPath, HandlerFunc: jsonHandler(t, http.MethodDelete, http.StatusNoContent, nil)},
+			},
+			args: tstutil.Args{
+				Managed: user(userWithSpec(*params()), userWithStatus(*observation())),
+			},
+			want: want{
+				mg: user(userWithSpec(*params()), userWithStatus(*observation()),
+					userWithConditions(cpv1alpha1.Deleting())),
+				err: nil,
+			},
+		},
+		"AlreadyGone": {
+			handlers: []tstutil.Handler{
+				{Path: userPath, HandlerFunc: jsonHandler(t, http.MethodDelete, http.StatusNotFound, nil)},
+			},
+			args: tstutil.Args{
+				Managed: user(userWithSpec(*params()), userWithStatus(*observation())),
+			},
+			want: want{
+				mg: user(userWithSpec(*params()), userWithStatus(*observation()),
+					userWithConditions(cpv1alpha1.Deleting())),
+				err: nil,
+			},
+		},
+		"InvalidRemoveUser": {
+			handlers: []tstutil.Handler{
+				{Path: userPath, HandlerFunc: jsonHandler(t, http.MethodDelete, http.StatusBadRequest, nil)},
+			},
+			args: tstutil.Args{
+				Managed: user(userWithSpec(*params()), userWithStatus(*observation())),
+			},
+			want: want{
+				mg: user(userWithSpec(*params()), userWithStatus(*observation()),
+					userWithConditions(cpv1alpha1.Deleting())),
+				err: errors.Wrap(errors.New(http.StatusText(http.StatusBadRequest)), errRemoveUser),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, errCr := setupServerAndGetUnitTestExternalUser(t, &tc.handlers, &tc.kube)
+			if errCr != nil {
+				t.Errorf("Delete(...): problem setting up the test server %s", errCr)
+			}
+
+			defer server.Close()
+
+			err := e.Delete(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				// the case where our mock server returns error.
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Delete(...): -want, +got:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Delete(...): -want, +got:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUserUpdate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		upd managed.ExternalUpdate
+		err error
+	}
+	cases := map[string]struct {
+		handlers []tstutil.Handler
+		kube     client.Client
+		args     tstutil.Args
+		want     want
+	}{
+		"Successful": {
+			handlers: []tstutil.Handler{
+				{
+					Path: settingsPath,
+					HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+						if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						body := &umv1.UserSettings{}
+						if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+							t.Errorf("r: cannot decode request body: %s", err)
+						}
+						_ = r.Body.Close()
+						if diff := cmp.Diff(settings(), body); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						w.WriteHeader(http.StatusNoContent)
+					},
+				},
+			},
+			args: tstutil.Args{
+				Managed: user(userWithSpec(*params()), userWithStatus(*observation())),
+			},
+			want: want{
+				mg:  user(userWithSpec(*params()), userWithStatus(*observation())),
+				upd: managed.ExternalUpdate{},
+				err: nil,
+			},
+		},
+		"PatchFails": {
+			handlers: []tstutil.Handler{
+				{Path: settingsPath, HandlerFunc: jsonHandler(t, http.MethodPatch, http.StatusBadRequest, nil)},
+			},
+			args: tstutil.Args{
+				Managed: user(userWithSpec(*params()), userWithStatus(*observation())),
+			},
+			want: want{
+				mg:  user(userWithSpec(*params()), userWithStatus(*observation())),
+				err: errors.Wrap(errors.New(http.StatusText(http.StatusBadRequest)), errUpdUserSettings),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, server, errCr := setupServerAndGetUnitTestExternalUser(t, &tc.handlers, &tc.kube)
+			if errCr != nil {
+				t.Errorf("Update(...): problem setting up the test server %s", errCr)
+			}
+
+			defer server.Close()
+
+			upd, err := e.Update(context.Background(), tc.args.Managed)
+			if tc.want.err != nil && err != nil {
+				// the case where our mock server returns error.
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Update(...): -want, +got:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Update(...): -want, +got:\n%s", diff)
+				}
+			}
+			if tc.want.err == nil {
+				if diff := cmp.Diff(tc.want.mg, tc.args.Managed); diff != "" {
+					t.Errorf("Update(...): -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(tc.want.upd, upd); diff != "" {
+					t.Errorf("Update(...): -want, +got:\n%s", diff)
+				}
+			}
+		})
+	}
+}
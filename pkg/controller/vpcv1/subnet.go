@@ -51,6 +51,7 @@ const (
 	errDeleteSubnet          = "could not delete the Subnet"
 	errGetFailedSubnet       = "error getting the Subnet"
 	errUpdateSubnet          = "error updating the Subnet"
+	errUpdateTagSubnet       = "error updating the tags of the Subnet"
 	errCouldNotGeneratePatch = "could not generate a diff patch"
 )
 
@@ -61,12 +62,13 @@ func SetupSubnet(mgr ctrl.Manager, l logging.Logger) error {
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.SubnetGroupVersionKind),
-		managed.WithExternalConnecter(&subnetConnector{
+		managed.WithExternalConnecter(ibmc.NewAuditConnecter(&subnetConnector{
 			kube:     mgr.GetClient(),
 			usage:    resource.NewProviderConfigUsageTracker(mgr.GetClient(), &v1beta1.ProviderConfigUsage{}),
 			clientFn: ibmc.NewClient,
-			logger:   log}),
-		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			logger:   log}, event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()),
+			ibmc.NewExpiration(mgr.GetClient(), event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
 		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 		managed.WithLogger(log),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
@@ -74,7 +76,7 @@ func SetupSubnet(mgr ctrl.Manager, l logging.Logger) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		For(&v1alpha1.Subnet{}).
-		Complete(r)
+		Complete(ibmc.NewExpirationReconciler(mgr, resource.ManagedKind(v1alpha1.SubnetGroupVersionKind), r))
 }
 
 // Expected to produce an object of type managed.ExternalClient when its Connect method
@@ -170,6 +172,11 @@ func (c *subnetExternal) Observe(ctx context.Context, mg resource.Managed) (mana
 		if wasLateInitialized, err = crossplaneClient.LateInitializeSpec(&crossplaneSubnet.Spec.ForProvider, cloudSubnet); err != nil {
 			return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrGenObservation)
 		}
+		lateInitSpec := crossplaneSubnet.Spec.ForProvider.DeepCopy()
+		if err = ibmc.RestrictLateInitialization(crossplaneSubnet, currentSpec, &crossplaneSubnet.Spec.ForProvider); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrGenObservation)
+		}
+		wasLateInitialized = wasLateInitialized && !cmp.Equal(currentSpec, &crossplaneSubnet.Spec.ForProvider)
 
 		if !cmp.Equal(currentSpec, &crossplaneSubnet.Spec.ForProvider) {
 			if err := c.kube.Update(ctx, crossplaneSubnet); err != nil {
@@ -181,12 +188,21 @@ func (c *subnetExternal) Observe(ctx context.Context, mg resource.Managed) (mana
 			return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrGenObservation)
 		}
 
-		if isUpToDate, err = crossplaneClient.IsUpToDate(&crossplaneSubnet.Spec.ForProvider, cloudSubnet, c.logger); err != nil {
+		if isUpToDate, err = crossplaneClient.IsUpToDate(lateInitSpec, cloudSubnet, c.logger); err != nil {
 			return managed.ExternalObservation{
 				ResourceExists:          true,
 				ResourceLateInitialized: wasLateInitialized,
 			}, errors.Wrap(err, ibmc.ErrCheckUpToDate)
 		}
+
+		if isUpToDate {
+			if isUpToDate, err = ibmc.TagsUpToDate(c.client, reference.FromPtrValue(cloudSubnet.CRN), crossplaneSubnet.Spec.ForProvider.Tags, crossplaneSubnet.Spec.ForProvider.AccessTags); err != nil {
+				return managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceLateInitialized: wasLateInitialized,
+				}, errors.Wrap(err, ibmc.ErrCheckUpToDate)
+			}
+		}
 	}
 
 	return managed.ExternalObservation{
@@ -204,6 +220,10 @@ func (c *subnetExternal) Create(ctx context.Context, mg resource.Managed) (manag
 		return managed.ExternalCreation{}, errors.New(errThisIsNotSubnet)
 	}
 
+	if err := enforceSubnetPolicies(c.client.ProviderConfig(), crossplaneSubnet); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateSubnet)
+	}
+
 	crossplaneSubnet.SetConditions(runtimev1alpha1.Creating())
 
 	createOptions, err := crossplaneClient.GenerateCreateOptions(&crossplaneSubnet.Spec.DeepCopy().ForProvider)
@@ -246,6 +266,10 @@ func (c *subnetExternal) Update(ctx context.Context, mg resource.Managed) (manag
 		return managed.ExternalUpdate{}, errors.New(errThisIsNotSubnet)
 	}
 
+	if err := enforceSubnetPolicies(c.client.ProviderConfig(), crossplaneSubnet); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateSubnet)
+	}
+
 	externalName := meta.GetExternalName(crossplaneSubnet)
 	cloudSubnet, err := getSubnet(c, externalName)
 	if err != nil {
@@ -261,13 +285,31 @@ func (c *subnetExternal) Update(ctx context.Context, mg resource.Managed) (manag
 		return managed.ExternalUpdate{}, errors.Wrap(err, errCouldNotGeneratePatch)
 	}
 
-	updateOptions := ibmVPC.UpdateSubnetOptions{}
-	updateOptions.SetID(crossplaneSubnet.Status.AtProvider.ID)
-	updateOptions.SetSubnetPatch(subnetPatch)
+	if len(subnetPatch) > 0 {
+		updateOptions := ibmVPC.UpdateSubnetOptions{}
+		updateOptions.SetID(crossplaneSubnet.Status.AtProvider.ID)
+		updateOptions.SetSubnetPatch(subnetPatch)
 
-	if _, _, err := c.client.VPCClient().UpdateSubnet(&updateOptions); err != nil {
-		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateSubnet)
+		if _, _, err := c.client.VPCClient().UpdateSubnet(&updateOptions); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateSubnet)
+		}
+	}
+
+	if err := ibmc.UpdateManagedTags(c.client, reference.FromPtrValue(cloudSubnet.CRN), crossplaneSubnet.Spec.ForProvider.Tags, crossplaneSubnet.Spec.ForProvider.AccessTags); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTagSubnet)
 	}
 
 	return managed.ExternalUpdate{}, nil
 }
+
+// enforceSubnetPolicies checks the zone of the subnet against the guardrails of its ProviderConfig
+func enforceSubnetPolicies(pc *v1beta1.ProviderConfigSpec, crossplaneSubnet *v1alpha1.Subnet) error {
+	zones := []string{}
+	if p := crossplaneSubnet.Spec.ForProvider.ByTocalCount; p != nil {
+		zones = append(zones, p.Zone.Name)
+	}
+	if p := crossplaneSubnet.Spec.ForProvider.ByCIDR; p != nil && p.Zone != nil {
+		zones = append(zones, p.Zone.Name)
+	}
+	return ibmc.EnforcePolicies(crossplaneSubnet, pc, ibmc.CheckGuardrails(pc, ibmc.GuardedValues{Zones: zones}))
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
+	containerv2 "github.com/crossplane-contrib/provider-ibm-cloud/apis/container/containerv2/v1alpha1"
 	"github.com/crossplane-contrib/provider-ibm-cloud/apis/v1beta1"
 	"github.com/crossplane-contrib/provider-ibm-cloud/apis/vpcv1/v1alpha1"
 	crossplaneClient "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/vpcv1/vpc"
@@ -51,8 +52,29 @@ const (
 	errDeleteVPC    = "could not delete the VPC"
 	errGetFailedVPC = "error getting the VPC"
 	errUpdateVPC    = "error updating the VPC"
+	errUpdateTagVPC = "error updating the tags of the VPC"
 )
 
+// vpcDependents are the managed resources that reference VPCs, and must be deleted before them
+var vpcDependents = []ibmc.Dependent{
+	{
+		Kind: v1alpha1.SubnetKind,
+		List: func() resource.ManagedList { return &v1alpha1.SubnetList{} },
+		DependsOn: func(mg resource.Managed, name string) bool {
+			p := mg.(*v1alpha1.Subnet).Spec.ForProvider
+			return (p.ByTocalCount != nil && ibmc.IsReferenceTo(p.ByTocalCount.VPC.VPCRef, name)) ||
+				(p.ByCIDR != nil && ibmc.IsReferenceTo(p.ByCIDR.VPC.VPCRef, name))
+		},
+	},
+	{
+		Kind: containerv2.ClusterKind,
+		List: func() resource.ManagedList { return &containerv2.ClusterList{} },
+		DependsOn: func(mg resource.Managed, name string) bool {
+			return ibmc.IsReferenceTo(mg.(*containerv2.Cluster).Spec.ForProvider.WorkerPools.VPCRef, name)
+		},
+	},
+}
+
 // SetupVPC adds a controller that reconciles VPC objects
 func SetupVPC(mgr ctrl.Manager, l logging.Logger) error {
 	name := managed.ControllerName(v1alpha1.VPCGroupKind)
@@ -60,12 +82,14 @@ func SetupVPC(mgr ctrl.Manager, l logging.Logger) error {
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.VPCGroupVersionKind),
-		managed.WithExternalConnecter(&vpcConnector{
+		managed.WithExternalConnecter(ibmc.NewAuditConnecter(&vpcConnector{
 			kube:     mgr.GetClient(),
 			usage:    resource.NewProviderConfigUsageTracker(mgr.GetClient(), &v1beta1.ProviderConfigUsage{}),
 			clientFn: ibmc.NewClient,
-			logger:   log}),
-		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			logger:   log}, event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()),
+			ibmc.NewExpiration(mgr.GetClient(), event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+			ibmc.NewProviderConfigDefaults(mgr.GetClient(), crossplaneClient.ApplyProviderConfigDefaults)),
 		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 		managed.WithLogger(log),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
@@ -73,7 +97,7 @@ func SetupVPC(mgr ctrl.Manager, l logging.Logger) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		For(&v1alpha1.VPC{}).
-		Complete(r)
+		Complete(ibmc.NewExpirationReconciler(mgr, resource.ManagedKind(v1alpha1.VPCGroupVersionKind), r))
 }
 
 // Expected to produce an object of type managed.ExternalClient when its Connect method
@@ -146,6 +170,11 @@ func (c *vpcExternal) Observe(ctx context.Context, mg resource.Managed) (managed
 				if wasLateInitialized, err = crossplaneClient.LateInitializeSpec(&crossplaneVPC.Spec.ForProvider, &cloudVPC); err != nil {
 					return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
 				}
+				lateInitSpec := crossplaneVPC.Spec.ForProvider.DeepCopy()
+				if err = ibmc.RestrictLateInitialization(crossplaneVPC, currentSpec, &crossplaneVPC.Spec.ForProvider); err != nil {
+					return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrManagedUpdateFailed)
+				}
+				wasLateInitialized = wasLateInitialized && !cmp.Equal(currentSpec, &crossplaneVPC.Spec.ForProvider)
 
 				if !cmp.Equal(currentSpec, &crossplaneVPC.Spec.ForProvider) {
 					if err := c.kube.Update(ctx, crossplaneVPC); err != nil {
@@ -157,13 +186,22 @@ func (c *vpcExternal) Observe(ctx context.Context, mg resource.Managed) (managed
 					return managed.ExternalObservation{}, errors.Wrap(err, ibmc.ErrGenObservation)
 				}
 
-				if isUpToDate, err = crossplaneClient.IsUpToDate(&crossplaneVPC.Spec.ForProvider, &cloudVPC, c.logger); err != nil {
+				if isUpToDate, err = crossplaneClient.IsUpToDate(lateInitSpec, &cloudVPC, c.logger); err != nil {
 					return managed.ExternalObservation{
 						ResourceExists:          true,
 						ResourceLateInitialized: wasLateInitialized,
 					}, errors.Wrap(err, ibmc.ErrCheckUpToDate)
 				}
 
+				if isUpToDate {
+					if isUpToDate, err = ibmc.TagsUpToDate(c.client, *cloudVPC.CRN, crossplaneVPC.Spec.ForProvider.Tags, crossplaneVPC.Spec.ForProvider.AccessTags); err != nil {
+						return managed.ExternalObservation{
+							ResourceExists:          true,
+							ResourceLateInitialized: wasLateInitialized,
+						}, errors.Wrap(err, ibmc.ErrCheckUpToDate)
+					}
+				}
+
 				break
 			}
 		}
@@ -184,6 +222,11 @@ func (c *vpcExternal) Create(ctx context.Context, mg resource.Managed) (managed.
 		return managed.ExternalCreation{}, errors.New(errThisIsNotVPC)
 	}
 
+	pc := c.client.ProviderConfig()
+	if err := ibmc.EnforcePolicies(crossplaneVPC, pc, ibmc.CheckNamingPolicy(pc, crossplaneVPC.Spec.ForProvider.Name)); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateVPC)
+	}
+
 	crossplaneVPC.SetConditions(runtimev1alpha1.Creating())
 
 	createOptions, err := crossplaneClient.GenerateCreateOptions(&crossplaneVPC.Spec.DeepCopy().ForProvider)
@@ -191,6 +234,16 @@ func (c *vpcExternal) Create(ctx context.Context, mg resource.Managed) (managed.
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreateReqVPC)
 	}
 
+	if createOptions.ResourceGroup == nil {
+		rgID, err := ibmc.DefaultResourceGroupID(c.client)
+		if err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errCreateReqVPC)
+		}
+		if rgID != nil {
+			createOptions.SetResourceGroup(&ibmVPC.ResourceGroupIdentity{ID: rgID})
+		}
+	}
+
 	vpc, _, err := c.client.VPCClient().CreateVPC(&createOptions)
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreateVPC)
@@ -208,6 +261,10 @@ func (c *vpcExternal) Delete(ctx context.Context, mg resource.Managed) error {
 		return errors.New(errThisIsNotVPC)
 	}
 
+	if err := ibmc.CheckDependents(ctx, c.kube, crossplaneVPC, vpcDependents...); err != nil {
+		return errors.Wrap(err, errDeleteVPC)
+	}
+
 	crossplaneVPC.SetConditions(runtimev1alpha1.Deleting())
 
 	_, err := c.client.VPCClient().DeleteVPC(&ibmVPC.DeleteVPCOptions{
@@ -227,6 +284,11 @@ func (c *vpcExternal) Update(ctx context.Context, mg resource.Managed) (managed.
 		return managed.ExternalUpdate{}, errors.New(errThisIsNotVPC)
 	}
 
+	pc := c.client.ProviderConfig()
+	if err := ibmc.EnforcePolicies(crossplaneVPC, pc, ibmc.CheckNamingPolicy(pc, crossplaneVPC.Spec.ForProvider.Name)); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateVPC)
+	}
+
 	updateOptions := ibmVPC.UpdateVPCOptions{
 		VPCPatch: map[string]interface{}{
 			"name": reference.FromPtrValue(crossplaneVPC.Spec.ForProvider.Name),
@@ -239,5 +301,9 @@ func (c *vpcExternal) Update(ctx context.Context, mg resource.Managed) (managed.
 		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateVPC)
 	}
 
+	if err := ibmc.UpdateManagedTags(c.client, crossplaneVPC.Status.AtProvider.CRN, crossplaneVPC.Spec.ForProvider.Tags, crossplaneVPC.Spec.ForProvider.AccessTags); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTagVPC)
+	}
+
 	return managed.ExternalUpdate{}, nil
 }
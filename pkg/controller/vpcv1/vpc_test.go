@@ -349,6 +349,7 @@ func testDeleteVPC(t *testing.T) {
 			},
 			kube: &test.MockClient{
 				MockUpdate: test.NewMockUpdateFn(nil),
+				MockList:   test.NewMockListFn(nil),
 			},
 		},
 		"AlreadyGone": {
@@ -377,6 +378,7 @@ func testDeleteVPC(t *testing.T) {
 			},
 			kube: &test.MockClient{
 				MockUpdate: test.NewMockUpdateFn(nil),
+				MockList:   test.NewMockListFn(nil),
 			},
 		},
 		"Failed": {
@@ -405,6 +407,7 @@ func testDeleteVPC(t *testing.T) {
 			},
 			kube: &test.MockClient{
 				MockUpdate: test.NewMockUpdateFn(nil),
+				MockList:   test.NewMockListFn(nil),
 			},
 		},
 	}
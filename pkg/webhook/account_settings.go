@@ -0,0 +1,94 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/pkg/errors"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/iamidentityv1/v1alpha1"
+	ibmcas "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients/accountsettings"
+)
+
+const (
+	// AccountSettingsPath is the path the account settings webhook is served at
+	AccountSettingsPath = "/validate-account-settings"
+
+	errDecodeObject = "cannot decode the object to be admitted"
+)
+
+// AccountSettings is an admission.Handler that denies the creation of an AccountSettings for an account whose
+// settings are already managed by another AccountSettings, as well as the update of an AccountSettings to such an
+// account. Unlike the check of the AccountSettings controller, it runs before the resource is stored, so that a
+// duplicate is rejected rather than left failing to be created.
+type AccountSettings struct {
+	kube   client.Reader
+	logger logging.Logger
+}
+
+// NewAccountSettings returns an AccountSettings handler.
+func NewAccountSettings(kube client.Reader, l logging.Logger) *AccountSettings {
+	return &AccountSettings{kube: kube, logger: l}
+}
+
+// Handle denies the AccountSettings of accounts whose settings are already managed, and allows any other request.
+func (a *AccountSettings) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if req.Operation != admissionv1beta1.Create && req.Operation != admissionv1beta1.Update {
+		return admission.Allowed("")
+	}
+
+	cr := &v1alpha1.AccountSettings{}
+	if err := json.Unmarshal(req.Object.Raw, cr); err != nil {
+		return admission.Errored(http.StatusBadRequest, errors.Wrap(err, errDecodeObject))
+	}
+
+	// an update that keeps the account is allowed, so that a duplicate that predates the webhook can still be deleted
+	if req.Operation == admissionv1beta1.Update {
+		old := &v1alpha1.AccountSettings{}
+		if err := json.Unmarshal(req.OldObject.Raw, old); err != nil {
+			return admission.Errored(http.StatusBadRequest, errors.Wrap(err, errDecodeOldObject))
+		}
+		if old.Spec.ForProvider.AccountID == cr.Spec.ForProvider.AccountID {
+			return admission.Allowed("")
+		}
+	}
+
+	if err := ibmcas.CheckNotManaged(ctx, a.kube, cr); err != nil {
+		a.logger.Debug("Denied an AccountSettings", "name", cr.GetName(), "account", cr.Spec.ForProvider.AccountID, "user", req.UserInfo.Username)
+		return admission.Denied(err.Error())
+	}
+	return admission.Allowed("")
+}
+
+// SetupAccountSettings registers the account settings webhook with the webhook server of the manager.
+func SetupAccountSettings(mgr ctrl.Manager, l logging.Logger) error {
+	// the API server is read rather than the cache of the manager, which may not hold a resource just created yet
+	mgr.GetWebhookServer().Register(AccountSettingsPath, &webhook.Admission{
+		Handler: NewAccountSettings(mgr.GetAPIReader(), l.WithValues("webhook", "account-settings")),
+	})
+	return nil
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-ibm-cloud/apis/iamidentityv1/v1alpha1"
+)
+
+func accountSettingsJSON(name, accountID string) []byte {
+	return []byte(`{"apiVersion":"iamidentityv1.ibmcloud.crossplane.io/v1alpha1","kind":"AccountSettings",` +
+		`"metadata":{"name":"` + name + `"},"spec":{"forProvider":{"accountId":"` + accountID + `"}}}`)
+}
+
+func TestAccountSettingsHandle(t *testing.T) {
+	existing := func(obj runtime.Object) error {
+		as := v1alpha1.AccountSettings{}
+		as.SetName("settings")
+		as.Spec.ForProvider.AccountID = "account-a"
+		obj.(*v1alpha1.AccountSettingsList).Items = []v1alpha1.AccountSettings{as}
+		return nil
+	}
+	cases := map[string]struct {
+		op      admissionv1beta1.Operation
+		obj     []byte
+		old     []byte
+		listErr error
+		want    bool
+	}{
+		"NotACreationNorAnUpdate": {
+			op:   admissionv1beta1.Delete,
+			want: true,
+		},
+		"OtherAccount": {
+			op:   admissionv1beta1.Create,
+			obj:  accountSettingsJSON("other", "account-b"),
+			want: true,
+		},
+		"SameAccount": {
+			op:  admissionv1beta1.Create,
+			obj: accountSettingsJSON("other", "account-a"),
+		},
+		"ExistingResource": {
+			op:   admissionv1beta1.Create,
+			obj:  accountSettingsJSON("settings", "account-a"),
+			want: true,
+		},
+		"UpdateKeepingAccount": {
+			op:   admissionv1beta1.Update,
+			obj:  accountSettingsJSON("other", "account-a"),
+			old:  accountSettingsJSON("other", "account-a"),
+			want: true,
+		},
+		"UpdateToManagedAccount": {
+			op:  admissionv1beta1.Update,
+			obj: accountSettingsJSON("other", "account-a"),
+			old: accountSettingsJSON("other", "account-b"),
+		},
+		"ListFailed": {
+			op:      admissionv1beta1.Create,
+			obj:     accountSettingsJSON("other", "account-b"),
+			listErr: errors.New("boom"),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			kube := &test.MockClient{MockList: test.NewMockListFn(tc.listErr, existing)}
+			a := NewAccountSettings(kube, logging.NewNopLogger())
+			req := admission.Request{AdmissionRequest: admissionv1beta1.AdmissionRequest{
+				Operation: tc.op,
+				Object:    runtime.RawExtension{Raw: tc.obj},
+				OldObject: runtime.RawExtension{Raw: tc.old},
+			}}
+			got := a.Handle(context.Background(), req)
+			if diff := cmp.Diff(tc.want, got.Allowed); diff != "" {
+				t.Errorf("Handle(...): -want allowed, +got allowed:\n%s", diff)
+			}
+		})
+	}
+}
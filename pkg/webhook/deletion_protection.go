@@ -0,0 +1,86 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+
+	ibmc "github.com/crossplane-contrib/provider-ibm-cloud/pkg/clients"
+)
+
+const (
+	// DeletionProtectionPath is the path the deletion protection webhook is served at
+	DeletionProtectionPath = "/validate-deletion-protection"
+
+	reasonDeletionBlocked event.Reason = "DeletionBlocked"
+
+	errDecodeOldObject = "cannot decode the object to be deleted"
+)
+
+// DeletionProtection is an admission.Handler that denies the deletion of the managed resources that are protected
+// from deletion (see clients.AnnotationDeletionProtection), and emits an event for each blocked attempt that is not a
+// dry run.
+type DeletionProtection struct {
+	record event.Recorder
+	logger logging.Logger
+}
+
+// NewDeletionProtection returns a DeletionProtection handler.
+func NewDeletionProtection(record event.Recorder, l logging.Logger) *DeletionProtection {
+	return &DeletionProtection{record: record, logger: l}
+}
+
+// Handle denies the deletion of protected objects, and allows any other request.
+func (d *DeletionProtection) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if req.Operation != admissionv1beta1.Delete {
+		return admission.Allowed("")
+	}
+
+	obj := &unstructured.Unstructured{}
+	if err := obj.UnmarshalJSON(req.OldObject.Raw); err != nil {
+		return admission.Errored(http.StatusBadRequest, errors.Wrap(err, errDecodeOldObject))
+	}
+
+	if err := ibmc.CheckDeletionProtection(obj.GetKind(), obj); err != nil {
+		d.logger.Debug("Blocked the deletion of a protected resource", "kind", obj.GetKind(), "name", obj.GetName(), "user", req.UserInfo.Username)
+		if req.DryRun == nil || !*req.DryRun {
+			d.record.Event(obj, event.Warning(reasonDeletionBlocked, err, "user", req.UserInfo.Username))
+		}
+		return admission.Denied(err.Error())
+	}
+	return admission.Allowed("")
+}
+
+// SetupDeletionProtection registers the deletion protection webhook with the webhook server of the manager.
+func SetupDeletionProtection(mgr ctrl.Manager, l logging.Logger) error {
+	name := "deletion-protection"
+	mgr.GetWebhookServer().Register(DeletionProtectionPath, &webhook.Admission{
+		Handler: NewDeletionProtection(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)), l.WithValues("webhook", name)),
+	})
+	return nil
+}
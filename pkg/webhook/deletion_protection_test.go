@@ -0,0 +1,103 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+)
+
+type countingRecorder struct {
+	events int
+}
+
+func (r *countingRecorder) Event(_ runtime.Object, _ event.Event) { r.events++ }
+
+func (r *countingRecorder) WithAnnotations(_ ...string) event.Recorder { return r }
+
+func bucketJSON(annotations string) []byte {
+	return []byte(`{"apiVersion":"cos.ibmcloud.crossplane.io/v1alpha1","kind":"Bucket","metadata":{"name":"data",` +
+		`"annotations":{` + annotations + `}}}`)
+}
+
+func TestDeletionProtectionHandle(t *testing.T) {
+	type want struct {
+		allowed bool
+		events  int
+	}
+	cases := map[string]struct {
+		op     admissionv1beta1.Operation
+		dryRun bool
+		old    []byte
+		want   want
+	}{
+		"NotADeletion": {
+			op:   admissionv1beta1.Update,
+			want: want{allowed: true},
+		},
+		"NotProtected": {
+			op:   admissionv1beta1.Delete,
+			old:  bucketJSON(""),
+			want: want{allowed: true},
+		},
+		"ProtectionDisabled": {
+			op:   admissionv1beta1.Delete,
+			old:  bucketJSON(`"ibmcloud.crossplane.io/deletion-protection":"false"`),
+			want: want{allowed: true},
+		},
+		"Protected": {
+			op:   admissionv1beta1.Delete,
+			old:  bucketJSON(`"ibmcloud.crossplane.io/deletion-protection":"true"`),
+			want: want{allowed: false, events: 1},
+		},
+		"ProtectedDryRun": {
+			op:     admissionv1beta1.Delete,
+			dryRun: true,
+			old:    bucketJSON(`"ibmcloud.crossplane.io/deletion-protection":"true"`),
+			want:   want{allowed: false},
+		},
+		"InvalidObject": {
+			op:   admissionv1beta1.Delete,
+			old:  []byte("{"),
+			want: want{allowed: false},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			rec := &countingRecorder{}
+			d := NewDeletionProtection(rec, logging.NewNopLogger())
+			req := admission.Request{AdmissionRequest: admissionv1beta1.AdmissionRequest{Operation: tc.op, DryRun: &tc.dryRun}}
+			req.OldObject.Raw = tc.old
+
+			resp := d.Handle(context.Background(), req)
+			if diff := cmp.Diff(tc.want.allowed, resp.Allowed); diff != "" {
+				t.Errorf("Handle(...): -want allowed, +got allowed:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.events, rec.events); diff != "" {
+				t.Errorf("Handle(...): -want events, +got events:\n%s", diff)
+			}
+		})
+	}
+}